@@ -34,20 +34,50 @@ type (
 
 	Integration struct {
 		HTTPClint     `env-required:"true" yaml:"http"`
+		RabbitMQ      `env-required:"true" yaml:"rabbit-mq"`
+		Redis         `env-required:"true" yaml:"redis"`
 		OpenTelemetry `env-required:"true" yaml:"otlp"`
 	}
 
+	RabbitMQ struct {
+		Topic `env-required:"true" yaml:"topic"`
+	}
+
+	Topic struct {
+		TopicUserStatusEvents `env-required:"true" yaml:"user-status-events"`
+	}
+
+	TopicUserStatusEvents struct {
+		URL         string        `env-required:"true" yaml:"url" env:"USER_STATUS_EVENTS_URL"`
+		MaxRetries  int           `yaml:"max-retries" env-default:"3"`
+		WaitingTime time.Duration `yaml:"waiting-time" env-default:"2s"`
+		Reconcile   `yaml:"reconcile"`
+	}
+
+	Reconcile struct {
+		Interval  time.Duration `yaml:"interval" env-default:"1m"`
+		After     time.Duration `yaml:"after" env-default:"1m"`
+		BatchSize int           `yaml:"batch-size" env-default:"100"`
+	}
+
+	Redis struct {
+		RedisURL      string `env-required:"true" yaml:"url" env:"REDIS_URL"`
+		RedisDB       int    `env-required:"true" yaml:"db" env:"REDIS_DB"`
+		RedisPassword string `env-required:"true" yaml:"password" env:"REDIS_HOST_PASSWORD"`
+	}
+
 	HTTPClint struct {
 		KeyCloak `env-required:"true" yaml:"keycloak"`
 	}
 
 	KeyCloak struct {
-		KeyCloakBaseURL        string        `env-required:"true" yaml:"base-url" env:"KEYCLOAK_URL"`
-		KeyCloakUsername       string        `env-required:"true" yaml:"username" env:"KEYCLOAK_USERNAME"`
-		KeyCloakPassword       string        `env-required:"true" yaml:"password" env:"KEYCLOAK_PASSWORD"`
-		KeyCloakRealm          string        `env-required:"true" yaml:"realm" env:"KEYCLOAK_REALM"`
-		KeyCloakRequestTimeout time.Duration `env-required:"true" yaml:"request-timeout"`
-		KeyCloakDebug          bool          `yaml:"debug" env-default:"true"`
+		KeyCloakBaseURL          string        `env-required:"true" yaml:"base-url" env:"KEYCLOAK_URL"`
+		KeyCloakUsername         string        `env-required:"true" yaml:"username" env:"KEYCLOAK_USERNAME"`
+		KeyCloakPassword         string        `env-required:"true" yaml:"password" env:"KEYCLOAK_PASSWORD"`
+		KeyCloakRealm            string        `env-required:"true" yaml:"realm" env:"KEYCLOAK_REALM"`
+		KeyCloakRequestTimeout   time.Duration `env-required:"true" yaml:"request-timeout"`
+		KeyCloakDebug            bool          `yaml:"debug" env-default:"true"`
+		KeyCloakBatchConcurrency int           `yaml:"batch-concurrency" env-default:"8"`
 	}
 
 	OpenTelemetry struct {
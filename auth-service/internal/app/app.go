@@ -19,7 +19,9 @@ import (
 	grpcrecovery "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	"github.com/lucasd-coder/fast-feet/auth-service/config"
 	authHandler "github.com/lucasd-coder/fast-feet/auth-service/internal/domain/auth/handler"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/provider/publish"
 	"github.com/lucasd-coder/fast-feet/auth-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/auth-service/pkg/cache"
 	"github.com/lucasd-coder/fast-feet/auth-service/pkg/pb"
 	"github.com/lucasd-coder/fast-feet/pkg/logger"
 	"github.com/lucasd-coder/fast-feet/pkg/monitor"
@@ -61,6 +63,16 @@ func Run(cfg *config.Config) {
 			log.Fatalf("Error shutting down tracer server provider: %v", err)
 		}
 	}()
+	cache.SetUpRedis(ctx, cfg)
+	publish.SetUpPublisher(cfg)
+	defer func() {
+		if err := publish.ClosePublisher(context.WithoutCancel(ctx)); err != nil {
+			logDefault.Error(fmt.Sprintf("Unable to close publisher: %v", err))
+		}
+	}()
+
+	go publish.NewWorker(cfg, InitializeAuthService().PublishPendingUserStatus).Start(ctx)
+
 	reg := prometheus.NewRegistry()
 	grpcServer := newGrpcServer(ctx, logger, reg)
 	registerServices(grpcServer)
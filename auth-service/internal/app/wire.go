@@ -9,8 +9,11 @@ import (
 	"github.com/lucasd-coder/fast-feet/auth-service/internal/domain/auth"
 	authHandler "github.com/lucasd-coder/fast-feet/auth-service/internal/domain/auth/handler"
 	"github.com/lucasd-coder/fast-feet/auth-service/internal/provider/kecloak"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/provider/publish"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/provider/statusstore"
 	val "github.com/lucasd-coder/fast-feet/auth-service/internal/provider/validator"
 	"github.com/lucasd-coder/fast-feet/auth-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/auth-service/pkg/cache"
 )
 
 var initializeValidator = wire.NewSet(
@@ -23,8 +26,25 @@ var initializeRepository = wire.NewSet(
 	kecloak.NewRepository,
 )
 
+var initializeStatusOutbox = wire.NewSet(
+	wire.Bind(new(auth.StatusOutbox), new(*statusstore.Repository)),
+	statusstore.NewRepository,
+	cache.GetClient,
+)
+
+var initializePublisher = wire.NewSet(
+	wire.Bind(new(auth.Publisher), new(*publish.Publisher)),
+	publish.GetPublisher,
+)
+
 func InitializeAuthHandler() *authHandler.Handler {
-	wire.Build(initializeValidator, initializeRepository, auth.InitializeService,
-		config.GetConfig, authHandler.NewHandler)
+	wire.Build(initializeValidator, initializeRepository, initializePublisher, initializeStatusOutbox,
+		auth.InitializeService, config.GetConfig, authHandler.NewHandler)
+	return nil
+}
+
+func InitializeAuthService() *auth.ServiceImpl {
+	wire.Build(initializeValidator, initializeRepository, initializePublisher, initializeStatusOutbox,
+		auth.InitializeService, config.GetConfig)
 	return nil
 }
@@ -12,12 +12,11 @@ import (
 	"github.com/lucasd-coder/fast-feet/auth-service/internal/domain/auth"
 	"github.com/lucasd-coder/fast-feet/auth-service/internal/domain/auth/handler"
 	"github.com/lucasd-coder/fast-feet/auth-service/internal/provider/kecloak"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/provider/publish"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/provider/statusstore"
 	"github.com/lucasd-coder/fast-feet/auth-service/internal/provider/validator"
 	"github.com/lucasd-coder/fast-feet/auth-service/internal/shared"
-)
-
-import (
-	_ "net/http/pprof"
+	"github.com/lucasd-coder/fast-feet/auth-service/pkg/cache"
 )
 
 // Injectors from wire.go:
@@ -26,13 +25,31 @@ func InitializeAuthHandler() *handler.Handler {
 	validation := &validator.Validation{}
 	configConfig := config.GetConfig()
 	repository := kecloak.NewRepository(configConfig)
-	serviceImpl := auth.NewService(validation, repository)
+	publisher := publish.GetPublisher()
+	client := cache.GetClient()
+	statusstoreRepository := statusstore.NewRepository(client)
+	serviceImpl := auth.NewService(validation, repository, publisher, statusstoreRepository)
 	handlerHandler := handler.NewHandler(serviceImpl, configConfig)
 	return handlerHandler
 }
 
+func InitializeAuthService() *auth.ServiceImpl {
+	validation := &validator.Validation{}
+	configConfig := config.GetConfig()
+	repository := kecloak.NewRepository(configConfig)
+	publisher := publish.GetPublisher()
+	client := cache.GetClient()
+	statusstoreRepository := statusstore.NewRepository(client)
+	serviceImpl := auth.NewService(validation, repository, publisher, statusstoreRepository)
+	return serviceImpl
+}
+
 // wire.go:
 
 var initializeValidator = wire.NewSet(wire.Struct(new(validator.Validation)), wire.Bind(new(shared.Validator), new(*validator.Validation)))
 
 var initializeRepository = wire.NewSet(wire.Bind(new(auth.Repository), new(*kecloak.Repository)), kecloak.NewRepository)
+
+var initializeStatusOutbox = wire.NewSet(wire.Bind(new(auth.StatusOutbox), new(*statusstore.Repository)), statusstore.NewRepository, cache.GetClient)
+
+var initializePublisher = wire.NewSet(wire.Bind(new(auth.Publisher), new(*publish.Publisher)), publish.GetPublisher)
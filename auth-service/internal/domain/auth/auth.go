@@ -56,16 +56,39 @@ func (f *FindUserByEmail) Validate(val shared.Validator) error {
 }
 
 type UserRepresentation struct {
-	ID       string `json:"id,omitempty"`
-	Username string `json:"username,omitempty"`
-	Enabled  bool   `json:"enabled,omitempty"`
-	Email    string `json:"email,omitempty"`
+	ID               string `json:"id,omitempty"`
+	Username         string `json:"username,omitempty"`
+	Enabled          bool   `json:"enabled,omitempty"`
+	Email            string `json:"email,omitempty"`
+	CreatedTimestamp int64  `json:"createdTimestamp,omitempty"`
 }
 
 type GetUserID struct {
 	ID string `json:"id" validate:"required,uuid"`
 }
 
+type GetUserIDs struct {
+	IDs []string `json:"ids" validate:"required,min=1,max=100,dive,uuid"`
+}
+
+type Account struct {
+	UserRepresentation
+	Roles []string `json:"roles,omitempty"`
+}
+
+func (g *GetUserIDs) Validate(val shared.Validator) error {
+	return val.ValidateStruct(g)
+}
+
 func (g *GetUserID) Validate(val shared.Validator) error {
 	return val.ValidateStruct(g)
 }
+
+type UpdateEmail struct {
+	ID    string `json:"id" validate:"required,uuid"`
+	Email string `json:"email" validate:"required,email"`
+}
+
+func (u *UpdateEmail) Validate(val shared.Validator) error {
+	return val.ValidateStruct(u)
+}
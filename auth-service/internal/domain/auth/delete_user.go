@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/auth-service/pkg/pb"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+)
+
+func (s *ServiceImpl) DeleteUser(ctx context.Context, pld *GetUserID) (*pb.EmptyResponse, error) {
+	log := logger.FromContext(ctx)
+
+	log.Infof("received request DeleteUser on with id: %s", pld.ID)
+
+	if err := pld.Validate(s.validate); err != nil {
+		return nil, shared.ValidationErrors(err)
+	}
+
+	if err := s.repository.DeleteUser(ctx, pld); err != nil {
+		return nil, shared.CheckError(err)
+	}
+
+	return &pb.EmptyResponse{}, nil
+}
@@ -0,0 +1,69 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Nerzal/gocloak/v13"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/domain/auth"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/mocks"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/provider/validator"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type DeleteUserSuite struct {
+	suite.Suite
+	svc  auth.Service
+	repo *mocks.Repository_internal_domain_auth
+	ctx  context.Context
+}
+
+func (suite *DeleteUserSuite) SetupTest() {
+	val := validator.NewValidation()
+	repo := new(mocks.Repository_internal_domain_auth)
+
+	suite.repo = repo
+	suite.svc = auth.NewService(val, repo, new(mocks.Publisher_internal_domain_auth), new(mocks.StatusOutbox_internal_domain_auth))
+	suite.ctx = context.Background()
+}
+
+func (suite *DeleteUserSuite) TestDeleteUserValidateFailure() {
+	pld := &auth.GetUserID{
+		ID: "1234",
+	}
+	_, err := suite.svc.DeleteUser(suite.ctx, pld)
+	st, ok := status.FromError(err)
+	suite.True(ok)
+	suite.Equal(st.Code(), codes.InvalidArgument)
+}
+
+func (suite *DeleteUserSuite) TestDeleteUserNotFound() {
+	pld := &auth.GetUserID{
+		ID: "433c311b-93a5-45c3-99c9-b52f3c4aef4f",
+	}
+
+	suite.repo.On("DeleteUser", suite.ctx, pld).
+		Return(&gocloak.APIError{Code: 404, Message: "User not found"})
+
+	_, err := suite.svc.DeleteUser(suite.ctx, pld)
+	st, ok := status.FromError(err)
+	suite.True(ok)
+	suite.Equal(st.Code(), codes.NotFound)
+}
+
+func (suite *DeleteUserSuite) TestDeleteUserSuccess() {
+	pld := &auth.GetUserID{
+		ID: "433c311b-93a5-45c3-99c9-b52f3c4aef4f",
+	}
+
+	suite.repo.On("DeleteUser", suite.ctx, pld).Return(nil)
+
+	_, err := suite.svc.DeleteUser(suite.ctx, pld)
+	suite.Nil(err)
+}
+
+func TestDeleteUserSuite(t *testing.T) {
+	suite.Run(t, new(DeleteUserSuite))
+}
@@ -0,0 +1,16 @@
+package auth
+
+import "time"
+
+// The message type of the event published when an account is enabled or
+// disabled.
+const (
+	UserStatusEventType    = "user.status.changed"
+	UserStatusEventVersion = "1"
+)
+
+type UserStatusEvent struct {
+	UserID     string    `json:"userId"`
+	Enabled    bool      `json:"enabled"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
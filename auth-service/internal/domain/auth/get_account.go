@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/auth-service/pkg/pb"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+)
+
+// GetAccount returns the account with its roles, used by the personal data
+// export.
+func (s *ServiceImpl) GetAccount(ctx context.Context, pld *GetUserID) (*pb.GetAccountResponse, error) {
+	log := logger.FromContext(ctx)
+
+	log.Infof("received request GetAccount on with id: %s", pld.ID)
+
+	if err := pld.Validate(s.validate); err != nil {
+		return nil, shared.ValidationErrors(err)
+	}
+
+	user, err := s.repository.FindUserByID(ctx, pld)
+	if err != nil {
+		return nil, shared.CheckError(err)
+	}
+
+	roles, err := s.repository.GetRoles(ctx, pld)
+	if err != nil {
+		return nil, shared.CheckError(err)
+	}
+
+	return newGetAccountResponse(user, roles), nil
+}
+
+// GetAccounts returns the accounts of a page of users in one call, ids
+// without an account are left out.
+func (s *ServiceImpl) GetAccounts(ctx context.Context, pld *GetUserIDs) (*pb.GetAccountsResponse, error) {
+	log := logger.FromContext(ctx)
+
+	log.Infof("received request GetAccounts with %d ids", len(pld.IDs))
+
+	if err := pld.Validate(s.validate); err != nil {
+		return nil, shared.ValidationErrors(err)
+	}
+
+	accounts, err := s.repository.FindAccounts(ctx, pld)
+	if err != nil {
+		return nil, shared.CheckError(err)
+	}
+
+	resp := &pb.GetAccountsResponse{
+		Accounts: make([]*pb.GetAccountResponse, 0, len(accounts)),
+	}
+
+	for i := range accounts {
+		resp.Accounts = append(resp.Accounts,
+			newGetAccountResponse(&accounts[i].UserRepresentation, accounts[i].Roles))
+	}
+
+	return resp, nil
+}
+
+func newGetAccountResponse(user *UserRepresentation, roles []string) *pb.GetAccountResponse {
+	return &pb.GetAccountResponse{
+		Id:        user.ID,
+		Username:  user.Username,
+		Enabled:   user.Enabled,
+		Email:     user.Email,
+		Roles:     roles,
+		CreatedAt: time.UnixMilli(user.CreatedTimestamp).UTC().Format(time.RFC3339),
+	}
+}
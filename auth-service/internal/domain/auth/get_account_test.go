@@ -0,0 +1,108 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Nerzal/gocloak/v13"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/domain/auth"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/mocks"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/provider/validator"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetAccountSuite struct {
+	suite.Suite
+	svc  auth.Service
+	repo *mocks.Repository_internal_domain_auth
+	ctx  context.Context
+}
+
+func (suite *GetAccountSuite) SetupTest() {
+	val := validator.NewValidation()
+	repo := new(mocks.Repository_internal_domain_auth)
+
+	suite.repo = repo
+	suite.svc = auth.NewService(val, repo, new(mocks.Publisher_internal_domain_auth), new(mocks.StatusOutbox_internal_domain_auth))
+	suite.ctx = context.Background()
+}
+
+func (suite *GetAccountSuite) TestGetAccountValidateFailure() {
+	pld := &auth.GetUserID{
+		ID: "1234",
+	}
+	_, err := suite.svc.GetAccount(suite.ctx, pld)
+	st, ok := status.FromError(err)
+	suite.True(ok)
+	suite.Equal(st.Code(), codes.InvalidArgument)
+}
+
+func (suite *GetAccountSuite) TestGetAccountNotFound() {
+	pld := &auth.GetUserID{
+		ID: "433c311b-93a5-45c3-99c9-b52f3c4aef4f",
+	}
+
+	suite.repo.On("FindUserByID", suite.ctx, pld).
+		Return(nil, &gocloak.APIError{Code: 404, Message: "User not found"})
+
+	_, err := suite.svc.GetAccount(suite.ctx, pld)
+	st, ok := status.FromError(err)
+	suite.True(ok)
+	suite.Equal(st.Code(), codes.NotFound)
+}
+
+func (suite *GetAccountSuite) TestGetAccountSuccess() {
+	pld := &auth.GetUserID{
+		ID: "433c311b-93a5-45c3-99c9-b52f3c4aef4f",
+	}
+
+	suite.repo.On("FindUserByID", suite.ctx, pld).Return(&auth.UserRepresentation{
+		ID:               pld.ID,
+		Username:         "maria@gmail.com",
+		Enabled:          true,
+		Email:            "maria@gmail.com",
+		CreatedTimestamp: 1700000000000,
+	}, nil)
+	suite.repo.On("GetRoles", suite.ctx, pld).Return([]string{"USER"}, nil)
+
+	resp, err := suite.svc.GetAccount(suite.ctx, pld)
+	suite.Nil(err)
+	suite.True(resp.GetEnabled())
+	suite.Equal([]string{"USER"}, resp.GetRoles())
+	suite.Equal("2023-11-14T22:13:20Z", resp.GetCreatedAt())
+}
+
+func (suite *GetAccountSuite) TestGetAccountsValidateFailure() {
+	pld := &auth.GetUserIDs{
+		IDs: []string{"433c311b-93a5-45c3-99c9-b52f3c4aef4f", "1234"},
+	}
+	_, err := suite.svc.GetAccounts(suite.ctx, pld)
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "FindAccounts", mock.Anything, mock.Anything)
+}
+
+func (suite *GetAccountSuite) TestGetAccountsSuccess() {
+	pld := &auth.GetUserIDs{
+		IDs: []string{"433c311b-93a5-45c3-99c9-b52f3c4aef4f", "7d0f8b8e-5b8a-4f61-9f57-3a1d9c2e6b10"},
+	}
+
+	suite.repo.On("FindAccounts", suite.ctx, pld).Return([]auth.Account{
+		{
+			UserRepresentation: auth.UserRepresentation{ID: pld.IDs[1], Enabled: true},
+			Roles:              []string{"ADMIN"},
+		},
+	}, nil)
+
+	resp, err := suite.svc.GetAccounts(suite.ctx, pld)
+	suite.Nil(err)
+	suite.Len(resp.GetAccounts(), 1)
+	suite.Equal(pld.IDs[1], resp.GetAccounts()[0].GetId())
+	suite.Equal([]string{"ADMIN"}, resp.GetAccounts()[0].GetRoles())
+}
+
+func TestGetAccountSuite(t *testing.T) {
+	suite.Run(t, new(GetAccountSuite))
+}
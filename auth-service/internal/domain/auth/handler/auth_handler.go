@@ -77,6 +77,80 @@ func (h *AuthHandler) IsActiveUser(ctx context.Context, _ *pb.EmptyRequest) (*pb
 	return h.service.IsActiveUser(ctx, &pld)
 }
 
+func (h *AuthHandler) UpdateEmail(ctx context.Context, req *pb.UpdateEmailRequest) (*pb.GetUserResponse, error) {
+	id, err := getHeader(ctx, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	pld := auth.UpdateEmail{
+		ID:    id,
+		Email: req.GetEmail(),
+	}
+
+	return h.service.UpdateEmail(ctx, &pld)
+}
+
+func (h *AuthHandler) DeleteUser(ctx context.Context, _ *pb.EmptyRequest) (*pb.EmptyResponse, error) {
+	id, err := getHeader(ctx, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	pld := auth.GetUserID{
+		ID: id,
+	}
+
+	return h.service.DeleteUser(ctx, &pld)
+}
+
+func (h *AuthHandler) GetAccount(ctx context.Context, _ *pb.EmptyRequest) (*pb.GetAccountResponse, error) {
+	id, err := getHeader(ctx, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	pld := auth.GetUserID{
+		ID: id,
+	}
+
+	return h.service.GetAccount(ctx, &pld)
+}
+
+func (h *AuthHandler) GetAccounts(ctx context.Context, req *pb.GetAccountsRequest) (*pb.GetAccountsResponse, error) {
+	pld := auth.GetUserIDs{
+		IDs: req.GetIds(),
+	}
+
+	return h.service.GetAccounts(ctx, &pld)
+}
+
+func (h *AuthHandler) DisableUser(ctx context.Context, _ *pb.EmptyRequest) (*pb.GetUserResponse, error) {
+	id, err := getHeader(ctx, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	pld := auth.GetUserID{
+		ID: id,
+	}
+
+	return h.service.DisableUser(ctx, &pld)
+}
+
+func (h *AuthHandler) EnableUser(ctx context.Context, _ *pb.EmptyRequest) (*pb.GetUserResponse, error) {
+	id, err := getHeader(ctx, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	pld := auth.GetUserID{
+		ID: id,
+	}
+
+	return h.service.EnableUser(ctx, &pld)
+}
+
 func getHeader(ctx context.Context, name string) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
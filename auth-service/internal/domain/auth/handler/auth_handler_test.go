@@ -11,14 +11,18 @@ import (
 	"time"
 
 	"github.com/Nerzal/gocloak/v13"
+	"github.com/alicebob/miniredis/v2"
 	"github.com/ilyakaznacheev/cleanenv"
 	"github.com/lucasd-coder/fast-feet/auth-service/config"
 	"github.com/lucasd-coder/fast-feet/auth-service/internal/domain/auth"
 	"github.com/lucasd-coder/fast-feet/auth-service/internal/domain/auth/handler"
 	"github.com/lucasd-coder/fast-feet/auth-service/internal/provider/kecloak"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/provider/publish"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/provider/statusstore"
 	"github.com/lucasd-coder/fast-feet/auth-service/internal/provider/validator"
 	"github.com/lucasd-coder/fast-feet/auth-service/pkg/pb"
 	testcontainers "github.com/lucasd-coder/fast-feet/pkg/testcontainers/keycloak"
+	"github.com/redis/go-redis/v9"
 	keycloak "github.com/stillya/testcontainers-keycloak"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
@@ -113,7 +117,8 @@ func (suite *AuthHandlerSuite) SetupTest() {
 
 	val := validator.NewValidation()
 	repo := kecloak.NewRepository(&suite.cfg)
-	svc := auth.NewService(val, repo)
+	outbox := statusstore.NewRepository(redis.NewClient(&redis.Options{Addr: miniredis.RunT(suite.T()).Addr()}))
+	svc := auth.NewService(val, repo, publish.NewPublisher(&suite.cfg), outbox)
 	hdler := handler.NewHandler(svc, &suite.cfg)
 	suite.authHandler = handler.NewAuthHandler(*hdler)
 
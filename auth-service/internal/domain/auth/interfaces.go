@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/lucasd-coder/fast-feet/auth-service/pkg/pb"
 )
@@ -12,6 +13,13 @@ type (
 		FindUserByEmail(ctx context.Context, pld *FindUserByEmail) (*pb.GetUserResponse, error)
 		GetRoles(ctx context.Context, pld *GetUserID) (*pb.GetRolesResponse, error)
 		IsActiveUser(ctx context.Context, pld *GetUserID) (*pb.IsActiveUserResponse, error)
+		UpdateEmail(ctx context.Context, pld *UpdateEmail) (*pb.GetUserResponse, error)
+		DeleteUser(ctx context.Context, pld *GetUserID) (*pb.EmptyResponse, error)
+		GetAccount(ctx context.Context, pld *GetUserID) (*pb.GetAccountResponse, error)
+		GetAccounts(ctx context.Context, pld *GetUserIDs) (*pb.GetAccountsResponse, error)
+		DisableUser(ctx context.Context, pld *GetUserID) (*pb.GetUserResponse, error)
+		EnableUser(ctx context.Context, pld *GetUserID) (*pb.GetUserResponse, error)
+		PublishPendingUserStatus(ctx context.Context, before time.Time, limit int) error
 	}
 
 	Repository interface {
@@ -19,5 +27,21 @@ type (
 		FindUserByEmail(ctx context.Context, pld *FindUserByEmail) (*UserRepresentation, error)
 		GetRoles(ctx context.Context, pld *GetUserID) ([]string, error)
 		IsActiveUser(ctx context.Context, pld *GetUserID) (bool, error)
+		UpdateEmail(ctx context.Context, pld *UpdateEmail) (*UserRepresentation, error)
+		DeleteUser(ctx context.Context, pld *GetUserID) error
+		FindUserByID(ctx context.Context, pld *GetUserID) (*UserRepresentation, error)
+		FindAccounts(ctx context.Context, pld *GetUserIDs) ([]Account, error)
+		SetEnabled(ctx context.Context, pld *GetUserID, enabled bool) (*UserRepresentation, error)
+		LogoutAllSessions(ctx context.Context, pld *GetUserID) error
+	}
+
+	Publisher interface {
+		PublishUserStatus(ctx context.Context, event *UserStatusEvent) error
+	}
+
+	StatusOutbox interface {
+		Save(ctx context.Context, event *UserStatusEvent) error
+		FindPending(ctx context.Context, before time.Time, limit int) ([]*UserStatusEvent, error)
+		Delete(ctx context.Context, event *UserStatusEvent) error
 	}
 )
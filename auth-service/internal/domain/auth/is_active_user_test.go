@@ -26,7 +26,7 @@ func (suite *IsActiveUserSuite) SetupTest() {
 	repo := new(mocks.Repository_internal_domain_auth)
 
 	suite.repo = repo
-	suite.svc = auth.NewService(val, repo)
+	suite.svc = auth.NewService(val, repo, new(mocks.Publisher_internal_domain_auth), new(mocks.StatusOutbox_internal_domain_auth))
 	suite.ctx = context.Background()
 }
 
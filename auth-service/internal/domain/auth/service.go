@@ -13,11 +13,15 @@ var InitializeService = wire.NewSet(
 type ServiceImpl struct {
 	validate   shared.Validator
 	repository Repository
+	publisher  Publisher
+	outbox     StatusOutbox
 }
 
-func NewService(val shared.Validator, repo Repository) *ServiceImpl {
+func NewService(val shared.Validator, repo Repository, publisher Publisher, outbox StatusOutbox) *ServiceImpl {
 	return &ServiceImpl{
 		validate:   val,
 		repository: repo,
+		publisher:  publisher,
+		outbox:     outbox,
 	}
 }
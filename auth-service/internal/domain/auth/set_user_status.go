@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/auth-service/pkg/pb"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DisableUser disables the account and revokes its active sessions, so the
+// refresh tokens already issued stop working.
+func (s *ServiceImpl) DisableUser(ctx context.Context, pld *GetUserID) (*pb.GetUserResponse, error) {
+	log := logger.FromContext(ctx)
+
+	log.Infof("received request DisableUser on with id: %s", pld.ID)
+
+	return s.setUserStatus(ctx, pld, false)
+}
+
+func (s *ServiceImpl) EnableUser(ctx context.Context, pld *GetUserID) (*pb.GetUserResponse, error) {
+	log := logger.FromContext(ctx)
+
+	log.Infof("received request EnableUser on with id: %s", pld.ID)
+
+	return s.setUserStatus(ctx, pld, true)
+}
+
+// setUserStatus saves the status event to the outbox before changing the
+// account, so a change is never left without its event. A publish that fails
+// is logged and left to PublishPendingUserStatus.
+func (s *ServiceImpl) setUserStatus(ctx context.Context, pld *GetUserID, enabled bool) (*pb.GetUserResponse, error) {
+	log := logger.FromContext(ctx)
+
+	if err := pld.Validate(s.validate); err != nil {
+		return nil, shared.ValidationErrors(err)
+	}
+
+	event := &UserStatusEvent{
+		UserID:     pld.ID,
+		Enabled:    enabled,
+		OccurredAt: time.Now().UTC(),
+	}
+
+	if err := s.outbox.Save(ctx, event); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error saving user status event: %v", err)
+	}
+
+	user, err := s.repository.SetEnabled(ctx, pld, enabled)
+	if err != nil {
+		return nil, shared.CheckError(err)
+	}
+
+	if !enabled {
+		if err := s.repository.LogoutAllSessions(ctx, pld); err != nil {
+			return nil, shared.CheckError(err)
+		}
+	}
+
+	if err := s.publishUserStatus(ctx, event); err != nil {
+		log.Errorf("error publishing user status of id: %s, kept pending, err: %v", user.ID, err)
+	}
+
+	return &pb.GetUserResponse{
+		Id:       user.ID,
+		Username: user.Username,
+		Enabled:  user.Enabled,
+		Email:    user.Email,
+	}, nil
+}
+
+// PublishPendingUserStatus publishes the outbox events saved before the given
+// time with the current status of the account, the change of a failed request
+// may or may not have been applied. Events of deleted accounts are discarded.
+func (s *ServiceImpl) PublishPendingUserStatus(ctx context.Context, before time.Time, limit int) error {
+	log := logger.FromContext(ctx)
+
+	events, err := s.outbox.FindPending(ctx, before, limit)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, event := range events {
+		user, err := s.repository.FindUserByID(ctx, &GetUserID{ID: event.UserID})
+		if err != nil {
+			if status.Code(shared.CheckError(err)) == codes.NotFound {
+				s.discardUserStatus(ctx, event)
+				continue
+			}
+			errs = append(errs, err)
+			continue
+		}
+
+		event.Enabled = user.Enabled
+		if err := s.publishUserStatus(ctx, event); err != nil {
+			log.Errorf("error publishing pending user status of id: %s, err: %v", event.UserID, err)
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *ServiceImpl) publishUserStatus(ctx context.Context, event *UserStatusEvent) error {
+	if err := s.publisher.PublishUserStatus(ctx, event); err != nil {
+		return err
+	}
+
+	return s.outbox.Delete(ctx, event)
+}
+
+func (s *ServiceImpl) discardUserStatus(ctx context.Context, event *UserStatusEvent) {
+	log := logger.FromContext(ctx)
+
+	if err := s.outbox.Delete(ctx, event); err != nil {
+		log.Errorf("error discarding user status event of id: %s, err: %v", event.UserID, err)
+	}
+}
@@ -0,0 +1,173 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Nerzal/gocloak/v13"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/domain/auth"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/mocks"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/provider/validator"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SetUserStatusSuite struct {
+	suite.Suite
+	svc       auth.Service
+	repo      *mocks.Repository_internal_domain_auth
+	publisher *mocks.Publisher_internal_domain_auth
+	outbox    *mocks.StatusOutbox_internal_domain_auth
+	ctx       context.Context
+	pld       *auth.GetUserID
+}
+
+func (suite *SetUserStatusSuite) SetupTest() {
+	val := validator.NewValidation()
+
+	suite.repo = new(mocks.Repository_internal_domain_auth)
+	suite.publisher = new(mocks.Publisher_internal_domain_auth)
+	suite.outbox = new(mocks.StatusOutbox_internal_domain_auth)
+	suite.svc = auth.NewService(val, suite.repo, suite.publisher, suite.outbox)
+	suite.ctx = context.Background()
+	suite.pld = &auth.GetUserID{
+		ID: "433c311b-93a5-45c3-99c9-b52f3c4aef4f",
+	}
+}
+
+func (suite *SetUserStatusSuite) user(enabled bool) *auth.UserRepresentation {
+	return &auth.UserRepresentation{
+		ID:       suite.pld.ID,
+		Username: "maria@gmail.com",
+		Email:    "maria@gmail.com",
+		Enabled:  enabled,
+	}
+}
+
+func (suite *SetUserStatusSuite) statusEvent(enabled bool) interface{} {
+	return mock.MatchedBy(func(e *auth.UserStatusEvent) bool {
+		return e.UserID == suite.pld.ID && e.Enabled == enabled && !e.OccurredAt.IsZero()
+	})
+}
+
+func (suite *SetUserStatusSuite) pendingEvent(enabled bool) *auth.UserStatusEvent {
+	return &auth.UserStatusEvent{
+		UserID:     suite.pld.ID,
+		Enabled:    enabled,
+		OccurredAt: time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func (suite *SetUserStatusSuite) TestDisableUser() {
+	suite.outbox.On("Save", suite.ctx, suite.statusEvent(false)).Return(nil)
+	suite.repo.On("SetEnabled", suite.ctx, suite.pld, false).Return(suite.user(false), nil)
+	suite.repo.On("LogoutAllSessions", suite.ctx, suite.pld).Return(nil)
+	suite.publisher.On("PublishUserStatus", suite.ctx, suite.statusEvent(false)).Return(nil)
+	suite.outbox.On("Delete", suite.ctx, suite.statusEvent(false)).Return(nil)
+
+	resp, err := suite.svc.DisableUser(suite.ctx, suite.pld)
+	suite.NoError(err)
+	suite.False(resp.GetEnabled())
+	suite.repo.AssertCalled(suite.T(), "LogoutAllSessions", suite.ctx, suite.pld)
+	suite.outbox.AssertCalled(suite.T(), "Delete", suite.ctx, suite.statusEvent(false))
+}
+
+func (suite *SetUserStatusSuite) TestEnableUser() {
+	suite.outbox.On("Save", suite.ctx, suite.statusEvent(true)).Return(nil)
+	suite.repo.On("SetEnabled", suite.ctx, suite.pld, true).Return(suite.user(true), nil)
+	suite.publisher.On("PublishUserStatus", suite.ctx, suite.statusEvent(true)).Return(nil)
+	suite.outbox.On("Delete", suite.ctx, suite.statusEvent(true)).Return(nil)
+
+	resp, err := suite.svc.EnableUser(suite.ctx, suite.pld)
+	suite.NoError(err)
+	suite.True(resp.GetEnabled())
+	suite.repo.AssertNotCalled(suite.T(), "LogoutAllSessions", mock.Anything, mock.Anything)
+}
+
+func (suite *SetUserStatusSuite) TestDisableUserValidateFailure() {
+	_, err := suite.svc.DisableUser(suite.ctx, &auth.GetUserID{ID: "1234"})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	suite.outbox.AssertNotCalled(suite.T(), "Save", mock.Anything, mock.Anything)
+	suite.repo.AssertNotCalled(suite.T(), "SetEnabled", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *SetUserStatusSuite) TestDisableUserOutboxFailure() {
+	suite.outbox.On("Save", suite.ctx, mock.Anything).Return(errors.New("redis unavailable"))
+
+	_, err := suite.svc.DisableUser(suite.ctx, suite.pld)
+	suite.Equal(codes.Unavailable, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "SetEnabled", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *SetUserStatusSuite) TestDisableUserNotFound() {
+	suite.outbox.On("Save", suite.ctx, mock.Anything).Return(nil)
+	suite.repo.On("SetEnabled", suite.ctx, suite.pld, false).
+		Return(nil, &gocloak.APIError{Code: 404, Message: "User not found"})
+
+	_, err := suite.svc.DisableUser(suite.ctx, suite.pld)
+	suite.Equal(codes.NotFound, status.Code(err))
+	suite.publisher.AssertNotCalled(suite.T(), "PublishUserStatus", mock.Anything, mock.Anything)
+}
+
+func (suite *SetUserStatusSuite) TestDisableUserPublishFailureKeepsPending() {
+	suite.outbox.On("Save", suite.ctx, mock.Anything).Return(nil)
+	suite.repo.On("SetEnabled", suite.ctx, suite.pld, false).Return(suite.user(false), nil)
+	suite.repo.On("LogoutAllSessions", suite.ctx, suite.pld).Return(nil)
+	suite.publisher.On("PublishUserStatus", suite.ctx, mock.Anything).Return(errors.New("broker unavailable"))
+
+	resp, err := suite.svc.DisableUser(suite.ctx, suite.pld)
+	suite.NoError(err)
+	suite.False(resp.GetEnabled())
+	suite.outbox.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (suite *SetUserStatusSuite) TestPublishPendingUserStatus() {
+	before := time.Date(2024, 5, 10, 12, 1, 0, 0, time.UTC)
+	event := suite.pendingEvent(true)
+
+	suite.outbox.On("FindPending", suite.ctx, before, 10).Return([]*auth.UserStatusEvent{event}, nil)
+	suite.repo.On("FindUserByID", suite.ctx, suite.pld).Return(suite.user(false), nil)
+	suite.publisher.On("PublishUserStatus", suite.ctx, suite.statusEvent(false)).Return(nil)
+	suite.outbox.On("Delete", suite.ctx, event).Return(nil)
+
+	err := suite.svc.PublishPendingUserStatus(suite.ctx, before, 10)
+	suite.NoError(err)
+	suite.publisher.AssertCalled(suite.T(), "PublishUserStatus", suite.ctx, suite.statusEvent(false))
+	suite.outbox.AssertCalled(suite.T(), "Delete", suite.ctx, event)
+}
+
+func (suite *SetUserStatusSuite) TestPublishPendingUserStatusDeletedAccount() {
+	before := time.Date(2024, 5, 10, 12, 1, 0, 0, time.UTC)
+	event := suite.pendingEvent(false)
+
+	suite.outbox.On("FindPending", suite.ctx, before, 10).Return([]*auth.UserStatusEvent{event}, nil)
+	suite.repo.On("FindUserByID", suite.ctx, suite.pld).
+		Return(nil, &gocloak.APIError{Code: 404, Message: "User not found"})
+	suite.outbox.On("Delete", suite.ctx, event).Return(nil)
+
+	err := suite.svc.PublishPendingUserStatus(suite.ctx, before, 10)
+	suite.NoError(err)
+	suite.publisher.AssertNotCalled(suite.T(), "PublishUserStatus", mock.Anything, mock.Anything)
+	suite.outbox.AssertCalled(suite.T(), "Delete", suite.ctx, event)
+}
+
+func (suite *SetUserStatusSuite) TestPublishPendingUserStatusFailure() {
+	before := time.Date(2024, 5, 10, 12, 1, 0, 0, time.UTC)
+	event := suite.pendingEvent(false)
+
+	suite.outbox.On("FindPending", suite.ctx, before, 10).Return([]*auth.UserStatusEvent{event}, nil)
+	suite.repo.On("FindUserByID", suite.ctx, suite.pld).Return(suite.user(false), nil)
+	suite.publisher.On("PublishUserStatus", suite.ctx, mock.Anything).Return(errors.New("broker unavailable"))
+
+	err := suite.svc.PublishPendingUserStatus(suite.ctx, before, 10)
+	suite.Error(err)
+	suite.outbox.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func TestSetUserStatusSuite(t *testing.T) {
+	suite.Run(t, new(SetUserStatusSuite))
+}
@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/auth-service/pkg/pb"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+)
+
+func (s *ServiceImpl) UpdateEmail(ctx context.Context, pld *UpdateEmail) (*pb.GetUserResponse, error) {
+	log := logger.FromContext(ctx)
+
+	log.Infof("received request UpdateEmail on with id: %s", pld.ID)
+
+	if err := pld.Validate(s.validate); err != nil {
+		return nil, shared.ValidationErrors(err)
+	}
+
+	result, err := s.repository.UpdateEmail(ctx, pld)
+	if err != nil {
+		return nil, shared.CheckError(err)
+	}
+
+	return &pb.GetUserResponse{
+		Id:       result.ID,
+		Username: result.Username,
+		Enabled:  result.Enabled,
+		Email:    result.Email,
+	}, nil
+}
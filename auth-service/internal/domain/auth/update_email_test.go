@@ -0,0 +1,79 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Nerzal/gocloak/v13"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/domain/auth"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/mocks"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/provider/validator"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UpdateEmailSuite struct {
+	suite.Suite
+	svc  auth.Service
+	repo *mocks.Repository_internal_domain_auth
+	ctx  context.Context
+}
+
+func (suite *UpdateEmailSuite) SetupTest() {
+	val := validator.NewValidation()
+	repo := new(mocks.Repository_internal_domain_auth)
+
+	suite.repo = repo
+	suite.svc = auth.NewService(val, repo, new(mocks.Publisher_internal_domain_auth), new(mocks.StatusOutbox_internal_domain_auth))
+	suite.ctx = context.Background()
+}
+
+func (suite *UpdateEmailSuite) TestUpdateEmailValidateFailure() {
+	pld := &auth.UpdateEmail{
+		ID:    "433c311b-93a5-45c3-99c9-b52f3c4aef4f",
+		Email: "invalid email",
+	}
+	_, err := suite.svc.UpdateEmail(suite.ctx, pld)
+	st, ok := status.FromError(err)
+	suite.True(ok)
+	suite.Equal(st.Code(), codes.InvalidArgument)
+}
+
+func (suite *UpdateEmailSuite) TestUpdateEmailAlreadyExist() {
+	pld := &auth.UpdateEmail{
+		ID:    "433c311b-93a5-45c3-99c9-b52f3c4aef4f",
+		Email: "maria@gmail.com",
+	}
+
+	suite.repo.On("UpdateEmail", suite.ctx, pld).
+		Return(nil, &gocloak.APIError{Code: 409, Message: "User exists with same username or email"})
+
+	_, err := suite.svc.UpdateEmail(suite.ctx, pld)
+	st, ok := status.FromError(err)
+	suite.True(ok)
+	suite.Equal(st.Code(), codes.AlreadyExists)
+}
+
+func (suite *UpdateEmailSuite) TestUpdateEmailSuccess() {
+	pld := &auth.UpdateEmail{
+		ID:    "433c311b-93a5-45c3-99c9-b52f3c4aef4f",
+		Email: "maria@gmail.com",
+	}
+
+	suite.repo.On("UpdateEmail", suite.ctx, pld).Return(&auth.UserRepresentation{
+		ID:       pld.ID,
+		Username: pld.Email,
+		Email:    pld.Email,
+		Enabled:  true,
+	}, nil)
+
+	resp, err := suite.svc.UpdateEmail(suite.ctx, pld)
+	suite.Nil(err)
+	suite.Equal(pld.Email, resp.GetEmail())
+	suite.Equal(pld.Email, resp.GetUsername())
+}
+
+func TestUpdateEmailSuite(t *testing.T) {
+	suite.Run(t, new(UpdateEmailSuite))
+}
@@ -15,6 +15,46 @@ type Repository_internal_domain_auth struct {
 	mock.Mock
 }
 
+// DeleteUser provides a mock function with given fields: ctx, pld
+func (_m *Repository_internal_domain_auth) DeleteUser(ctx context.Context, pld *auth.GetUserID) error {
+	ret := _m.Called(ctx, pld)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.GetUserID) error); ok {
+		r0 = rf(ctx, pld)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindAccounts provides a mock function with given fields: ctx, pld
+func (_m *Repository_internal_domain_auth) FindAccounts(ctx context.Context, pld *auth.GetUserIDs) ([]auth.Account, error) {
+	ret := _m.Called(ctx, pld)
+
+	var r0 []auth.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.GetUserIDs) ([]auth.Account, error)); ok {
+		return rf(ctx, pld)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.GetUserIDs) []auth.Account); ok {
+		r0 = rf(ctx, pld)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]auth.Account)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *auth.GetUserIDs) error); ok {
+		r1 = rf(ctx, pld)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindUserByEmail provides a mock function with given fields: ctx, pld
 func (_m *Repository_internal_domain_auth) FindUserByEmail(ctx context.Context, pld *auth.FindUserByEmail) (*auth.UserRepresentation, error) {
 	ret := _m.Called(ctx, pld)
@@ -41,6 +81,32 @@ func (_m *Repository_internal_domain_auth) FindUserByEmail(ctx context.Context,
 	return r0, r1
 }
 
+// FindUserByID provides a mock function with given fields: ctx, pld
+func (_m *Repository_internal_domain_auth) FindUserByID(ctx context.Context, pld *auth.GetUserID) (*auth.UserRepresentation, error) {
+	ret := _m.Called(ctx, pld)
+
+	var r0 *auth.UserRepresentation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.GetUserID) (*auth.UserRepresentation, error)); ok {
+		return rf(ctx, pld)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.GetUserID) *auth.UserRepresentation); ok {
+		r0 = rf(ctx, pld)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.UserRepresentation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *auth.GetUserID) error); ok {
+		r1 = rf(ctx, pld)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRoles provides a mock function with given fields: ctx, pld
 func (_m *Repository_internal_domain_auth) GetRoles(ctx context.Context, pld *auth.GetUserID) ([]string, error) {
 	ret := _m.Called(ctx, pld)
@@ -91,6 +157,20 @@ func (_m *Repository_internal_domain_auth) IsActiveUser(ctx context.Context, pld
 	return r0, r1
 }
 
+// LogoutAllSessions provides a mock function with given fields: ctx, pld
+func (_m *Repository_internal_domain_auth) LogoutAllSessions(ctx context.Context, pld *auth.GetUserID) error {
+	ret := _m.Called(ctx, pld)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.GetUserID) error); ok {
+		r0 = rf(ctx, pld)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Register provides a mock function with given fields: ctx, pld
 func (_m *Repository_internal_domain_auth) Register(ctx context.Context, pld *auth.Register) (string, error) {
 	ret := _m.Called(ctx, pld)
@@ -115,6 +195,58 @@ func (_m *Repository_internal_domain_auth) Register(ctx context.Context, pld *au
 	return r0, r1
 }
 
+// SetEnabled provides a mock function with given fields: ctx, pld, enabled
+func (_m *Repository_internal_domain_auth) SetEnabled(ctx context.Context, pld *auth.GetUserID, enabled bool) (*auth.UserRepresentation, error) {
+	ret := _m.Called(ctx, pld, enabled)
+
+	var r0 *auth.UserRepresentation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.GetUserID, bool) (*auth.UserRepresentation, error)); ok {
+		return rf(ctx, pld, enabled)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.GetUserID, bool) *auth.UserRepresentation); ok {
+		r0 = rf(ctx, pld, enabled)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.UserRepresentation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *auth.GetUserID, bool) error); ok {
+		r1 = rf(ctx, pld, enabled)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateEmail provides a mock function with given fields: ctx, pld
+func (_m *Repository_internal_domain_auth) UpdateEmail(ctx context.Context, pld *auth.UpdateEmail) (*auth.UserRepresentation, error) {
+	ret := _m.Called(ctx, pld)
+
+	var r0 *auth.UserRepresentation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.UpdateEmail) (*auth.UserRepresentation, error)); ok {
+		return rf(ctx, pld)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.UpdateEmail) *auth.UserRepresentation); ok {
+		r0 = rf(ctx, pld)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.UserRepresentation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *auth.UpdateEmail) error); ok {
+		r1 = rf(ctx, pld)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewRepository_internal_domain_auth creates a new instance of Repository_internal_domain_auth. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRepository_internal_domain_auth(t interface {
@@ -10,6 +10,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	pb "github.com/lucasd-coder/fast-feet/auth-service/pkg/pb"
+
+	time "time"
 )
 
 // Service_internal_domain_auth is an autogenerated mock type for the Service type
@@ -43,6 +45,84 @@ func (_m *Service_internal_domain_auth) CreateUser(ctx context.Context, pld *aut
 	return r0, r1
 }
 
+// DeleteUser provides a mock function with given fields: ctx, pld
+func (_m *Service_internal_domain_auth) DeleteUser(ctx context.Context, pld *auth.GetUserID) (*pb.EmptyResponse, error) {
+	ret := _m.Called(ctx, pld)
+
+	var r0 *pb.EmptyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.GetUserID) (*pb.EmptyResponse, error)); ok {
+		return rf(ctx, pld)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.GetUserID) *pb.EmptyResponse); ok {
+		r0 = rf(ctx, pld)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.EmptyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *auth.GetUserID) error); ok {
+		r1 = rf(ctx, pld)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableUser provides a mock function with given fields: ctx, pld
+func (_m *Service_internal_domain_auth) DisableUser(ctx context.Context, pld *auth.GetUserID) (*pb.GetUserResponse, error) {
+	ret := _m.Called(ctx, pld)
+
+	var r0 *pb.GetUserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.GetUserID) (*pb.GetUserResponse, error)); ok {
+		return rf(ctx, pld)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.GetUserID) *pb.GetUserResponse); ok {
+		r0 = rf(ctx, pld)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetUserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *auth.GetUserID) error); ok {
+		r1 = rf(ctx, pld)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnableUser provides a mock function with given fields: ctx, pld
+func (_m *Service_internal_domain_auth) EnableUser(ctx context.Context, pld *auth.GetUserID) (*pb.GetUserResponse, error) {
+	ret := _m.Called(ctx, pld)
+
+	var r0 *pb.GetUserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.GetUserID) (*pb.GetUserResponse, error)); ok {
+		return rf(ctx, pld)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.GetUserID) *pb.GetUserResponse); ok {
+		r0 = rf(ctx, pld)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetUserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *auth.GetUserID) error); ok {
+		r1 = rf(ctx, pld)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindUserByEmail provides a mock function with given fields: ctx, pld
 func (_m *Service_internal_domain_auth) FindUserByEmail(ctx context.Context, pld *auth.FindUserByEmail) (*pb.GetUserResponse, error) {
 	ret := _m.Called(ctx, pld)
@@ -69,6 +149,58 @@ func (_m *Service_internal_domain_auth) FindUserByEmail(ctx context.Context, pld
 	return r0, r1
 }
 
+// GetAccount provides a mock function with given fields: ctx, pld
+func (_m *Service_internal_domain_auth) GetAccount(ctx context.Context, pld *auth.GetUserID) (*pb.GetAccountResponse, error) {
+	ret := _m.Called(ctx, pld)
+
+	var r0 *pb.GetAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.GetUserID) (*pb.GetAccountResponse, error)); ok {
+		return rf(ctx, pld)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.GetUserID) *pb.GetAccountResponse); ok {
+		r0 = rf(ctx, pld)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *auth.GetUserID) error); ok {
+		r1 = rf(ctx, pld)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccounts provides a mock function with given fields: ctx, pld
+func (_m *Service_internal_domain_auth) GetAccounts(ctx context.Context, pld *auth.GetUserIDs) (*pb.GetAccountsResponse, error) {
+	ret := _m.Called(ctx, pld)
+
+	var r0 *pb.GetAccountsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.GetUserIDs) (*pb.GetAccountsResponse, error)); ok {
+		return rf(ctx, pld)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.GetUserIDs) *pb.GetAccountsResponse); ok {
+		r0 = rf(ctx, pld)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetAccountsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *auth.GetUserIDs) error); ok {
+		r1 = rf(ctx, pld)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRoles provides a mock function with given fields: ctx, pld
 func (_m *Service_internal_domain_auth) GetRoles(ctx context.Context, pld *auth.GetUserID) (*pb.GetRolesResponse, error) {
 	ret := _m.Called(ctx, pld)
@@ -121,6 +253,46 @@ func (_m *Service_internal_domain_auth) IsActiveUser(ctx context.Context, pld *a
 	return r0, r1
 }
 
+// PublishPendingUserStatus provides a mock function with given fields: ctx, before, limit
+func (_m *Service_internal_domain_auth) PublishPendingUserStatus(ctx context.Context, before time.Time, limit int) error {
+	ret := _m.Called(ctx, before, limit)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) error); ok {
+		r0 = rf(ctx, before, limit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateEmail provides a mock function with given fields: ctx, pld
+func (_m *Service_internal_domain_auth) UpdateEmail(ctx context.Context, pld *auth.UpdateEmail) (*pb.GetUserResponse, error) {
+	ret := _m.Called(ctx, pld)
+
+	var r0 *pb.GetUserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.UpdateEmail) (*pb.GetUserResponse, error)); ok {
+		return rf(ctx, pld)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.UpdateEmail) *pb.GetUserResponse); ok {
+		r0 = rf(ctx, pld)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetUserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *auth.UpdateEmail) error); ok {
+		r1 = rf(ctx, pld)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewService_internal_domain_auth creates a new instance of Service_internal_domain_auth. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewService_internal_domain_auth(t interface {
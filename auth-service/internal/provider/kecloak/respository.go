@@ -2,8 +2,11 @@ package kecloak
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/Nerzal/gocloak/v13"
 	"github.com/go-resty/resty/v2"
@@ -136,6 +139,207 @@ func (r *Repository) IsActiveUser(ctx context.Context, pld *auth.GetUserID) (boo
 	return *user.Enabled, nil
 }
 
+func (r *Repository) FindUserByID(ctx context.Context, pld *auth.GetUserID) (*auth.UserRepresentation, error) {
+	user, err := r.findUserByID(ctx, pld.ID)
+	if err != nil {
+		r.createSpanError(ctx, err, spanErrRequest)
+		return nil, err
+	}
+
+	return &auth.UserRepresentation{
+		ID:               gocloak.PString(user.ID),
+		Username:         gocloak.PString(user.Username),
+		Enabled:          gocloak.PBool(user.Enabled),
+		Email:            gocloak.PString(user.Email),
+		CreatedTimestamp: gocloak.PInt64(user.CreatedTimestamp),
+	}, nil
+}
+
+// FindAccounts logs in once and fetches the accounts and their roles with at
+// most KeyCloakBatchConcurrency requests in flight. Ids without an account
+// are left out.
+func (r *Repository) FindAccounts(ctx context.Context, pld *auth.GetUserIDs) ([]auth.Account, error) {
+	client := NewClient(ctx, r.Config)
+	token, err := client.LoginAdmin(ctx, r.Config.KeyCloakUsername, r.Config.KeyCloakPassword, r.Config.KeyCloakRealm)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*auth.Account, len(pld.IDs))
+	errs := make([]error, len(pld.IDs))
+
+	sem := make(chan struct{}, max(r.Config.KeyCloakBatchConcurrency, 1))
+	var wg sync.WaitGroup
+
+	for i, id := range pld.IDs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, id string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			accounts[i], errs[i] = r.findAccount(ctx, client, token.AccessToken, id)
+		}(i, id)
+	}
+
+	wg.Wait()
+
+	result := make([]auth.Account, 0, len(pld.IDs))
+	for i := range pld.IDs {
+		var apiErr *gocloak.APIError
+		switch {
+		case errors.As(errs[i], &apiErr) && apiErr.Code == http.StatusNotFound:
+			continue
+		case errs[i] != nil:
+			r.createSpanError(ctx, errs[i], spanErrRequest)
+			return nil, errs[i]
+		}
+		result = append(result, *accounts[i])
+	}
+
+	return result, nil
+}
+
+func (r *Repository) findAccount(ctx context.Context, client *gocloak.GoCloak,
+	accessToken, userID string) (*auth.Account, error) {
+	user, err := client.GetUserByID(ctx, accessToken, r.Config.KeyCloakRealm, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := client.GetRealmRolesByUserID(ctx, accessToken, r.Config.KeyCloakRealm, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := extractRoles(roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.Account{
+		UserRepresentation: auth.UserRepresentation{
+			ID:               gocloak.PString(user.ID),
+			Username:         gocloak.PString(user.Username),
+			Enabled:          gocloak.PBool(user.Enabled),
+			Email:            gocloak.PString(user.Email),
+			CreatedTimestamp: gocloak.PInt64(user.CreatedTimestamp),
+		},
+		Roles: names,
+	}, nil
+}
+
+// UpdateEmail changes the email and the username, which mirrors the email
+// since Register.
+func (r *Repository) UpdateEmail(ctx context.Context, pld *auth.UpdateEmail) (*auth.UserRepresentation, error) {
+	client := NewClient(ctx, r.Config)
+	token, err := client.LoginAdmin(ctx, r.Config.KeyCloakUsername, r.Config.KeyCloakPassword, r.Config.KeyCloakRealm)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := client.GetUserByID(ctx, token.AccessToken, r.Config.KeyCloakRealm, pld.ID)
+	if err != nil {
+		r.createSpanError(ctx, err, spanErrRequest)
+		return nil, err
+	}
+
+	user.Email = gocloak.StringP(pld.Email)
+	user.Username = gocloak.StringP(pld.Email)
+
+	if err := client.UpdateUser(ctx, token.AccessToken, r.Config.KeyCloakRealm, *user); err != nil {
+		r.createSpanError(ctx, err, spanErrRequest)
+		return nil, err
+	}
+
+	return &auth.UserRepresentation{
+		ID:       gocloak.PString(user.ID),
+		Username: gocloak.PString(user.Username),
+		Enabled:  gocloak.PBool(user.Enabled),
+		Email:    gocloak.PString(user.Email),
+	}, nil
+}
+
+// DeleteUser disables the account first, so its tokens stop being accepted
+// even if the delete fails, and then deletes it.
+func (r *Repository) DeleteUser(ctx context.Context, pld *auth.GetUserID) error {
+	client := NewClient(ctx, r.Config)
+	token, err := client.LoginAdmin(ctx, r.Config.KeyCloakUsername, r.Config.KeyCloakPassword, r.Config.KeyCloakRealm)
+	if err != nil {
+		return err
+	}
+
+	user, err := client.GetUserByID(ctx, token.AccessToken, r.Config.KeyCloakRealm, pld.ID)
+	if err != nil {
+		r.createSpanError(ctx, err, spanErrRequest)
+		return err
+	}
+
+	if gocloak.PBool(user.Enabled) {
+		user.Enabled = gocloak.BoolP(false)
+		if err := client.UpdateUser(ctx, token.AccessToken, r.Config.KeyCloakRealm, *user); err != nil {
+			r.createSpanError(ctx, err, spanErrRequest)
+			return err
+		}
+	}
+
+	if err := client.DeleteUser(ctx, token.AccessToken, r.Config.KeyCloakRealm, pld.ID); err != nil {
+		r.createSpanError(ctx, err, spanErrRequest)
+		return err
+	}
+
+	return nil
+}
+
+// SetEnabled updates the enabled flag of the account, an account already in
+// that state is left untouched.
+func (r *Repository) SetEnabled(ctx context.Context, pld *auth.GetUserID, enabled bool) (*auth.UserRepresentation, error) {
+	client := NewClient(ctx, r.Config)
+	token, err := client.LoginAdmin(ctx, r.Config.KeyCloakUsername, r.Config.KeyCloakPassword, r.Config.KeyCloakRealm)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := client.GetUserByID(ctx, token.AccessToken, r.Config.KeyCloakRealm, pld.ID)
+	if err != nil {
+		r.createSpanError(ctx, err, spanErrRequest)
+		return nil, err
+	}
+
+	if gocloak.PBool(user.Enabled) != enabled {
+		user.Enabled = gocloak.BoolP(enabled)
+		if err := client.UpdateUser(ctx, token.AccessToken, r.Config.KeyCloakRealm, *user); err != nil {
+			r.createSpanError(ctx, err, spanErrRequest)
+			return nil, err
+		}
+	}
+
+	return &auth.UserRepresentation{
+		ID:               gocloak.PString(user.ID),
+		Username:         gocloak.PString(user.Username),
+		Enabled:          enabled,
+		Email:            gocloak.PString(user.Email),
+		CreatedTimestamp: gocloak.PInt64(user.CreatedTimestamp),
+	}, nil
+}
+
+// LogoutAllSessions revokes every active session of the account.
+func (r *Repository) LogoutAllSessions(ctx context.Context, pld *auth.GetUserID) error {
+	client := NewClient(ctx, r.Config)
+	token, err := client.LoginAdmin(ctx, r.Config.KeyCloakUsername, r.Config.KeyCloakPassword, r.Config.KeyCloakRealm)
+	if err != nil {
+		return err
+	}
+
+	if err := client.LogoutAllSessions(ctx, token.AccessToken, r.Config.KeyCloakRealm, pld.ID); err != nil {
+		r.createSpanError(ctx, err, spanErrRequest)
+		return err
+	}
+
+	return nil
+}
+
 func (r *Repository) addRealmRoleToUser(ctx context.Context, userID string, roles []string) error {
 	client := NewClient(ctx, r.Config)
 
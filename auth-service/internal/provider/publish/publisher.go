@@ -0,0 +1,144 @@
+package publish
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/auth-service/config"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/domain/auth"
+	"github.com/lucasd-coder/fast-feet/pkg/envelope"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"gocloud.dev/pubsub"
+
+	// revive
+	_ "gocloud.dev/pubsub/mempubsub"
+	// revive
+	_ "gocloud.dev/pubsub/rabbitpubsub"
+)
+
+var publisher *Publisher
+
+var ErrPublisherClosed = errors.New("publisher is closed")
+
+// Publisher sends the user status events. The topic is opened on first use
+// and kept open, a failed send drops it so the next one reconnects.
+type Publisher struct {
+	mu     sync.Mutex
+	cfg    *config.Config
+	topic  *pubsub.Topic
+	closed bool
+}
+
+func NewPublisher(cfg *config.Config) *Publisher {
+	return &Publisher{cfg: cfg}
+}
+
+func SetUpPublisher(cfg *config.Config) {
+	publisher = NewPublisher(cfg)
+}
+
+func GetPublisher() *Publisher {
+	return publisher
+}
+
+func ClosePublisher(ctx context.Context) error {
+	return publisher.Shutdown(ctx)
+}
+
+func (p *Publisher) PublishUserStatus(ctx context.Context, event *auth.UserStatusEvent) error {
+	log := logger.FromContext(ctx)
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("fail json.Marshal err: %w", err)
+	}
+
+	metadata := envelope.New(ctx, auth.UserStatusEventType, auth.UserStatusEventVersion,
+		envelope.ContentTypeJSON).Metadata(nil)
+	envelope.Inject(ctx, metadata)
+
+	m := &pubsub.Message{
+		Body:     body,
+		Metadata: metadata,
+	}
+
+	opt := p.cfg.TopicUserStatusEvents
+
+	for i := 0; ; i++ {
+		err = p.send(ctx, m)
+		if err == nil || errors.Is(err, ErrPublisherClosed) || i >= opt.MaxRetries-1 {
+			return err
+		}
+
+		backOffTime := time.Duration(1+i) * opt.WaitingTime
+		log.Errorf("error when trying to publish user status, retrying in %v err: %v", backOffTime, err)
+		time.Sleep(backOffTime)
+	}
+}
+
+func (p *Publisher) send(ctx context.Context, m *pubsub.Message) error {
+	topic, err := p.open(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := topic.Send(ctx, m); err != nil {
+		p.drop(ctx, topic)
+		return err
+	}
+
+	return nil
+}
+
+func (p *Publisher) open(ctx context.Context) (*pubsub.Topic, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return nil, ErrPublisherClosed
+	}
+
+	if p.topic == nil {
+		topic, err := pubsub.OpenTopic(ctx, p.cfg.TopicUserStatusEvents.URL)
+		if err != nil {
+			return nil, fmt.Errorf("fail open topic: %s err: %w", p.cfg.TopicUserStatusEvents.URL, err)
+		}
+		p.topic = topic
+	}
+
+	return p.topic, nil
+}
+
+func (p *Publisher) drop(ctx context.Context, topic *pubsub.Topic) {
+	p.mu.Lock()
+	if p.topic != topic {
+		p.mu.Unlock()
+		return
+	}
+	p.topic = nil
+	p.mu.Unlock()
+
+	if err := topic.Shutdown(context.WithoutCancel(ctx)); err != nil {
+		logger.FromContext(ctx).Errorf("error closing broken topic err: %v", err)
+	}
+}
+
+// Shutdown flushes the pending messages and closes the topic.
+func (p *Publisher) Shutdown(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+	if p.topic == nil {
+		return nil
+	}
+
+	topic := p.topic
+	p.topic = nil
+
+	return topic.Shutdown(ctx)
+}
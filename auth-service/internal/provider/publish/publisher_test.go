@@ -0,0 +1,76 @@
+package publish_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/auth-service/config"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/domain/auth"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/provider/publish"
+	"github.com/lucasd-coder/fast-feet/pkg/envelope"
+	"github.com/stretchr/testify/suite"
+	"gocloud.dev/pubsub"
+)
+
+const topicURL = "mem://user-status-events"
+
+type PublisherSuite struct {
+	suite.Suite
+	ctx       context.Context
+	cfg       *config.Config
+	topic     *pubsub.Topic
+	publisher *publish.Publisher
+}
+
+func (suite *PublisherSuite) SetupTest() {
+	suite.ctx = context.Background()
+
+	suite.cfg = &config.Config{}
+	suite.cfg.TopicUserStatusEvents.URL = topicURL
+	suite.cfg.TopicUserStatusEvents.MaxRetries = 1
+
+	// mempubsub subscriptions can only be opened on existing topics, the
+	// opener caches it so the publisher shuts it down.
+	var err error
+	suite.topic, err = pubsub.OpenTopic(suite.ctx, topicURL)
+	suite.Require().NoError(err)
+
+	suite.publisher = publish.NewPublisher(suite.cfg)
+}
+
+func (suite *PublisherSuite) TestPublishUserStatus() {
+	sub, err := pubsub.OpenSubscription(suite.ctx, topicURL)
+	suite.Require().NoError(err)
+	defer sub.Shutdown(suite.ctx)
+
+	event := &auth.UserStatusEvent{
+		UserID:     "433c311b-93a5-45c3-99c9-b52f3c4aef4f",
+		OccurredAt: time.Now().UTC(),
+	}
+
+	suite.Require().NoError(suite.publisher.PublishUserStatus(suite.ctx, event))
+	suite.Require().NoError(suite.publisher.Shutdown(suite.ctx))
+
+	msg, err := sub.Receive(suite.ctx)
+	suite.Require().NoError(err)
+	msg.Ack()
+
+	var got auth.UserStatusEvent
+	suite.NoError(json.Unmarshal(msg.Body, &got))
+	suite.Equal(event.UserID, got.UserID)
+	suite.False(got.Enabled)
+
+	env := envelope.FromMetadata(msg.Metadata)
+	suite.Equal(auth.UserStatusEventType, env.Type)
+	suite.Equal(envelope.ContentTypeJSON, env.ContentType)
+	suite.NotEmpty(env.ID)
+
+	err = suite.publisher.PublishUserStatus(suite.ctx, event)
+	suite.ErrorIs(err, publish.ErrPublisherClosed)
+}
+
+func TestPublisherSuite(t *testing.T) {
+	suite.Run(t, new(PublisherSuite))
+}
@@ -0,0 +1,47 @@
+package publish
+
+import (
+	"context"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/auth-service/config"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+)
+
+// Worker publishes the user status events left pending in the outbox, the
+// ones the request that changed the status failed to publish.
+type Worker struct {
+	cfg            *config.Config
+	publishPending func(ctx context.Context, before time.Time, limit int) error
+}
+
+func NewWorker(cfg *config.Config,
+	publishPending func(ctx context.Context, before time.Time, limit int) error) *Worker {
+	return &Worker{
+		cfg:            cfg,
+		publishPending: publishPending,
+	}
+}
+
+func (w *Worker) Start(ctx context.Context) {
+	log := logger.FromContext(ctx)
+	opt := w.cfg.TopicUserStatusEvents.Reconcile
+
+	log.Infof("User status worker has been started.... interval: %s", opt.Interval)
+
+	ticker := time.NewTicker(opt.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("context cancelled, stopping User status worker...")
+			return
+		case <-ticker.C:
+			// a change newer than After may still be published by its request.
+			if err := w.publishPending(ctx, time.Now().Add(-opt.After), opt.BatchSize); err != nil {
+				log.Errorf("error publishing pending user status: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package statusstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/domain/auth"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	pendingKey  = "user-status:pending"
+	eventKeyFmt = "user-status:%s"
+)
+
+// Repository is the outbox of the user status events in Redis: a key with
+// the latest event of each user and a sorted set of the pending users by the
+// time of their change. A newer change replaces the pending event of the user.
+type Repository struct {
+	client *redis.Client
+}
+
+func NewRepository(client *redis.Client) *Repository {
+	return &Repository{
+		client: client,
+	}
+}
+
+func (r *Repository) Save(ctx context.Context, event *auth.UserStatusEvent) error {
+	val, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("fail json.Marshal err: %w", err)
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, eventKey(event.UserID), val, 0)
+		pipe.ZAdd(ctx, pendingKey, redis.Z{Score: score(event.OccurredAt), Member: event.UserID})
+		return nil
+	})
+	return err
+}
+
+// FindPending returns up to limit events changed before the given time, the
+// oldest first.
+func (r *Repository) FindPending(ctx context.Context, before time.Time, limit int) ([]*auth.UserStatusEvent, error) {
+	ids, err := r.client.ZRangeByScore(ctx, pendingKey, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatFloat(score(before), 'f', -1, 64),
+		Count: int64(limit),
+	}).Result()
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, eventKey(id))
+	}
+
+	vals, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*auth.UserStatusEvent, 0, len(vals))
+	for _, val := range vals {
+		s, ok := val.(string)
+		if !ok {
+			continue
+		}
+
+		var event auth.UserStatusEvent
+		if err := json.Unmarshal([]byte(s), &event); err != nil {
+			return nil, fmt.Errorf("fail json.Unmarshal err: %w", err)
+		}
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
+
+// Delete removes the event once published, unless a newer change of the user
+// replaced it meanwhile.
+func (r *Repository) Delete(ctx context.Context, event *auth.UserStatusEvent) error {
+	key := eventKey(event.UserID)
+
+	return r.client.Watch(ctx, func(tx *redis.Tx) error {
+		cur, err := tx.Get(ctx, key).Bytes()
+		if errors.Is(err, redis.Nil) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var stored auth.UserStatusEvent
+		if err := json.Unmarshal(cur, &stored); err != nil {
+			return fmt.Errorf("fail json.Unmarshal err: %w", err)
+		}
+
+		if !stored.OccurredAt.Equal(event.OccurredAt) {
+			return nil
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Del(ctx, key)
+			pipe.ZRem(ctx, pendingKey, event.UserID)
+			return nil
+		})
+		if errors.Is(err, redis.TxFailedErr) {
+			return nil
+		}
+		return err
+	}, key)
+}
+
+func score(t time.Time) float64 {
+	return float64(t.UnixMilli())
+}
+
+func eventKey(userID string) string {
+	return fmt.Sprintf(eventKeyFmt, userID)
+}
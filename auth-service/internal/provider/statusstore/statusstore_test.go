@@ -0,0 +1,92 @@
+package statusstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/domain/auth"
+	"github.com/lucasd-coder/fast-feet/auth-service/internal/provider/statusstore"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+const userID = "433c311b-93a5-45c3-99c9-b52f3c4aef4f"
+
+type StatusStoreSuite struct {
+	suite.Suite
+	ctx         context.Context
+	redisServer *miniredis.Miniredis
+	repo        *statusstore.Repository
+	changedAt   time.Time
+}
+
+func (suite *StatusStoreSuite) SetupTest() {
+	suite.ctx = context.Background()
+
+	var err error
+	suite.redisServer, err = miniredis.Run()
+	suite.Require().NoError(err)
+
+	client := redis.NewClient(&redis.Options{Addr: suite.redisServer.Addr()})
+	suite.repo = statusstore.NewRepository(client)
+	suite.changedAt = time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+}
+
+func (suite *StatusStoreSuite) TearDownTest() {
+	suite.redisServer.Close()
+}
+
+func (suite *StatusStoreSuite) event(enabled bool, occurredAt time.Time) *auth.UserStatusEvent {
+	return &auth.UserStatusEvent{UserID: userID, Enabled: enabled, OccurredAt: occurredAt}
+}
+
+func (suite *StatusStoreSuite) TestFindPendingBefore() {
+	suite.Require().NoError(suite.repo.Save(suite.ctx, suite.event(false, suite.changedAt)))
+
+	events, err := suite.repo.FindPending(suite.ctx, suite.changedAt.Add(-time.Second), 10)
+	suite.NoError(err)
+	suite.Empty(events)
+
+	events, err = suite.repo.FindPending(suite.ctx, suite.changedAt.Add(time.Second), 10)
+	suite.NoError(err)
+	suite.Equal([]*auth.UserStatusEvent{suite.event(false, suite.changedAt)}, events)
+}
+
+func (suite *StatusStoreSuite) TestNewerChangeReplacesPending() {
+	newer := suite.event(true, suite.changedAt.Add(time.Minute))
+	suite.Require().NoError(suite.repo.Save(suite.ctx, suite.event(false, suite.changedAt)))
+	suite.Require().NoError(suite.repo.Save(suite.ctx, newer))
+
+	events, err := suite.repo.FindPending(suite.ctx, suite.changedAt.Add(time.Hour), 10)
+	suite.NoError(err)
+	suite.Equal([]*auth.UserStatusEvent{newer}, events)
+}
+
+func (suite *StatusStoreSuite) TestDelete() {
+	event := suite.event(false, suite.changedAt)
+	suite.Require().NoError(suite.repo.Save(suite.ctx, event))
+
+	suite.NoError(suite.repo.Delete(suite.ctx, event))
+
+	events, err := suite.repo.FindPending(suite.ctx, suite.changedAt.Add(time.Hour), 10)
+	suite.NoError(err)
+	suite.Empty(events)
+}
+
+func (suite *StatusStoreSuite) TestDeleteKeepsNewerChange() {
+	newer := suite.event(true, suite.changedAt.Add(time.Minute))
+	suite.Require().NoError(suite.repo.Save(suite.ctx, suite.event(false, suite.changedAt)))
+	suite.Require().NoError(suite.repo.Save(suite.ctx, newer))
+
+	suite.NoError(suite.repo.Delete(suite.ctx, suite.event(false, suite.changedAt)))
+
+	events, err := suite.repo.FindPending(suite.ctx, suite.changedAt.Add(time.Hour), 10)
+	suite.NoError(err)
+	suite.Equal([]*auth.UserStatusEvent{newer}, events)
+}
+
+func TestStatusStoreSuite(t *testing.T) {
+	suite.Run(t, new(StatusStoreSuite))
+}
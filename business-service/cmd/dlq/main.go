@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/dlq"
+)
+
+const usage = `fastfeet-dlq inspects and replays business-service dead-lettered messages.
+
+Usage:
+  fastfeet-dlq <list|replay|purge> [flags]
+
+Flags:
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	command := os.Args[1]
+
+	fs := flag.NewFlagSet(command, flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		fs.PrintDefaults()
+	}
+
+	queue := fs.String("queue", "user", "dead-letter queue from the config: user, order or user-status")
+	url := fs.String("url", "", "dead-letter url, overrides -queue")
+	target := fs.String("target", "", "url to replay to, defaults to the x-dead-letter-source-topic header")
+	ids := fs.String("ids", "", "comma separated message ids (or prefixes) to replay or purge, defaults to all")
+	limit := fs.Int("limit", 100, "maximum number of messages to read, 0 for no limit")
+	wait := fs.Duration("wait", 2*time.Second, "time to wait for a message before stopping")
+	dryRun := fs.Bool("dry-run", false, "show what would be replayed or purged without doing it")
+
+	if err := fs.Parse(os.Args[2:]); err != nil {
+		log.Fatalf("flag error: %v", err)
+	}
+
+	cfg := loadConfig()
+
+	deadLetterURL := *url
+	if deadLetterURL == "" {
+		switch *queue {
+		case "user":
+			deadLetterURL = cfg.QueueUserEvents.DeadLetterURL
+		case "order":
+			deadLetterURL = cfg.QueueOrderEvents.DeadLetterURL
+		case "user-status":
+			deadLetterURL = cfg.QueueUserStatusEvents.DeadLetterURL
+		default:
+			log.Fatalf("unknown queue: %s", *queue)
+		}
+	}
+
+	opt := &dlq.Options{
+		DeadLetterURL: deadLetterURL,
+		TargetURL:     *target,
+		AesKeys:       cfg.AesKeys(),
+		AesKey:        cfg.AesKey,
+		Limit:         *limit,
+		Wait:          *wait,
+		DryRun:        *dryRun,
+	}
+	if *ids != "" {
+		opt.IDs = strings.Split(*ids, ",")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	sub, err := dlq.NewClient(ctx, opt)
+	if err != nil {
+		log.Fatalf("error creating subscription client: %v, for queueURL: %s", err, opt.DeadLetterURL)
+	}
+
+	inspector := dlq.NewInspector(opt, sub)
+
+	var entries []*dlq.Entry
+
+	switch command {
+	case "list":
+		entries, err = inspector.List(ctx)
+	case "replay":
+		entries, err = inspector.Replay(ctx)
+	case "purge":
+		entries, err = inspector.Purge(ctx)
+	default:
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	for _, entry := range entries {
+		if err := enc.Encode(entry); err != nil {
+			log.Fatalf("encode error: %v", err)
+		}
+	}
+
+	if shutdownErr := sub.Shutdown(context.WithoutCancel(ctx)); shutdownErr != nil {
+		log.Printf("error client for queueURL: %s, shutdown: %v", opt.DeadLetterURL, shutdownErr)
+	}
+
+	if err != nil {
+		log.Fatalf("%s error: %v", command, err)
+	}
+}
+
+func loadConfig() *config.Config {
+	var cfg config.Config
+
+	path := "./config/config.yml"
+	if os.Getenv("GO_PROFILE") == "dev" {
+		path = "./config/config-dev.yml"
+	}
+
+	if err := cleanenv.ReadConfig(path, &cfg); err != nil {
+		log.Fatalf("Config error: %v", err)
+	}
+
+	return &cfg
+}
@@ -6,17 +6,53 @@ var cfg *Config
 
 type (
 	Config struct {
-		App         `yaml:"app"`
-		GRPC        `yaml:"grpc"`
-		HTTP        `yaml:"http"`
-		Log         `yaml:"logger"`
-		Integration `yaml:"integration"`
+		App          `yaml:"app"`
+		GRPC         `yaml:"grpc"`
+		HTTP         `yaml:"http"`
+		Log          `yaml:"logger"`
+		Integration  `yaml:"integration"`
+		Scheduler    `yaml:"scheduler"`
+		Erasure      `yaml:"erasure"`
+		Registration `yaml:"registration"`
+	}
+
+	// Scheduler fires the scheduled orders, a failed order is fired again
+	// once its Lease expires.
+	Scheduler struct {
+		PollInterval time.Duration `yaml:"poll-interval" env-default:"1s"`
+		Lease        time.Duration `yaml:"lease" env-default:"1m"`
+		BatchSize    int64         `yaml:"batch-size" env-default:"100"`
+		MaxAttempts  int           `yaml:"max-attempts" env-default:"5"`
+	}
+
+	// Erasure runs the pending right-to-erasure requests, a failed one runs
+	// again from the failed step once its Lease expires.
+	Erasure struct {
+		PollInterval time.Duration `yaml:"poll-interval" env-default:"5s"`
+		Lease        time.Duration `yaml:"lease" env-default:"1m"`
+		BatchSize    int64         `yaml:"batch-size" env-default:"10"`
+	}
+
+	// Registration compensates the failed user registrations, a failed
+	// compensation runs again once its Lease expires. A registration is held
+	// by the request running it for AttemptLease. Finished registrations are
+	// kept for Retention.
+	Registration struct {
+		PollInterval time.Duration `yaml:"poll-interval" env-default:"5s"`
+		Lease        time.Duration `yaml:"lease" env-default:"1m"`
+		AttemptLease time.Duration `yaml:"attempt-lease" env-default:"2m"`
+		BatchSize    int64         `yaml:"batch-size" env-default:"10"`
+		Retention    time.Duration `yaml:"retention" env-default:"168h"`
 	}
 
 	App struct {
-		Name    string `env-required:"true" yaml:"name"    env:"APP_NAME"`
-		Version string `env-required:"true" yaml:"version" env:"APP_VERSION"`
-		AesKey  string `env-required:"true" yaml:"aes-key" env:"AES_KEY"`
+		Name     string `env-required:"true" yaml:"name"    env:"APP_NAME"`
+		Version  string `env-required:"true" yaml:"version" env:"APP_VERSION"`
+		AesKey   string `env-required:"true" yaml:"aes-key" env:"AES_KEY"`
+		AesKeyID string `yaml:"aes-key-id" env:"AES_KEY_ID" env-default:"v1"`
+		// AesPreviousKeys keeps retired keys by ID, e.g. "v0:secret", until no
+		// message encrypted with them is left in the queues.
+		AesPreviousKeys map[string]string `yaml:"aes-previous-keys" env:"AES_PREVIOUS_KEYS"`
 	}
 
 	Log struct {
@@ -45,6 +81,15 @@ type (
 		UserManagerService `env-required:"true" yaml:"user-manager-service"`
 		OrderDataService   `env-required:"true" yaml:"order-data-service"`
 		AuthService        `env-required:"true" yaml:"auth-service"`
+		CircuitBreaker     `yaml:"circuit-breaker"`
+	}
+
+	// CircuitBreaker opens after MaxFailures consecutive unavailable calls to
+	// a service and lets a trial call through after OpenTimeout.
+	CircuitBreaker struct {
+		MaxFailures uint32        `yaml:"max-failures" env-default:"5"`
+		OpenTimeout time.Duration `yaml:"open-timeout" env-default:"30s"`
+		Interval    time.Duration `yaml:"interval" env-default:"60s"`
 	}
 
 	UserManagerService struct {
@@ -71,11 +116,15 @@ type (
 	RabbitMQ struct {
 		Queue           `env-required:"true" yaml:"queue"`
 		RabbitServerURL string `env-required:"true" env:"RABBIT_SERVER_URL"`
+		// ManagementURL enables the queue depth metric, e.g. http://localhost:15672.
+		ManagementURL      string        `yaml:"management-url" env:"RABBIT_MANAGEMENT_URL"`
+		QueueDepthInterval time.Duration `yaml:"queue-depth-interval" env-default:"15s"`
 	}
 
 	Queue struct {
-		QueueUserEvents  `env-required:"true" yaml:"user-events"`
-		QueueOrderEvents `env-required:"true" yaml:"order-events"`
+		QueueUserEvents       `env-required:"true" yaml:"user-events"`
+		QueueOrderEvents      `env-required:"true" yaml:"order-events"`
+		QueueUserStatusEvents `env-required:"true" yaml:"user-status-events"`
 	}
 
 	HTTPClint struct {
@@ -84,22 +133,61 @@ type (
 
 	QueueUserEvents struct {
 		QueueURL                 string        `env-required:"true" yaml:"url"`
+		DeadLetterURL            string        `yaml:"dead-letter-url"`
+		RetryURL                 string        `yaml:"retry-url"`
 		MaxReceiveMessage        time.Duration `yaml:"max-receive-message" env-default:"60s"`
 		MaxRetries               int           `yaml:"max-retries" env-default:"5"`
 		MaxConcurrentMessages    int           `yaml:"max-concurrent-messages" env-default:"10"`
 		NumberOfMessageReceivers int           `yaml:"number-of-message-receivers" env-default:"2"`
 		PollDelay                time.Duration `yaml:"poll-delay-in-milliseconds" env-default:"100ms"`
 		WaitingTime              time.Duration `yaml:"waiting-time" env-default:"2s"`
+		MaxBackoff               time.Duration `yaml:"max-backoff" env-default:"5m"`
+		DrainTimeout             time.Duration `yaml:"drain-timeout" env-default:"25s"`
+		DedupTTL                 time.Duration `yaml:"dedup-ttl" env-default:"24h"`
+		DedupProcessingTTL       time.Duration `yaml:"dedup-processing-ttl" env-default:"2m"`
+		OrderingKey              string        `yaml:"ordering-key"`
+		Exchange                 string        `yaml:"exchange"`
+		MaxPriority              int           `yaml:"max-priority"`
 	}
 
 	QueueOrderEvents struct {
 		QueueURL                 string        `env-required:"true" yaml:"url"`
+		DeadLetterURL            string        `yaml:"dead-letter-url"`
+		RetryURL                 string        `yaml:"retry-url"`
+		MaxReceiveMessage        time.Duration `yaml:"max-receive-message" env-default:"60s"`
+		MaxRetries               int           `yaml:"max-retries" env-default:"5"`
+		MaxConcurrentMessages    int           `yaml:"max-concurrent-messages" env-default:"10"`
+		NumberOfMessageReceivers int           `yaml:"number-of-message-receivers" env-default:"2"`
+		PollDelay                time.Duration `yaml:"poll-delay-in-milliseconds" env-default:"100ms"`
+		WaitingTime              time.Duration `yaml:"waiting-time" env-default:"2s"`
+		MaxBackoff               time.Duration `yaml:"max-backoff" env-default:"5m"`
+		DrainTimeout             time.Duration `yaml:"drain-timeout" env-default:"25s"`
+		DedupTTL                 time.Duration `yaml:"dedup-ttl" env-default:"24h"`
+		DedupProcessingTTL       time.Duration `yaml:"dedup-processing-ttl" env-default:"2m"`
+		OrderingKey              string        `yaml:"ordering-key"`
+		Exchange                 string        `yaml:"exchange"`
+		MaxPriority              int           `yaml:"max-priority"`
+		BatchSize                int           `yaml:"batch-size" env-default:"1"`
+		BatchWindow              time.Duration `yaml:"batch-window" env-default:"200ms"`
+	}
+
+	QueueUserStatusEvents struct {
+		QueueURL                 string        `env-required:"true" yaml:"url"`
+		DeadLetterURL            string        `yaml:"dead-letter-url"`
+		RetryURL                 string        `yaml:"retry-url"`
 		MaxReceiveMessage        time.Duration `yaml:"max-receive-message" env-default:"60s"`
 		MaxRetries               int           `yaml:"max-retries" env-default:"5"`
 		MaxConcurrentMessages    int           `yaml:"max-concurrent-messages" env-default:"10"`
 		NumberOfMessageReceivers int           `yaml:"number-of-message-receivers" env-default:"2"`
 		PollDelay                time.Duration `yaml:"poll-delay-in-milliseconds" env-default:"100ms"`
 		WaitingTime              time.Duration `yaml:"waiting-time" env-default:"2s"`
+		MaxBackoff               time.Duration `yaml:"max-backoff" env-default:"5m"`
+		DrainTimeout             time.Duration `yaml:"drain-timeout" env-default:"25s"`
+		DedupTTL                 time.Duration `yaml:"dedup-ttl" env-default:"24h"`
+		DedupProcessingTTL       time.Duration `yaml:"dedup-processing-ttl" env-default:"2m"`
+		OrderingKey              string        `yaml:"ordering-key"`
+		Exchange                 string        `yaml:"exchange"`
+		MaxPriority              int           `yaml:"max-priority"`
 	}
 
 	ViaCep struct {
@@ -129,6 +217,16 @@ type (
 	}
 )
 
+// AesKeys returns every key able to decrypt user events, indexed by key ID.
+func (a *App) AesKeys() map[string]string {
+	keys := make(map[string]string, len(a.AesPreviousKeys)+1)
+	for id, key := range a.AesPreviousKeys {
+		keys[id] = key
+	}
+	keys[a.AesKeyID] = a.AesKey
+	return keys
+}
+
 func ExportConfig(config *Config) {
 	cfg = config
 }
@@ -10,6 +10,7 @@ import (
 	"os"
 	"os/signal"
 	"regexp"
+	"sync"
 	"syscall"
 
 	// revive
@@ -18,8 +19,16 @@ import (
 	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
 	grpcrecovery "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	"github.com/lucasd-coder/fast-feet/business-service/config"
+	erasureHandler "github.com/lucasd-coder/fast-feet/business-service/internal/domain/erasure/handler"
+	exportHandler "github.com/lucasd-coder/fast-feet/business-service/internal/domain/export/handler"
 	orderHandler "github.com/lucasd-coder/fast-feet/business-service/internal/domain/order/handler"
 	userHandler "github.com/lucasd-coder/fast-feet/business-service/internal/domain/user/handler"
+	cacheProvider "github.com/lucasd-coder/fast-feet/business-service/internal/provider/cache"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/circuitbreaker"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/erasurestore"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/readiness"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/registrationstore"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/scheduler"
 	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/subscribe"
 	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
 	"github.com/lucasd-coder/fast-feet/business-service/internal/shared/queueoptions"
@@ -72,7 +81,8 @@ func Run(cfg *config.Config) {
 	reg := prometheus.NewRegistry()
 
 	grpcServer := newGrpcServer(ctx, logger, reg)
-	registerServices(grpcServer)
+	healthServer := registerServices(grpcServer)
+	ready := readiness.New()
 
 	logDefault.Info(fmt.Sprintf("Started listening... address[:%s]", cfg.GRPC.Port))
 
@@ -82,18 +92,58 @@ func Run(cfg *config.Config) {
 		}
 	}()
 
-	go newHTTPServer(ctx, cfg, reg)
+	go newHTTPServer(ctx, cfg, reg, ready)
 
-	go subscribeUserEvents(ctx, cfg, reg)
+	subscribeCtx, stopSubscribers := context.WithCancel(ctx)
+	defer stopSubscribers()
 
-	go subscribeOrderEvents(ctx, cfg, reg)
+	var subscribers sync.WaitGroup
+	subscribers.Add(6)
+
+	go func() {
+		defer subscribers.Done()
+		subscribeUserEvents(subscribeCtx, cfg, reg, ready)
+	}()
+
+	go func() {
+		defer subscribers.Done()
+		subscribeOrderEvents(subscribeCtx, cfg, reg, ready)
+	}()
+
+	go func() {
+		defer subscribers.Done()
+		subscribeUserStatusEvents(subscribeCtx, cfg, reg, ready)
+	}()
+
+	go func() {
+		defer subscribers.Done()
+		scheduleOrders(subscribeCtx, cfg)
+	}()
+
+	go func() {
+		defer subscribers.Done()
+		runErasures(subscribeCtx, cfg)
+	}()
+
+	go func() {
+		defer subscribers.Done()
+		compensateRegistrations(subscribeCtx, cfg)
+	}()
 
 	stopChan := make(chan os.Signal, 1)
 
 	signal.Notify(stopChan, syscall.SIGTERM, syscall.SIGINT)
 	<-stopChan
 
+	logDefault.Info("Shutting down, draining subscribers...")
+	ready.Shutdown()
+	healthServer.Shutdown()
+
+	stopSubscribers()
+	subscribers.Wait()
+
 	grpcServer.GracefulStop()
+	logDefault.Info("Shutdown completed")
 }
 
 func newGrpcServer(ctx context.Context, logger *logger.Log, reg *prometheus.Registry) *grpc.Server {
@@ -130,7 +180,7 @@ func newGrpcServer(ctx context.Context, logger *logger.Log, reg *prometheus.Regi
 	return grpcServer
 }
 
-func newHTTPServer(ctx context.Context, cfg *config.Config, reg prometheus.Gatherer) {
+func newHTTPServer(ctx context.Context, cfg *config.Config, reg prometheus.Gatherer, ready *readiness.Readiness) {
 	log := logger.FromContext(ctx)
 
 	m := http.NewServeMux()
@@ -146,6 +196,8 @@ func newHTTPServer(ctx context.Context, cfg *config.Config, reg prometheus.Gathe
 		_, _ = w.Write([]byte("ok"))
 	})
 
+	m.Handle("/ready", ready)
+
 	profiler.StartProfiling(m)
 
 	httpSrv := &http.Server{
@@ -165,7 +217,7 @@ func newHTTPServer(ctx context.Context, cfg *config.Config, reg prometheus.Gathe
 	}
 }
 
-func subscribeUserEvents(ctx context.Context, cfg *config.Config, reg *prometheus.Registry) {
+func subscribeUserEvents(ctx context.Context, cfg *config.Config, reg *prometheus.Registry, ready *readiness.Readiness) {
 	optsQueueUserEvents := queueoptions.NewOptionQueueUserEvents(cfg)
 	userHandler := InitializeUserHandler()
 	log := logger.FromContext(ctx)
@@ -176,16 +228,23 @@ func subscribeUserEvents(ctx context.Context, cfg *config.Config, reg *prometheu
 		return
 	}
 
-	subscribeUserEvents := subscribe.New(func(ctx context.Context, m []byte) error {
+	dedup := subscribe.Deduplicate(cacheProvider.NewCacheRepository[string](cache.GetClient()), optsQueueUserEvents, metric)
+
+	subscribeUserEvents := subscribe.New(dedup(func(ctx context.Context, m []byte) error {
 		return userHandler.CreateUser(ctx, m)
-	}, optsQueueUserEvents, metric)
+	}), optsQueueUserEvents, metric).
+		WithGate(circuitbreaker.NewGate(cfg, circuitbreaker.UserManagerService, circuitbreaker.AuthService))
 
+	ready.Register(utils.ExtractQueueName(cfg.QueueUserEvents.QueueURL), subscribeUserEvents.Ready)
 	subscribeUserEvents.Start(ctx)
 }
 
-func subscribeOrderEvents(ctx context.Context, cfg *config.Config, reg *prometheus.Registry) {
+func subscribeOrderEvents(ctx context.Context, cfg *config.Config, reg *prometheus.Registry, ready *readiness.Readiness) {
 	optsQueueOrderEvents := queueoptions.NewOptionOrderEvents(cfg)
 	orderHandler := InitializeOrderHandler()
+	if cfg.QueueOrderEvents.BatchSize > 1 {
+		orderHandler = InitializeBatchOrderHandler()
+	}
 
 	log := logger.FromContext(ctx)
 
@@ -195,23 +254,79 @@ func subscribeOrderEvents(ctx context.Context, cfg *config.Config, reg *promethe
 		return
 	}
 
-	subscribeOrderEvents := subscribe.New(func(ctx context.Context, m []byte) error {
+	dedup := subscribe.Deduplicate(cacheProvider.NewCacheRepository[string](cache.GetClient()), optsQueueOrderEvents, metric)
+
+	subscribeOrderEvents := subscribe.New(dedup(func(ctx context.Context, m []byte) error {
 		return orderHandler.CreateOrderHandler(ctx, m)
-	}, optsQueueOrderEvents, metric)
+	}), optsQueueOrderEvents, metric).
+		WithGate(circuitbreaker.NewGate(cfg, circuitbreaker.OrderDataService, circuitbreaker.AuthService,
+			circuitbreaker.UserManagerService))
 
+	ready.Register(utils.ExtractQueueName(cfg.QueueOrderEvents.QueueURL), subscribeOrderEvents.Ready)
 	subscribeOrderEvents.Start(ctx)
 }
 
-func registerServices(grpcServer *grpc.Server) {
+func subscribeUserStatusEvents(ctx context.Context, cfg *config.Config, reg *prometheus.Registry, ready *readiness.Readiness) {
+	optsQueueUserStatusEvents := queueoptions.NewOptionQueueUserStatusEvents(cfg)
+	userStatusHandler := InitializeUserStatusHandler()
+	log := logger.FromContext(ctx)
+
+	metric, err := monitor.CreateMetrics(utils.ExtractQueueName(cfg.QueueUserStatusEvents.QueueURL), reg)
+	if err != nil {
+		log.Errorf("CreateMetrics Error: %s", err)
+		return
+	}
+
+	dedup := subscribe.Deduplicate(cacheProvider.NewCacheRepository[string](cache.GetClient()),
+		optsQueueUserStatusEvents, metric)
+
+	subscribeUserStatusEvents := subscribe.New(dedup(func(ctx context.Context, m []byte) error {
+		return userStatusHandler.UserStatusChanged(ctx, m)
+	}), optsQueueUserStatusEvents, metric).
+		WithGate(circuitbreaker.NewGate(cfg, circuitbreaker.OrderDataService, circuitbreaker.AuthService))
+
+	ready.Register(utils.ExtractQueueName(cfg.QueueUserStatusEvents.QueueURL), subscribeUserStatusEvents.Ready)
+	subscribeUserStatusEvents.Start(ctx)
+}
+
+func scheduleOrders(ctx context.Context, cfg *config.Config) {
+	orderHandler := InitializeOrderHandler()
+
+	scheduler.NewWorker(scheduler.NewRepository(cache.GetClient()), cfg, orderHandler.FireScheduledOrder).Start(ctx)
+}
+
+func runErasures(ctx context.Context, cfg *config.Config) {
+	erasureHandler := InitializeErasureHandler()
+
+	erasurestore.NewWorker(erasurestore.NewRepository(cache.GetClient()), cfg, erasureHandler.Run).Start(ctx)
+}
+
+func compensateRegistrations(ctx context.Context, cfg *config.Config) {
+	userHandler := InitializeUserHandler()
+
+	registrationstore.NewWorker(registrationstore.NewRepository(cache.GetClient(), cfg), cfg,
+		userHandler.Compensate).Start(ctx)
+}
+
+func registerServices(grpcServer *grpc.Server) *health.Server {
 	initializeOrder := InitializeOrderHandler()
 	initializeUser := InitializeUserHandler()
+	initializeErasure := InitializeErasureHandler()
+	initializeExport := InitializeExportHandler()
 
 	order := orderHandler.NewOrderHandler(*initializeOrder)
 	user := userHandler.NewUserHandler(*initializeUser)
+	erasure := erasureHandler.NewErasureHandler(*initializeErasure)
+	export := exportHandler.NewExportHandler(*initializeExport)
 
 	pb.RegisterOrderHandlerServer(grpcServer, order)
 	pb.RegisterUserHandlerServer(grpcServer, user)
+	pb.RegisterErasureHandlerServer(grpcServer, erasure)
+	pb.RegisterExportHandlerServer(grpcServer, export)
 
-	grpc_health_v1.RegisterHealthServer(grpcServer, health.NewServer())
+	healthServer := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
 	reflection.Register(grpcServer)
+
+	return healthServer
 }
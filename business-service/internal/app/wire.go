@@ -6,15 +6,24 @@ package app
 import (
 	"github.com/google/wire"
 	"github.com/lucasd-coder/fast-feet/business-service/config"
+	erasure "github.com/lucasd-coder/fast-feet/business-service/internal/domain/erasure"
+	erasureHandler "github.com/lucasd-coder/fast-feet/business-service/internal/domain/erasure/handler"
+	export "github.com/lucasd-coder/fast-feet/business-service/internal/domain/export"
+	exportHandler "github.com/lucasd-coder/fast-feet/business-service/internal/domain/export/handler"
 	order "github.com/lucasd-coder/fast-feet/business-service/internal/domain/order"
 	orderHandler "github.com/lucasd-coder/fast-feet/business-service/internal/domain/order/handler"
 	user "github.com/lucasd-coder/fast-feet/business-service/internal/domain/user"
 	userHandler "github.com/lucasd-coder/fast-feet/business-service/internal/domain/user/handler"
+	userstatus "github.com/lucasd-coder/fast-feet/business-service/internal/domain/userstatus"
+	userStatusHandler "github.com/lucasd-coder/fast-feet/business-service/internal/domain/userstatus/handler"
 	"github.com/lucasd-coder/fast-feet/business-service/pkg/cache"
 
 	authservice "github.com/lucasd-coder/fast-feet/business-service/internal/provider/authservice/repository"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/erasurestore"
 	managerservice "github.com/lucasd-coder/fast-feet/business-service/internal/provider/managerservice/repository"
 	orderdataservice "github.com/lucasd-coder/fast-feet/business-service/internal/provider/orderdataservice/repository"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/registrationstore"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/scheduler"
 	val "github.com/lucasd-coder/fast-feet/business-service/internal/provider/validator"
 	viacepservice "github.com/lucasd-coder/fast-feet/business-service/internal/provider/viacepservice/repository"
 	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
@@ -30,6 +39,12 @@ var initializeUserRepository = wire.NewSet(
 	managerservice.NewUserRepository,
 )
 
+var initializeRegistrationRepository = wire.NewSet(
+	wire.Bind(new(user.RegistrationRepository), new(*registrationstore.Repository)),
+	cache.GetClient,
+	registrationstore.NewRepository,
+)
+
 var initializeAuthRepository = wire.NewSet(
 	wire.Bind(new(shared.AuthRepository), new(*authservice.AuthRepository)),
 	authservice.NewAuthRepository,
@@ -41,19 +56,85 @@ var initializeViaCepRepository = wire.NewSet(
 	viacepservice.NewViaCepRepository,
 )
 
+var initializeScheduledOrderRepository = wire.NewSet(
+	wire.Bind(new(order.ScheduledOrderRepository), new(*scheduler.Repository)),
+	scheduler.NewRepository,
+)
+
 var initializeOrderDataRepository = wire.NewSet(
 	wire.Bind(new(order.Repository), new(*orderdataservice.OrderDataRepository)),
 	orderdataservice.NewOrderDataRepository,
 )
 
+var initializeDeliverymanRepository = wire.NewSet(
+	wire.Bind(new(order.DeliverymanRepository), new(*managerservice.UserRepository)),
+	managerservice.NewUserRepository,
+)
+
+var initializeBatchOrderDataRepository = wire.NewSet(
+	wire.Bind(new(order.Repository), new(*orderdataservice.BatchOrderDataRepository)),
+	orderdataservice.NewOrderDataRepository,
+	orderdataservice.NewBatchOrderDataRepository,
+)
+
+var initializeErasureRepository = wire.NewSet(
+	wire.Bind(new(erasure.Repository), new(*erasurestore.Repository)),
+	wire.Bind(new(erasure.UserRepository), new(*managerservice.UserRepository)),
+	wire.Bind(new(erasure.OrderRepository), new(*orderdataservice.OrderDataRepository)),
+	wire.Bind(new(erasure.RegistrationRepository), new(*registrationstore.Repository)),
+	wire.Bind(new(erasure.ScheduledOrderRepository), new(*scheduler.Repository)),
+	cache.GetClient,
+	erasurestore.NewRepository,
+	registrationstore.NewRepository,
+	scheduler.NewRepository,
+	managerservice.NewUserRepository,
+	orderdataservice.NewOrderDataRepository,
+)
+
+var initializeExportRepository = wire.NewSet(
+	wire.Bind(new(export.UserRepository), new(*managerservice.UserRepository)),
+	wire.Bind(new(export.OrderRepository), new(*orderdataservice.OrderDataRepository)),
+	managerservice.NewUserRepository,
+	orderdataservice.NewOrderDataRepository,
+)
+
+var initializeUserStatusRepository = wire.NewSet(
+	wire.Bind(new(userstatus.OrderRepository), new(*orderdataservice.OrderDataRepository)),
+	orderdataservice.NewOrderDataRepository,
+)
+
 func InitializeUserHandler() *userHandler.Handler {
-	wire.Build(initializeUserRepository,
+	wire.Build(initializeUserRepository, initializeRegistrationRepository,
 		initializeAuthRepository, initializeValidator, user.InitializeService, config.GetConfig, userHandler.NewHandler)
 	return nil
 }
 
 func InitializeOrderHandler() *orderHandler.Handler {
 	wire.Build(initializeAuthRepository, initializeViaCepRepository, initializeOrderDataRepository,
-		initializeValidator, order.InitializeService, config.GetConfig, orderHandler.NewHandler)
+		initializeDeliverymanRepository, initializeScheduledOrderRepository, initializeValidator, order.InitializeService, config.GetConfig, orderHandler.NewHandler)
+	return nil
+}
+
+func InitializeBatchOrderHandler() *orderHandler.Handler {
+	wire.Build(initializeAuthRepository, initializeViaCepRepository, initializeBatchOrderDataRepository,
+		initializeDeliverymanRepository, initializeScheduledOrderRepository, initializeValidator, order.InitializeService, config.GetConfig, orderHandler.NewHandler)
+	return nil
+}
+
+func InitializeErasureHandler() *erasureHandler.Handler {
+	wire.Build(initializeErasureRepository, initializeAuthRepository, initializeValidator,
+		erasure.InitializeService, config.GetConfig, erasureHandler.NewHandler)
+	return nil
+}
+
+func InitializeExportHandler() *exportHandler.Handler {
+	wire.Build(initializeExportRepository, initializeAuthRepository, initializeValidator,
+		export.InitializeService, config.GetConfig, exportHandler.NewHandler)
+	return nil
+}
+
+func InitializeUserStatusHandler() *userStatusHandler.Handler {
+	wire.Build(initializeUserStatusRepository, initializeAuthRepository, initializeValidator,
+		userstatus.InitializeService, config.GetConfig, userStatusHandler.NewHandler)
 	return nil
 }
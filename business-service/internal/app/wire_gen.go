@@ -9,13 +9,22 @@ package app
 import (
 	"github.com/google/wire"
 	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/erasure"
+	handler3 "github.com/lucasd-coder/fast-feet/business-service/internal/domain/erasure/handler"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/export"
+	handler4 "github.com/lucasd-coder/fast-feet/business-service/internal/domain/export/handler"
 	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/order"
 	handler2 "github.com/lucasd-coder/fast-feet/business-service/internal/domain/order/handler"
 	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/user"
 	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/user/handler"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/userstatus"
+	handler5 "github.com/lucasd-coder/fast-feet/business-service/internal/domain/userstatus/handler"
 	repository2 "github.com/lucasd-coder/fast-feet/business-service/internal/provider/authservice/repository"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/erasurestore"
 	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/managerservice/repository"
 	repository3 "github.com/lucasd-coder/fast-feet/business-service/internal/provider/orderdataservice/repository"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/registrationstore"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/scheduler"
 	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/validator"
 	repository4 "github.com/lucasd-coder/fast-feet/business-service/internal/provider/viacepservice/repository"
 	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
@@ -28,8 +37,10 @@ func InitializeUserHandler() *handler.Handler {
 	configConfig := config.GetConfig()
 	userRepository := repository.NewUserRepository(configConfig)
 	authRepository := repository2.NewAuthRepository(configConfig)
+	client := cache.GetClient()
+	registrationstoreRepository := registrationstore.NewRepository(client, configConfig)
 	validation := &validator.Validation{}
-	serviceImpl := user.NewService(userRepository, authRepository, validation)
+	serviceImpl := user.NewService(userRepository, authRepository, registrationstoreRepository, validation)
 	handlerHandler := handler.NewHandler(serviceImpl, configConfig)
 	return handlerHandler
 }
@@ -41,19 +52,86 @@ func InitializeOrderHandler() *handler2.Handler {
 	authRepository := repository2.NewAuthRepository(configConfig)
 	client := cache.GetClient()
 	viaCepRepository := repository4.NewViaCepRepository(configConfig, client)
-	serviceImpl := order.NewService(validation, orderDataRepository, authRepository, viaCepRepository)
+	schedulerRepository := scheduler.NewRepository(client)
+	userRepository := repository.NewUserRepository(configConfig)
+	serviceImpl := order.NewService(validation, orderDataRepository, authRepository, viaCepRepository, schedulerRepository, userRepository)
+	handlerHandler := handler2.NewHandler(serviceImpl, configConfig)
+	return handlerHandler
+}
+
+func InitializeBatchOrderHandler() *handler2.Handler {
+	validation := &validator.Validation{}
+	configConfig := config.GetConfig()
+	orderDataRepository := repository3.NewOrderDataRepository(configConfig)
+	batchOrderDataRepository := repository3.NewBatchOrderDataRepository(configConfig, orderDataRepository)
+	authRepository := repository2.NewAuthRepository(configConfig)
+	client := cache.GetClient()
+	viaCepRepository := repository4.NewViaCepRepository(configConfig, client)
+	schedulerRepository := scheduler.NewRepository(client)
+	userRepository := repository.NewUserRepository(configConfig)
+	serviceImpl := order.NewService(validation, batchOrderDataRepository, authRepository, viaCepRepository, schedulerRepository, userRepository)
 	handlerHandler := handler2.NewHandler(serviceImpl, configConfig)
 	return handlerHandler
 }
 
+func InitializeErasureHandler() *handler3.Handler {
+	validation := &validator.Validation{}
+	client := cache.GetClient()
+	erasurestoreRepository := erasurestore.NewRepository(client)
+	configConfig := config.GetConfig()
+	authRepository := repository2.NewAuthRepository(configConfig)
+	userRepository := repository.NewUserRepository(configConfig)
+	orderDataRepository := repository3.NewOrderDataRepository(configConfig)
+	registrationstoreRepository := registrationstore.NewRepository(client, configConfig)
+	schedulerRepository := scheduler.NewRepository(client)
+	serviceImpl := erasure.NewService(validation, erasurestoreRepository, authRepository, userRepository, orderDataRepository, registrationstoreRepository, schedulerRepository)
+	handlerHandler := handler3.NewHandler(serviceImpl, configConfig)
+	return handlerHandler
+}
+
+func InitializeExportHandler() *handler4.Handler {
+	validation := &validator.Validation{}
+	configConfig := config.GetConfig()
+	authRepository := repository2.NewAuthRepository(configConfig)
+	userRepository := repository.NewUserRepository(configConfig)
+	orderDataRepository := repository3.NewOrderDataRepository(configConfig)
+	serviceImpl := export.NewService(validation, authRepository, userRepository, orderDataRepository)
+	handlerHandler := handler4.NewHandler(serviceImpl, configConfig)
+	return handlerHandler
+}
+
+func InitializeUserStatusHandler() *handler5.Handler {
+	validation := &validator.Validation{}
+	configConfig := config.GetConfig()
+	authRepository := repository2.NewAuthRepository(configConfig)
+	orderDataRepository := repository3.NewOrderDataRepository(configConfig)
+	serviceImpl := userstatus.NewService(validation, authRepository, orderDataRepository)
+	handlerHandler := handler5.NewHandler(serviceImpl, configConfig)
+	return handlerHandler
+}
+
 // wire.go:
 
 var initializeValidator = wire.NewSet(wire.Struct(new(validator.Validation)), wire.Bind(new(shared.Validator), new(*validator.Validation)))
 
 var initializeUserRepository = wire.NewSet(wire.Bind(new(user.Repository), new(*repository.UserRepository)), repository.NewUserRepository)
 
+var initializeRegistrationRepository = wire.NewSet(wire.Bind(new(user.RegistrationRepository), new(*registrationstore.Repository)), cache.GetClient, registrationstore.NewRepository)
+
 var initializeAuthRepository = wire.NewSet(wire.Bind(new(shared.AuthRepository), new(*repository2.AuthRepository)), repository2.NewAuthRepository)
 
 var initializeViaCepRepository = wire.NewSet(wire.Bind(new(order.ViaCepRepository), new(*repository4.ViaCepRepository)), cache.GetClient, repository4.NewViaCepRepository)
 
+var initializeScheduledOrderRepository = wire.NewSet(wire.Bind(new(order.ScheduledOrderRepository), new(*scheduler.Repository)), scheduler.NewRepository)
+
 var initializeOrderDataRepository = wire.NewSet(wire.Bind(new(order.Repository), new(*repository3.OrderDataRepository)), repository3.NewOrderDataRepository)
+
+var initializeDeliverymanRepository = wire.NewSet(wire.Bind(new(order.DeliverymanRepository), new(*repository.UserRepository)), repository.NewUserRepository)
+
+var initializeBatchOrderDataRepository = wire.NewSet(wire.Bind(new(order.Repository), new(*repository3.BatchOrderDataRepository)), repository3.NewOrderDataRepository, repository3.NewBatchOrderDataRepository)
+
+var initializeErasureRepository = wire.NewSet(wire.Bind(new(erasure.Repository), new(*erasurestore.Repository)), wire.Bind(new(erasure.UserRepository), new(*repository.UserRepository)), wire.Bind(new(erasure.OrderRepository), new(*repository3.OrderDataRepository)), wire.Bind(new(erasure.RegistrationRepository), new(*registrationstore.Repository)), wire.Bind(new(erasure.ScheduledOrderRepository), new(*scheduler.Repository)), cache.GetClient, erasurestore.NewRepository, registrationstore.NewRepository, scheduler.NewRepository, repository.NewUserRepository, repository3.NewOrderDataRepository)
+
+var initializeExportRepository = wire.NewSet(wire.Bind(new(export.UserRepository), new(*repository.UserRepository)), wire.Bind(new(export.OrderRepository), new(*repository3.OrderDataRepository)), repository.NewUserRepository, repository3.NewOrderDataRepository)
+
+var initializeUserStatusRepository = wire.NewSet(wire.Bind(new(userstatus.OrderRepository), new(*repository3.OrderDataRepository)), repository3.NewOrderDataRepository)
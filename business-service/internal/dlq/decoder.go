@@ -0,0 +1,77 @@
+package dlq
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/user"
+)
+
+const redacted = "[REDACTED]"
+
+type Decoder struct {
+	aesKeys map[string]string
+	aesKey  string
+}
+
+func NewDecoder(aesKeys map[string]string, aesKey string) *Decoder {
+	return &Decoder{
+		aesKeys: aesKeys,
+		aesKey:  aesKey,
+	}
+}
+
+// Decode returns the payload of a dead-lettered message. User events are
+// encrypted protobuf, or encrypted gob when published before it, order
+// events are plain JSON.
+func (d *Decoder) Decode(sourceQueue string, body []byte) (any, error) {
+	if strings.Contains(sourceQueue, "order-events") {
+		return d.decodeJSON(body)
+	}
+
+	pld, err := d.decodeUser(body)
+	if err != nil {
+		if payload, jsonErr := d.decodeJSON(body); jsonErr == nil {
+			return payload, nil
+		}
+		return nil, err
+	}
+	return pld, nil
+}
+
+func (d *Decoder) decodeUser(body []byte) (*user.Payload, error) {
+	pld, err := user.DecodePayload(d.aesKeys, d.aesKey, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if pld.Data.Password != "" {
+		pld.Data.Password = redacted
+	}
+
+	return pld, nil
+}
+
+func (d *Decoder) decodeJSON(body []byte) (map[string]any, error) {
+	var payload map[string]any
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("err Unmarshal: %w", err)
+	}
+
+	redact(payload)
+
+	return payload, nil
+}
+
+func redact(payload map[string]any) {
+	for k, v := range payload {
+		if strings.EqualFold(k, "password") {
+			payload[k] = redacted
+			continue
+		}
+		if nested, ok := v.(map[string]any); ok {
+			redact(nested)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package dlq
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/broker"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/subscribe"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"gocloud.dev/pubsub"
+)
+
+var ErrMissingTarget = errors.New("no target url informed and message has no source topic")
+
+type Options struct {
+	DeadLetterURL string
+	TargetURL     string
+	AesKeys       map[string]string
+	AesKey        string
+	Limit         int
+	Wait          time.Duration
+	DryRun        bool
+	IDs           []string
+}
+
+type Entry struct {
+	ID       string            `json:"id"`
+	Metadata map[string]string `json:"metadata"`
+	Payload  any               `json:"payload,omitempty"`
+	Raw      []byte            `json:"raw,omitempty"`
+	Action   string            `json:"action,omitempty"`
+}
+
+// Inspector reads dead-lettered messages through the same gocloud pubsub
+// URLs used by the subscriber. Messages that are only listed, not selected
+// or handled in dry-run mode are nacked so the broker keeps them.
+type Inspector struct {
+	opt     *Options
+	sub     *pubsub.Subscription
+	decoder *Decoder
+}
+
+func NewInspector(opt *Options, sub *pubsub.Subscription) *Inspector {
+	return &Inspector{
+		opt:     opt,
+		sub:     sub,
+		decoder: NewDecoder(opt.AesKeys, opt.AesKey),
+	}
+}
+
+func NewClient(ctx context.Context, opt *Options) (*pubsub.Subscription, error) {
+	sub, err := broker.OpenSubscription(ctx, opt.DeadLetterURL)
+	return sub, err
+}
+
+func (i *Inspector) List(ctx context.Context) ([]*Entry, error) {
+	return i.run(ctx, "", func(_ context.Context, _ *pubsub.Message) error {
+		return nil
+	})
+}
+
+func (i *Inspector) Replay(ctx context.Context) ([]*Entry, error) {
+	topics := map[string]*pubsub.Topic{}
+	defer func() {
+		for url, topic := range topics {
+			if err := topic.Shutdown(ctx); err != nil {
+				logger.FromContext(ctx).Errorf("error topic for url: %s, shutdown: %v", url, err)
+			}
+		}
+	}()
+
+	return i.run(ctx, "replay", func(ctx context.Context, msg *pubsub.Message) error {
+		target := replayTarget(i.opt.TargetURL, msg.Metadata)
+		if target == "" {
+			return ErrMissingTarget
+		}
+
+		topic, ok := topics[target]
+		if !ok {
+			var err error
+			topic, err = broker.OpenTopic(ctx, target)
+			if err != nil {
+				return fmt.Errorf("fail open topic: %s err: %w", target, err)
+			}
+			topics[target] = topic
+		}
+
+		return topic.Send(ctx, &pubsub.Message{
+			Body:     msg.Body,
+			Metadata: originalMetadata(msg.Metadata),
+		})
+	})
+}
+
+// replayTarget returns the topic the message was consumed from. Messages
+// dead-lettered before the source topic was recorded only have the source
+// queue, which is also the topic except on RabbitMQ, where the exchange is
+// unknown and the target must be informed.
+func replayTarget(target string, metadata map[string]string) string {
+	if target != "" {
+		return target
+	}
+	if topic := metadata[subscribe.HeaderDeadLetterSourceTopic]; topic != "" {
+		return topic
+	}
+	if queue := metadata[subscribe.HeaderDeadLetterSourceQueue]; broker.Scheme(queue) != broker.SchemeRabbit {
+		return queue
+	}
+	return ""
+}
+
+func (i *Inspector) Purge(ctx context.Context) ([]*Entry, error) {
+	return i.run(ctx, "purge", func(_ context.Context, _ *pubsub.Message) error {
+		return nil
+	})
+}
+
+func (i *Inspector) run(ctx context.Context, action string,
+	apply func(ctx context.Context, msg *pubsub.Message) error,
+) ([]*Entry, error) {
+	msgs, err := i.receive(ctx)
+
+	// release everything still held, so the broker redelivers it later.
+	defer func() {
+		for _, msg := range msgs {
+			if msg != nil && msg.Nackable() {
+				msg.Nack()
+			}
+		}
+	}()
+
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*Entry, 0, len(msgs))
+	for idx, msg := range msgs {
+		entry := i.entry(msg)
+
+		if action != "" && i.selected(entry.ID) {
+			entry.Action = action
+			if i.opt.DryRun {
+				entry.Action += " (dry-run)"
+			} else {
+				if err := apply(ctx, msg); err != nil {
+					return entries, fmt.Errorf("fail %s message %s err: %w", action, entry.ID, err)
+				}
+				msg.Ack()
+				msgs[idx] = nil
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (i *Inspector) receive(ctx context.Context) ([]*pubsub.Message, error) {
+	var msgs []*pubsub.Message
+	seen := map[string]bool{}
+
+	for i.opt.Limit <= 0 || len(msgs) < i.opt.Limit {
+		rctx, cancel := context.WithTimeout(ctx, i.opt.Wait)
+		msg, err := i.sub.Receive(rctx)
+		cancel()
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) || rctx.Err() != nil {
+				break
+			}
+			return msgs, fmt.Errorf("fail receive from: %s err: %w", i.opt.DeadLetterURL, err)
+		}
+
+		key := msg.LoggableID
+		if key != "" && seen[key] {
+			msg.Nack()
+			break
+		}
+		seen[key] = true
+
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+func (i *Inspector) entry(msg *pubsub.Message) *Entry {
+	entry := &Entry{
+		ID:       MessageID(msg.Body),
+		Metadata: msg.Metadata,
+	}
+
+	payload, err := i.decoder.Decode(msg.Metadata[subscribe.HeaderDeadLetterSourceQueue], msg.Body)
+	if err != nil {
+		entry.Raw = msg.Body
+		return entry
+	}
+	entry.Payload = payload
+
+	return entry
+}
+
+func (i *Inspector) selected(id string) bool {
+	if len(i.opt.IDs) == 0 {
+		return true
+	}
+
+	for _, selected := range i.opt.IDs {
+		if strings.HasPrefix(id, selected) {
+			return true
+		}
+	}
+	return false
+}
+
+// MessageID identifies a dead-lettered message by its body, as broker
+// delivery tags change on every redelivery.
+func MessageID(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+func originalMetadata(metadata map[string]string) map[string]string {
+	result := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		if strings.HasPrefix(k, "x-dead-letter-") || strings.HasPrefix(k, "x-retry-") {
+			continue
+		}
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,210 @@
+package dlq_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/dlq"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/user"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/subscribe"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared/ciphers"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared/codec"
+	"github.com/stretchr/testify/suite"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/mempubsub"
+)
+
+const aesKey = "c8d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8"
+
+type InspectorSuite struct {
+	suite.Suite
+	ctx       context.Context
+	opt       *dlq.Options
+	dlqTopic  *pubsub.Topic
+	dlqSub    *pubsub.Subscription
+	source    *pubsub.Topic
+	sourceSub *pubsub.Subscription
+	sourceURL string
+}
+
+func (suite *InspectorSuite) SetupTest() {
+	suite.ctx = context.Background()
+	id := time.Now().UnixNano()
+
+	suite.opt = &dlq.Options{
+		DeadLetterURL: fmt.Sprintf("mem://business-service-user-events-dlq-%d", id),
+		AesKey:        aesKey,
+		Wait:          600 * time.Millisecond,
+	}
+
+	var err error
+	suite.dlqTopic, err = pubsub.OpenTopic(suite.ctx, suite.opt.DeadLetterURL)
+	suite.Require().NoError(err)
+
+	suite.sourceURL = fmt.Sprintf("mem://business-service-user-events-%d", id)
+	suite.source, err = pubsub.OpenTopic(suite.ctx, suite.sourceURL)
+	suite.Require().NoError(err)
+	suite.sourceSub = mempubsub.NewSubscription(suite.source, time.Minute)
+
+	suite.dlqSub = mempubsub.NewSubscription(suite.dlqTopic, time.Minute)
+
+	suite.send(suite.encryptUser("secret123"), suite.sourceURL)
+	suite.send([]byte(`{"data":{"product":{"name":"tv"}},"password":"p"}`), suite.sourceURL)
+}
+
+func (suite *InspectorSuite) TearDownTest() {
+	_ = suite.dlqSub.Shutdown(suite.ctx)
+	_ = suite.sourceSub.Shutdown(suite.ctx)
+	_ = suite.source.Shutdown(suite.ctx)
+	_ = suite.dlqTopic.Shutdown(suite.ctx)
+}
+
+func (suite *InspectorSuite) send(body []byte, sourceURL string) {
+	suite.Require().NoError(suite.dlqTopic.Send(suite.ctx, &pubsub.Message{
+		Body: body,
+		Metadata: map[string]string{
+			"importance":                          "high",
+			subscribe.HeaderDeadLetterReason:      subscribe.ReasonMaxRetriesExceeded,
+			subscribe.HeaderDeadLetterSourceQueue: sourceURL,
+		},
+	}))
+}
+
+func (suite *InspectorSuite) encryptUser(password string) []byte {
+	enc, err := codec.New[user.Payload]().Encode(user.Payload{
+		Data:      user.Data{Name: "maria", Email: "maria@gmail.com", Password: password},
+		EventDate: "2024-01-01",
+	})
+	suite.Require().NoError(err)
+
+	result, err := ciphers.Encrypt(ciphers.ExtractKey([]byte(aesKey)), enc)
+	suite.Require().NoError(err)
+	return result
+}
+
+func (suite *InspectorSuite) inspector() *dlq.Inspector {
+	return dlq.NewInspector(suite.opt, suite.dlqSub)
+}
+
+func (suite *InspectorSuite) TestListDecodesAndRedacts() {
+	entries, err := suite.inspector().List(suite.ctx)
+	suite.NoError(err)
+	suite.Len(entries, 2)
+
+	for _, entry := range entries {
+		switch payload := entry.Payload.(type) {
+		case *user.Payload:
+			suite.Equal("maria", payload.Data.Name)
+			suite.Equal("[REDACTED]", payload.Data.Password)
+		case map[string]any:
+			suite.Equal("[REDACTED]", payload["password"])
+		default:
+			suite.Failf("unexpected payload", "%T", payload)
+		}
+	}
+
+	// listing does not consume the messages.
+	entries, err = suite.inspector().List(suite.ctx)
+	suite.NoError(err)
+	suite.Len(entries, 2)
+}
+
+func (suite *InspectorSuite) TestReplaySelectedMessage() {
+	entries, err := suite.inspector().List(suite.ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Len(entries, 2)
+
+	suite.opt.IDs = []string{entries[1].ID[:8]}
+	replayed, err := suite.inspector().Replay(suite.ctx)
+	suite.NoError(err)
+	for _, entry := range replayed {
+		if entry.ID == entries[1].ID {
+			suite.Equal("replay", entry.Action)
+		} else {
+			suite.Empty(entry.Action)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(suite.ctx, 2*time.Second)
+	defer cancel()
+	msg, err := suite.sourceSub.Receive(ctx)
+	suite.Require().NoError(err)
+	msg.Ack()
+
+	suite.Equal(entries[1].ID, dlq.MessageID(msg.Body))
+	suite.Equal(map[string]string{"importance": "high"}, msg.Metadata)
+
+	remaining, err := suite.inspector().List(suite.ctx)
+	suite.NoError(err)
+	suite.Len(remaining, 1)
+}
+
+func (suite *InspectorSuite) TestReplayToSourceTopic() {
+	suite.Require().NoError(suite.inspectorPurge())
+
+	suite.Require().NoError(suite.dlqTopic.Send(suite.ctx, &pubsub.Message{
+		Body: []byte(`{"data":{"product":{"name":"tv"}}}`),
+		Metadata: map[string]string{
+			subscribe.HeaderDeadLetterSourceQueue: "rabbit://business-service-order-events",
+			subscribe.HeaderDeadLetterSourceTopic: suite.sourceURL,
+		},
+	}))
+
+	replayed, err := suite.inspector().Replay(suite.ctx)
+	suite.NoError(err)
+	suite.Len(replayed, 1)
+
+	ctx, cancel := context.WithTimeout(suite.ctx, 2*time.Second)
+	defer cancel()
+	msg, err := suite.sourceSub.Receive(ctx)
+	suite.Require().NoError(err)
+	msg.Ack()
+	suite.Equal(replayed[0].ID, dlq.MessageID(msg.Body))
+}
+
+func (suite *InspectorSuite) TestReplayRequiresTargetForRabbitQueue() {
+	suite.Require().NoError(suite.inspectorPurge())
+
+	suite.Require().NoError(suite.dlqTopic.Send(suite.ctx, &pubsub.Message{
+		Body: []byte(`{"data":{"product":{"name":"tv"}}}`),
+		Metadata: map[string]string{
+			subscribe.HeaderDeadLetterSourceQueue: "rabbit://business-service-order-events",
+		},
+	}))
+
+	_, err := suite.inspector().Replay(suite.ctx)
+	suite.ErrorIs(err, dlq.ErrMissingTarget)
+
+	suite.opt.TargetURL = suite.sourceURL
+	replayed, err := suite.inspector().Replay(suite.ctx)
+	suite.NoError(err)
+	suite.Equal("replay", replayed[0].Action)
+}
+
+func (suite *InspectorSuite) inspectorPurge() error {
+	_, err := suite.inspector().Purge(suite.ctx)
+	return err
+}
+
+func (suite *InspectorSuite) TestPurgeDryRunKeepsMessages() {
+	suite.opt.DryRun = true
+	purged, err := suite.inspector().Purge(suite.ctx)
+	suite.NoError(err)
+	suite.Len(purged, 2)
+	suite.Equal("purge (dry-run)", purged[0].Action)
+
+	suite.opt.DryRun = false
+	_, err = suite.inspector().Purge(suite.ctx)
+	suite.NoError(err)
+
+	remaining, err := suite.inspector().List(suite.ctx)
+	suite.NoError(err)
+	suite.Empty(remaining)
+}
+
+func TestInspectorSuite(t *testing.T) {
+	suite.Run(t, new(InspectorSuite))
+}
@@ -0,0 +1,179 @@
+package erasure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/user"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EraseUser registers the erasure of the user, the steps run in the
+// background. Requesting it again returns the existing erasure and resumes
+// it when still pending.
+func (s *ServiceImpl) EraseUser(ctx context.Context, pld *Request) (*pb.ErasureResponse, error) {
+	log := logger.FromContext(ctx)
+
+	if err := pld.Validate(s.validate); err != nil {
+		return nil, shared.ValidationErrors(err)
+	}
+
+	e := &Erasure{
+		UserID:      pld.UserID,
+		Pseudonym:   uuid.NewString(),
+		Status:      StatusPending,
+		Steps:       map[string]time.Time{},
+		RequestedAt: time.Now().UTC(),
+	}
+
+	created, err := s.repository.Create(ctx, e)
+	if err != nil {
+		return nil, fmt.Errorf("error when create erasure err: %w", err)
+	}
+
+	if created {
+		log.Infof("erasure of user id: %s requested", pld.UserID)
+		return e.ToResponse(), nil
+	}
+
+	e, err = s.repository.Find(ctx, pld.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error when find erasure err: %w", err)
+	}
+
+	if e.Status == StatusPending {
+		if err := s.repository.Enqueue(ctx, pld.UserID); err != nil {
+			return nil, fmt.Errorf("error when enqueue erasure err: %w", err)
+		}
+	}
+
+	return e.ToResponse(), nil
+}
+
+func (s *ServiceImpl) GetErasure(ctx context.Context, pld *Request) (*pb.ErasureResponse, error) {
+	if err := pld.Validate(s.validate); err != nil {
+		return nil, shared.ValidationErrors(err)
+	}
+
+	e, err := s.repository.Find(ctx, pld.UserID)
+	if err != nil {
+		if errors.Is(err, shared.ErrErasureNotFound) {
+			return nil, shared.NotFoundError(err)
+		}
+		return nil, fmt.Errorf("error when find erasure err: %w", err)
+	}
+
+	return e.ToResponse(), nil
+}
+
+// Run executes the pending steps of e, saving it after each one. On error
+// e is saved with the failure and runs again from the failed step.
+func (s *ServiceImpl) Run(ctx context.Context, e *Erasure) error {
+	log := logger.FromContext(ctx)
+
+	if e.Steps == nil {
+		e.Steps = map[string]time.Time{}
+	}
+
+	for _, step := range Steps {
+		if e.Done(step) {
+			continue
+		}
+
+		if err := s.runStep(ctx, e, step); err != nil {
+			e.Attempts++
+			e.LastError = fmt.Sprintf("%s: %v", step, err)
+			if err := s.repository.Update(ctx, e); err != nil {
+				log.Errorf("error updating erasure of user id: %s, err: %v", e.UserID, err)
+			}
+			return fmt.Errorf("error when run erasure step %s err: %w", step, err)
+		}
+
+		log.Infof("erasure of user id: %s step %s completed", e.UserID, step)
+
+		e.Steps[step] = time.Now().UTC()
+		if err := s.repository.Update(ctx, e); err != nil {
+			return fmt.Errorf("error when update erasure err: %w", err)
+		}
+	}
+
+	e.Status = StatusCompleted
+	e.CompletedAt = time.Now().UTC()
+	e.Pseudonym = ""
+	e.LastError = ""
+
+	return s.repository.Complete(ctx, e)
+}
+
+func (s *ServiceImpl) runStep(ctx context.Context, e *Erasure, step string) error {
+	switch step {
+	case StepDisableAccount:
+		_, err := s.authRepository.DisableUser(ctx, e.UserID)
+		return ignoreNotFound(err)
+	case StepDeleteRegistration:
+		return s.deleteRegistration(ctx, e.UserID)
+	case StepCancelScheduledOrders:
+		return s.cancelScheduledOrders(ctx, e.UserID)
+	case StepDeleteAccount:
+		return ignoreNotFound(s.authRepository.DeleteUser(ctx, e.UserID))
+	case StepDeleteProfile:
+		_, err := s.userRepository.DeleteUser(ctx, &pb.DeleteUserRequest{UserId: e.UserID})
+		return ignoreNotFound(err)
+	case StepPseudonymizeOrders:
+		_, err := s.orderRepository.PseudonymizeOrders(ctx, &pb.PseudonymizeOrdersRequest{
+			DeliverymanId: e.UserID,
+			Pseudonym:     e.Pseudonym,
+		})
+		return err
+	default:
+		return fmt.Errorf("unknown erasure step: %s", step)
+	}
+}
+
+// deleteRegistration deletes the registration of the account email. Without
+// the account there is nothing to find it by, a finished registration
+// expires after the registration retention.
+func (s *ServiceImpl) deleteRegistration(ctx context.Context, userID string) error {
+	account, err := s.authRepository.GetAccount(ctx, userID)
+	if err != nil {
+		return ignoreNotFound(err)
+	}
+
+	return s.registrationRepository.Delete(ctx, user.RegistrationKey(account.Email))
+}
+
+// cancelScheduledOrders cancels the orders the user scheduled, their payload
+// is kept until they fire. An order firing meanwhile fails the permission
+// check, as the account is disabled.
+func (s *ServiceImpl) cancelScheduledOrders(ctx context.Context, userID string) error {
+	orders, err := s.scheduledRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, scheduled := range orders {
+		err := s.scheduledRepository.Cancel(ctx, userID, scheduled.ID)
+		if err != nil && !errors.Is(err, shared.ErrScheduledOrderNotFound) &&
+			!errors.Is(err, shared.ErrScheduledOrderFired) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ignoreNotFound treats data already gone, e.g. erased by a previous
+// attempt, as erased.
+func ignoreNotFound(err error) error {
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,209 @@
+package erasure_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/erasure"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/order"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/user"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/mocks"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/validator"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const userID = "9a0e3a5c-3f5b-4f0d-9d5e-2b0c1f6a7e21"
+
+type EraseUserSuite struct {
+	suite.Suite
+	ctx       context.Context
+	repo      *mocks.Repository_internal_domain_erasure
+	repoAuth  *mocks.AuthRepository_internal_shared
+	repoUser  *mocks.UserRepository_internal_domain_erasure
+	repoOrder *mocks.OrderRepository_internal_domain_erasure
+	repoReg   *mocks.RegistrationRepository_internal_domain_erasure
+	repoSched *mocks.ScheduledOrderRepository_internal_domain_erasure
+	svc       erasure.Service
+}
+
+func (suite *EraseUserSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.repo = new(mocks.Repository_internal_domain_erasure)
+	suite.repoAuth = new(mocks.AuthRepository_internal_shared)
+	suite.repoUser = new(mocks.UserRepository_internal_domain_erasure)
+	suite.repoOrder = new(mocks.OrderRepository_internal_domain_erasure)
+	suite.repoReg = new(mocks.RegistrationRepository_internal_domain_erasure)
+	suite.repoSched = new(mocks.ScheduledOrderRepository_internal_domain_erasure)
+
+	suite.svc = erasure.NewService(validator.NewValidation(), suite.repo,
+		suite.repoAuth, suite.repoUser, suite.repoOrder, suite.repoReg, suite.repoSched)
+}
+
+func (suite *EraseUserSuite) TestEraseUserValidateFailure() {
+	_, err := suite.svc.EraseUser(suite.ctx, &erasure.Request{UserID: "1234"})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *EraseUserSuite) TestEraseUserCreated() {
+	suite.repo.On("Create", suite.ctx, mock.MatchedBy(func(e *erasure.Erasure) bool {
+		return e.UserID == userID && e.Status == erasure.StatusPending && e.Pseudonym != ""
+	})).Return(true, nil)
+
+	resp, err := suite.svc.EraseUser(suite.ctx, &erasure.Request{UserID: userID})
+	suite.NoError(err)
+	suite.Equal(erasure.StatusPending, resp.GetStatus())
+	suite.Len(resp.GetSteps(), len(erasure.Steps))
+	suite.repo.AssertNotCalled(suite.T(), "Enqueue", mock.Anything, mock.Anything)
+}
+
+func (suite *EraseUserSuite) TestEraseUserResumesPending() {
+	existing := &erasure.Erasure{
+		UserID:      userID,
+		Status:      erasure.StatusPending,
+		Steps:       map[string]time.Time{erasure.StepDisableAccount: time.Now()},
+		RequestedAt: time.Now(),
+		Attempts:    3,
+	}
+
+	suite.repo.On("Create", suite.ctx, mock.Anything).Return(false, nil)
+	suite.repo.On("Find", suite.ctx, userID).Return(existing, nil)
+	suite.repo.On("Enqueue", suite.ctx, userID).Return(nil)
+
+	resp, err := suite.svc.EraseUser(suite.ctx, &erasure.Request{UserID: userID})
+	suite.NoError(err)
+	suite.Equal(int32(3), resp.GetAttempts())
+	suite.NotEmpty(resp.GetSteps()[0].GetCompletedAt())
+	suite.repo.AssertExpectations(suite.T())
+}
+
+func (suite *EraseUserSuite) TestEraseUserCompletedIsNotEnqueued() {
+	existing := &erasure.Erasure{
+		UserID:      userID,
+		Status:      erasure.StatusCompleted,
+		RequestedAt: time.Now(),
+		CompletedAt: time.Now(),
+	}
+
+	suite.repo.On("Create", suite.ctx, mock.Anything).Return(false, nil)
+	suite.repo.On("Find", suite.ctx, userID).Return(existing, nil)
+
+	resp, err := suite.svc.EraseUser(suite.ctx, &erasure.Request{UserID: userID})
+	suite.NoError(err)
+	suite.Equal(erasure.StatusCompleted, resp.GetStatus())
+	suite.repo.AssertNotCalled(suite.T(), "Enqueue", mock.Anything, mock.Anything)
+}
+
+func (suite *EraseUserSuite) TestGetErasureNotFound() {
+	suite.repo.On("Find", suite.ctx, userID).Return(nil, shared.ErrErasureNotFound)
+
+	_, err := suite.svc.GetErasure(suite.ctx, &erasure.Request{UserID: userID})
+	suite.Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *EraseUserSuite) TestRunCompletesEveryStep() {
+	e := &erasure.Erasure{
+		UserID:    userID,
+		Pseudonym: "5d1f6c2b-6f61-4d8c-9d43-3c5b8ad5c0e4",
+		Status:    erasure.StatusPending,
+		Steps:     map[string]time.Time{},
+	}
+
+	var calls []string
+	record := func(step string) func(mock.Arguments) {
+		return func(mock.Arguments) { calls = append(calls, step) }
+	}
+
+	suite.repoAuth.On("DisableUser", suite.ctx, userID).
+		Return(&shared.GetUserResponse{ID: userID, Email: "maria@gmail.com"}, nil).
+		Run(record(erasure.StepDisableAccount))
+	suite.repoAuth.On("GetAccount", suite.ctx, userID).
+		Return(&shared.GetAccountResponse{ID: userID, Email: "maria@gmail.com"}, nil)
+	suite.repoReg.On("Delete", suite.ctx, user.RegistrationKey("maria@gmail.com")).Return(nil).
+		Run(record(erasure.StepDeleteRegistration))
+	suite.repoSched.On("FindByUserID", suite.ctx, userID).Return([]*order.ScheduledOrder{
+		{ID: "c1b6a3f0-2d4e-4b7a-9f1c-8e5d2a7b3c6d", UserID: userID},
+		{ID: "f3e2d1c0-5b4a-4c9d-8e7f-6a5b4c3d2e1f", UserID: userID},
+	}, nil)
+	suite.repoSched.On("Cancel", suite.ctx, userID, "c1b6a3f0-2d4e-4b7a-9f1c-8e5d2a7b3c6d").Return(nil).
+		Run(record(erasure.StepCancelScheduledOrders))
+	suite.repoSched.On("Cancel", suite.ctx, userID, "f3e2d1c0-5b4a-4c9d-8e7f-6a5b4c3d2e1f").
+		Return(shared.ErrScheduledOrderFired)
+	suite.repoAuth.On("DeleteUser", suite.ctx, userID).
+		Return(status.Error(codes.NotFound, "user not found")).
+		Run(record(erasure.StepDeleteAccount))
+	suite.repoUser.On("DeleteUser", suite.ctx, &pb.DeleteUserRequest{UserId: userID}).
+		Return(&pb.DeleteUserResponse{UserId: userID}, nil).
+		Run(record(erasure.StepDeleteProfile))
+	suite.repoOrder.On("PseudonymizeOrders", suite.ctx, &pb.PseudonymizeOrdersRequest{
+		DeliverymanId: userID,
+		Pseudonym:     "5d1f6c2b-6f61-4d8c-9d43-3c5b8ad5c0e4",
+	}).Return(&pb.PseudonymizeOrdersResponse{Modified: 2}, nil).
+		Run(record(erasure.StepPseudonymizeOrders))
+	suite.repo.On("Update", suite.ctx, e).Return(nil)
+	suite.repo.On("Complete", suite.ctx, e).Return(nil)
+
+	suite.NoError(suite.svc.Run(suite.ctx, e))
+	suite.Equal(erasure.StatusCompleted, e.Status)
+	suite.Empty(e.Pseudonym)
+	suite.False(e.CompletedAt.IsZero())
+	for _, step := range erasure.Steps {
+		suite.True(e.Done(step), step)
+	}
+	suite.Equal(erasure.Steps, calls)
+}
+
+func (suite *EraseUserSuite) TestRunSkipsRegistrationOfDeletedAccount() {
+	e := &erasure.Erasure{
+		UserID: userID,
+		Status: erasure.StatusPending,
+		Steps:  map[string]time.Time{erasure.StepDisableAccount: time.Now()},
+	}
+
+	suite.repoAuth.On("GetAccount", suite.ctx, userID).
+		Return(nil, status.Error(codes.NotFound, "user not found"))
+	suite.repoSched.On("FindByUserID", suite.ctx, userID).Return(nil, errors.New("redis unavailable"))
+	suite.repo.On("Update", suite.ctx, e).Return(nil)
+
+	suite.Error(suite.svc.Run(suite.ctx, e))
+	suite.True(e.Done(erasure.StepDeleteRegistration))
+	suite.Contains(e.LastError, erasure.StepCancelScheduledOrders)
+	suite.repoReg.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything)
+	suite.repoAuth.AssertNotCalled(suite.T(), "DeleteUser", mock.Anything, mock.Anything)
+}
+
+func (suite *EraseUserSuite) TestRunResumesFromFailedStep() {
+	e := &erasure.Erasure{
+		UserID:    userID,
+		Pseudonym: "5d1f6c2b-6f61-4d8c-9d43-3c5b8ad5c0e4",
+		Status:    erasure.StatusPending,
+		Steps: map[string]time.Time{
+			erasure.StepDisableAccount:        time.Now(),
+			erasure.StepDeleteRegistration:    time.Now(),
+			erasure.StepCancelScheduledOrders: time.Now(),
+			erasure.StepDeleteAccount:         time.Now(),
+		},
+	}
+
+	suite.repoUser.On("DeleteUser", suite.ctx, mock.Anything).
+		Return(nil, errors.New("user-manager-service unavailable"))
+	suite.repo.On("Update", suite.ctx, e).Return(nil)
+
+	suite.Error(suite.svc.Run(suite.ctx, e))
+	suite.Equal(erasure.StatusPending, e.Status)
+	suite.Equal(1, e.Attempts)
+	suite.Contains(e.LastError, erasure.StepDeleteProfile)
+	suite.repoAuth.AssertNotCalled(suite.T(), "DeleteUser", mock.Anything, mock.Anything)
+	suite.repoOrder.AssertNotCalled(suite.T(), "PseudonymizeOrders", mock.Anything, mock.Anything)
+	suite.repo.AssertNotCalled(suite.T(), "Complete", mock.Anything, mock.Anything)
+}
+
+func TestEraseUserSuite(t *testing.T) {
+	suite.Run(t, new(EraseUserSuite))
+}
@@ -0,0 +1,92 @@
+package erasure
+
+import (
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+)
+
+const (
+	StatusPending   = "PENDING"
+	StatusCompleted = "COMPLETED"
+)
+
+// The steps of an erasure, they run in this order and each one is
+// idempotent, so a failed erasure resumes from the first pending step. The
+// account is disabled first, so the user can't sign in or create data while
+// it is erased.
+//
+// The events the router-service outbox published for the user are not
+// erased, they are encrypted and its TTL index removes them after the outbox
+// retention. Orders scheduled for the user as deliveryman fail the
+// deliveryman check when they fire and are dropped.
+const (
+	StepDisableAccount        = "disable-account"
+	StepDeleteRegistration    = "delete-registration"
+	StepCancelScheduledOrders = "cancel-scheduled-orders"
+	StepDeleteAccount         = "delete-account"
+	StepDeleteProfile         = "delete-profile"
+	StepPseudonymizeOrders    = "pseudonymize-orders"
+)
+
+var Steps = []string{
+	StepDisableAccount,
+	StepDeleteRegistration,
+	StepCancelScheduledOrders,
+	StepDeleteAccount,
+	StepDeleteProfile,
+	StepPseudonymizeOrders,
+}
+
+type Request struct {
+	UserID string `json:"userId,omitempty" validate:"required,uuid4"`
+}
+
+// Erasure is the state of a right-to-erasure request, it is kept after
+// completion as the audit record. Pseudonym replaces the user ID on the
+// orders and is cleared once they are pseudonymized.
+type Erasure struct {
+	UserID      string               `json:"userId"`
+	Pseudonym   string               `json:"pseudonym,omitempty"`
+	Status      string               `json:"status"`
+	Steps       map[string]time.Time `json:"steps"`
+	RequestedAt time.Time            `json:"requestedAt"`
+	CompletedAt time.Time            `json:"completedAt,omitempty"`
+	Attempts    int                  `json:"attempts"`
+	LastError   string               `json:"lastError,omitempty"`
+}
+
+func (r *Request) Validate(val shared.Validator) error {
+	return val.ValidateStruct(r)
+}
+
+func (e *Erasure) Done(step string) bool {
+	_, ok := e.Steps[step]
+	return ok
+}
+
+func (e *Erasure) ToResponse() *pb.ErasureResponse {
+	resp := &pb.ErasureResponse{
+		UserId:      e.UserID,
+		Status:      e.Status,
+		Steps:       make([]*pb.ErasureStep, 0, len(Steps)),
+		RequestedAt: e.RequestedAt.Format(time.RFC3339),
+		Attempts:    int32(e.Attempts),
+		LastError:   e.LastError,
+	}
+
+	for _, step := range Steps {
+		s := &pb.ErasureStep{Name: step}
+		if at, ok := e.Steps[step]; ok {
+			s.CompletedAt = at.Format(time.RFC3339)
+		}
+		resp.Steps = append(resp.Steps, s)
+	}
+
+	if !e.CompletedAt.IsZero() {
+		resp.CompletedAt = e.CompletedAt.Format(time.RFC3339)
+	}
+
+	return resp
+}
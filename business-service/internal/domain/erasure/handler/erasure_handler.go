@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/erasure"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+)
+
+type ErasureHandler struct {
+	pb.UnimplementedErasureHandlerServer
+	Handler
+}
+
+func NewErasureHandler(h Handler) *ErasureHandler {
+	return &ErasureHandler{
+		Handler: h,
+	}
+}
+
+func (h *ErasureHandler) EraseUser(ctx context.Context, req *pb.EraseUserRequest) (*pb.ErasureResponse, error) {
+	slog.With("id", req.GetId()).
+		Info("received request")
+
+	pld := erasure.Request{
+		UserID: req.GetId(),
+	}
+
+	return h.service.EraseUser(ctx, &pld)
+}
+
+func (h *ErasureHandler) GetErasure(ctx context.Context, req *pb.EraseUserRequest) (*pb.ErasureResponse, error) {
+	pld := erasure.Request{
+		UserID: req.GetId(),
+	}
+
+	return h.service.GetErasure(ctx, &pld)
+}
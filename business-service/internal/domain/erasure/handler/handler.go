@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/erasure"
+)
+
+type Handler struct {
+	service erasure.Service
+	cfg     *config.Config
+}
+
+func NewHandler(s erasure.Service, cfg *config.Config) *Handler {
+	return &Handler{
+		service: s,
+		cfg:     cfg,
+	}
+}
+
+// Run is the function the erasure worker calls for each due erasure.
+func (h *Handler) Run(ctx context.Context, e *erasure.Erasure) error {
+	return h.service.Run(ctx, e)
+}
@@ -0,0 +1,47 @@
+package erasure
+
+import (
+	"context"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/order"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+)
+
+type (
+	Repository interface {
+		// Create stores a new erasure, it returns false when the user already
+		// has one.
+		Create(ctx context.Context, e *Erasure) (bool, error)
+		Find(ctx context.Context, userID string) (*Erasure, error)
+		// Enqueue makes a pending erasure due now.
+		Enqueue(ctx context.Context, userID string) error
+		Update(ctx context.Context, e *Erasure) error
+		Complete(ctx context.Context, e *Erasure) error
+	}
+
+	UserRepository interface {
+		DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error)
+	}
+
+	// RegistrationRepository deletes the registration saga state, keyed by
+	// the email hash of the user.
+	RegistrationRepository interface {
+		Delete(ctx context.Context, key string) error
+	}
+
+	ScheduledOrderRepository interface {
+		FindByUserID(ctx context.Context, userID string) ([]*order.ScheduledOrder, error)
+		Cancel(ctx context.Context, userID, id string) error
+	}
+
+	OrderRepository interface {
+		PseudonymizeOrders(ctx context.Context,
+			req *pb.PseudonymizeOrdersRequest) (*pb.PseudonymizeOrdersResponse, error)
+	}
+
+	Service interface {
+		EraseUser(ctx context.Context, pld *Request) (*pb.ErasureResponse, error)
+		GetErasure(ctx context.Context, pld *Request) (*pb.ErasureResponse, error)
+		Run(ctx context.Context, e *Erasure) error
+	}
+)
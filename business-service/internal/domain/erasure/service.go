@@ -0,0 +1,41 @@
+package erasure
+
+import (
+	"github.com/google/wire"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+)
+
+var InitializeService = wire.NewSet(
+	wire.Bind(new(Service), new(*ServiceImpl)),
+	NewService,
+)
+
+type ServiceImpl struct {
+	validate               shared.Validator
+	repository             Repository
+	authRepository         shared.AuthRepository
+	userRepository         UserRepository
+	orderRepository        OrderRepository
+	registrationRepository RegistrationRepository
+	scheduledRepository    ScheduledOrderRepository
+}
+
+func NewService(
+	val shared.Validator,
+	repo Repository,
+	authRepo shared.AuthRepository,
+	userRepo UserRepository,
+	orderRepo OrderRepository,
+	registrationRepo RegistrationRepository,
+	scheduledRepo ScheduledOrderRepository,
+) *ServiceImpl {
+	return &ServiceImpl{
+		validate:               val,
+		repository:             repo,
+		authRepository:         authRepo,
+		userRepository:         userRepo,
+		orderRepository:        orderRepo,
+		registrationRepository: registrationRepo,
+		scheduledRepository:    scheduledRepo,
+	}
+}
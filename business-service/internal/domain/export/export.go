@@ -0,0 +1,30 @@
+package export
+
+import (
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+)
+
+type Request struct {
+	UserID      string `json:"userId,omitempty" validate:"required,uuid4"`
+	RequesterID string `json:"requesterId,omitempty" validate:"required,uuid4"`
+}
+
+func (r *Request) Validate(val shared.Validator) error {
+	return val.ValidateStruct(r)
+}
+
+func newAccountPart(account *shared.GetAccountResponse) *pb.UserDataPart {
+	return &pb.UserDataPart{
+		Part: &pb.UserDataPart_Account{
+			Account: &pb.GetAccountResponse{
+				Id:        account.ID,
+				Username:  account.Username,
+				Enabled:   account.Enabled,
+				Email:     account.Email,
+				Roles:     account.Roles,
+				CreatedAt: account.CreatedAt,
+			},
+		},
+	}
+}
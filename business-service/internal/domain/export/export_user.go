@@ -0,0 +1,87 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportUser streams the personal data kept of the user by user-manager,
+// auth and order-data services. Only the user or an admin may ask for it.
+func (s *ServiceImpl) ExportUser(ctx context.Context, pld *Request, send func(*pb.UserDataPart) error) error {
+	log := logger.FromContext(ctx)
+
+	if err := pld.Validate(s.validate); err != nil {
+		return shared.ValidationErrors(err)
+	}
+
+	if err := s.checkPermission(ctx, pld); err != nil {
+		return err
+	}
+
+	profile, err := s.userRepository.ExportUser(ctx, &pb.ExportUserRequest{UserId: pld.UserID})
+	if err != nil {
+		log.Errorf("error when export profile with id: %s, err: %v", pld.UserID, err)
+		return err
+	}
+
+	if err := send(&pb.UserDataPart{Part: &pb.UserDataPart_Profile{Profile: profile}}); err != nil {
+		return err
+	}
+
+	account, err := s.authRepository.GetAccount(ctx, pld.UserID)
+	switch {
+	case status.Code(err) == codes.NotFound:
+		log.Infof("user id: %s has no account to export", pld.UserID)
+	case err != nil:
+		log.Errorf("error when export account with id: %s, err: %v", pld.UserID, err)
+		return err
+	default:
+		if err := send(newAccountPart(account)); err != nil {
+			return err
+		}
+	}
+
+	var total int
+	err = s.orderRepository.ExportOrders(ctx, &pb.ExportOrdersRequest{DeliverymanId: pld.UserID},
+		func(order *pb.Order) error {
+			total++
+			return send(&pb.UserDataPart{Part: &pb.UserDataPart_Order{Order: order}})
+		})
+	if err != nil {
+		return fmt.Errorf("error when export orders err: %w", err)
+	}
+
+	log.Infof("exported personal data of user id: %s with %d orders", pld.UserID, total)
+
+	return nil
+}
+
+func (s *ServiceImpl) checkPermission(ctx context.Context, pld *Request) error {
+	log := logger.FromContext(ctx)
+
+	if strings.EqualFold(pld.UserID, pld.RequesterID) {
+		return nil
+	}
+
+	roles, err := s.authRepository.FindRolesByID(ctx, pld.RequesterID)
+	if err != nil {
+		log.Errorf("error when check permission with id: %s, err: %v", pld.RequesterID, err)
+		return err
+	}
+
+	for _, role := range roles.Roles {
+		if strings.EqualFold(shared.ADMIN, role) {
+			return nil
+		}
+	}
+
+	log.Errorf("error mission not permission to id: %s", pld.RequesterID)
+	return shared.UnauthenticatedError(shared.ErrUserUnauthorized)
+}
@@ -0,0 +1,129 @@
+package export_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/export"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/mocks"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/validator"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	userID  = "9a0e3a5c-3f5b-4f0d-9d5e-2b0c1f6a7e21"
+	adminID = "2f6d1b4e-8c3a-4e5f-9a7b-1c2d3e4f5a6b"
+)
+
+type ExportUserSuite struct {
+	suite.Suite
+	ctx       context.Context
+	repoAuth  *mocks.AuthRepository_internal_shared
+	repoUser  *mocks.UserRepository_internal_domain_export
+	repoOrder *mocks.OrderRepository_internal_domain_export
+	svc       export.Service
+	parts     []*pb.UserDataPart
+}
+
+func (suite *ExportUserSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.repoAuth = new(mocks.AuthRepository_internal_shared)
+	suite.repoUser = new(mocks.UserRepository_internal_domain_export)
+	suite.repoOrder = new(mocks.OrderRepository_internal_domain_export)
+	suite.parts = nil
+
+	suite.svc = export.NewService(validator.NewValidation(), suite.repoAuth, suite.repoUser, suite.repoOrder)
+}
+
+func (suite *ExportUserSuite) send(part *pb.UserDataPart) error {
+	suite.parts = append(suite.parts, part)
+	return nil
+}
+
+func (suite *ExportUserSuite) mockExport() {
+	suite.repoUser.On("ExportUser", suite.ctx, &pb.ExportUserRequest{UserId: userID}).
+		Return(&pb.ExportUserResponse{UserId: userID, Cpf: "80961164088"}, nil)
+	suite.repoAuth.On("GetAccount", suite.ctx, userID).
+		Return(&shared.GetAccountResponse{ID: userID, Enabled: true, Roles: []string{"USER"}}, nil)
+	suite.repoOrder.On("ExportOrders", suite.ctx, &pb.ExportOrdersRequest{DeliverymanId: userID},
+		mock.AnythingOfType("func(*pb.Order) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(2).(func(*pb.Order) error)
+			suite.Require().NoError(fn(&pb.Order{Id: "a"}))
+			suite.Require().NoError(fn(&pb.Order{Id: "b"}))
+		}).
+		Return(nil)
+}
+
+func (suite *ExportUserSuite) TestExportUserValidateFailure() {
+	err := suite.svc.ExportUser(suite.ctx, &export.Request{UserID: "1234", RequesterID: userID}, suite.send)
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *ExportUserSuite) TestExportUserItself() {
+	suite.mockExport()
+
+	err := suite.svc.ExportUser(suite.ctx, &export.Request{UserID: userID, RequesterID: userID}, suite.send)
+	suite.NoError(err)
+	suite.Len(suite.parts, 4)
+	suite.Equal("80961164088", suite.parts[0].GetProfile().GetCpf())
+	suite.Equal([]string{"USER"}, suite.parts[1].GetAccount().GetRoles())
+	suite.Equal("a", suite.parts[2].GetOrder().GetId())
+	suite.Equal("b", suite.parts[3].GetOrder().GetId())
+	suite.repoAuth.AssertNotCalled(suite.T(), "FindRolesByID", mock.Anything, mock.Anything)
+}
+
+func (suite *ExportUserSuite) TestExportUserByAdmin() {
+	suite.repoAuth.On("FindRolesByID", suite.ctx, adminID).
+		Return(&shared.GetRolesResponse{Roles: []string{"ADMIN"}}, nil)
+	suite.mockExport()
+
+	err := suite.svc.ExportUser(suite.ctx, &export.Request{UserID: userID, RequesterID: adminID}, suite.send)
+	suite.NoError(err)
+	suite.Len(suite.parts, 4)
+}
+
+func (suite *ExportUserSuite) TestExportUserNotPermission() {
+	suite.repoAuth.On("FindRolesByID", suite.ctx, adminID).
+		Return(&shared.GetRolesResponse{Roles: []string{"USER"}}, nil)
+
+	err := suite.svc.ExportUser(suite.ctx, &export.Request{UserID: userID, RequesterID: adminID}, suite.send)
+	suite.Equal(codes.Unauthenticated, status.Code(err))
+	suite.Empty(suite.parts)
+	suite.repoUser.AssertNotCalled(suite.T(), "ExportUser", mock.Anything, mock.Anything)
+}
+
+func (suite *ExportUserSuite) TestExportUserWithoutAccount() {
+	suite.repoUser.On("ExportUser", suite.ctx, &pb.ExportUserRequest{UserId: userID}).
+		Return(&pb.ExportUserResponse{UserId: userID}, nil)
+	suite.repoAuth.On("GetAccount", suite.ctx, userID).
+		Return(nil, status.Error(codes.NotFound, "user not found"))
+	suite.repoOrder.On("ExportOrders", suite.ctx, mock.Anything, mock.Anything).Return(nil)
+
+	err := suite.svc.ExportUser(suite.ctx, &export.Request{UserID: userID, RequesterID: userID}, suite.send)
+	suite.NoError(err)
+	suite.Len(suite.parts, 1)
+	suite.NotNil(suite.parts[0].GetProfile())
+}
+
+func (suite *ExportUserSuite) TestExportUserOrdersFailure() {
+	suite.repoUser.On("ExportUser", suite.ctx, &pb.ExportUserRequest{UserId: userID}).
+		Return(&pb.ExportUserResponse{UserId: userID}, nil)
+	suite.repoAuth.On("GetAccount", suite.ctx, userID).
+		Return(&shared.GetAccountResponse{ID: userID}, nil)
+	suite.repoOrder.On("ExportOrders", suite.ctx, mock.Anything, mock.Anything).
+		Return(errors.New("order-data-service unavailable"))
+
+	err := suite.svc.ExportUser(suite.ctx, &export.Request{UserID: userID, RequesterID: userID}, suite.send)
+	suite.Error(err)
+}
+
+func TestExportUserSuite(t *testing.T) {
+	suite.Run(t, new(ExportUserSuite))
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/export"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+)
+
+type ExportHandler struct {
+	pb.UnimplementedExportHandlerServer
+	Handler
+}
+
+func NewExportHandler(h Handler) *ExportHandler {
+	return &ExportHandler{
+		Handler: h,
+	}
+}
+
+func (h *ExportHandler) ExportUserData(req *pb.ExportUserDataRequest, stream pb.ExportHandler_ExportUserDataServer) error {
+	slog.With("id", req.GetId(), "requesterId", req.GetRequesterId()).
+		Info("received request")
+
+	pld := export.Request{
+		UserID:      req.GetId(),
+		RequesterID: req.GetRequesterId(),
+	}
+
+	return h.service.ExportUser(stream.Context(), &pld, stream.Send)
+}
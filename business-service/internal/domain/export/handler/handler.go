@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/export"
+)
+
+type Handler struct {
+	service export.Service
+	cfg     *config.Config
+}
+
+func NewHandler(s export.Service, cfg *config.Config) *Handler {
+	return &Handler{
+		service: s,
+		cfg:     cfg,
+	}
+}
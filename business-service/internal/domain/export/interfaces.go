@@ -0,0 +1,23 @@
+package export
+
+import (
+	"context"
+
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+)
+
+type (
+	UserRepository interface {
+		ExportUser(ctx context.Context, req *pb.ExportUserRequest) (*pb.ExportUserResponse, error)
+	}
+
+	OrderRepository interface {
+		ExportOrders(ctx context.Context, req *pb.ExportOrdersRequest, fn func(*pb.Order) error) error
+	}
+
+	Service interface {
+		// ExportUser calls send for every part of the personal data of the
+		// user, in the order described by pb.UserDataPart.
+		ExportUser(ctx context.Context, pld *Request, send func(*pb.UserDataPart) error) error
+	}
+)
@@ -0,0 +1,32 @@
+package export
+
+import (
+	"github.com/google/wire"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+)
+
+var InitializeService = wire.NewSet(
+	wire.Bind(new(Service), new(*ServiceImpl)),
+	NewService,
+)
+
+type ServiceImpl struct {
+	validate        shared.Validator
+	authRepository  shared.AuthRepository
+	userRepository  UserRepository
+	orderRepository OrderRepository
+}
+
+func NewService(
+	val shared.Validator,
+	authRepo shared.AuthRepository,
+	userRepo UserRepository,
+	orderRepo OrderRepository,
+) *ServiceImpl {
+	return &ServiceImpl{
+		validate:        val,
+		authRepository:  authRepo,
+		userRepository:  userRepo,
+		orderRepository: orderRepo,
+	}
+}
@@ -3,6 +3,7 @@ package order
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
 	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
@@ -21,6 +22,10 @@ func (s *ServiceImpl) CreateOrder(ctx context.Context, pld Payload) (*pb.OrderRe
 		return nil, err
 	}
 
+	if err := s.isAvailableDeliveryman(ctx, pld.Data.DeliverymanID); err != nil {
+		return nil, err
+	}
+
 	isAdmin, err := s.hasPermissionIsAdmin(ctx, pld.Data.UserID)
 	if err != nil {
 		return nil, err
@@ -31,6 +36,10 @@ func (s *ServiceImpl) CreateOrder(ctx context.Context, pld Payload) (*pb.OrderRe
 		return nil, err
 	}
 
+	if scheduledAt := pld.ScheduledAt(); scheduledAt.After(time.Now()) {
+		return s.scheduleOrder(ctx, pld, scheduledAt)
+	}
+
 	log.Infof("get started address with postalCode: %s", pld.Data.Address.PostalCode)
 
 	address, err := s.viaCepRepository.GetAddress(ctx, pld.Data.Address.PostalCode)
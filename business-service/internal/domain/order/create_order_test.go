@@ -24,6 +24,8 @@ type CreateOrderSuite struct {
 	repoAuth   *mocks.AuthRepository_internal_shared
 	repoOrder  *mocks.Repository_internal_domain_order
 	repoViaCep *mocks.ViaCepRepository_internal_domain_order
+	repoSched  *mocks.ScheduledOrderRepository_internal_domain_order
+	repoDeliv  *mocks.DeliverymanRepository_internal_domain_order
 	ctx        context.Context
 	valErrs    noProviderVal.ValidationErrors
 	pld        order.Payload
@@ -34,11 +36,15 @@ func (suite *CreateOrderSuite) SetupTest() {
 	repoAuth := new(mocks.AuthRepository_internal_shared)
 	repoOrder := new(mocks.Repository_internal_domain_order)
 	repoViaCep := new(mocks.ViaCepRepository_internal_domain_order)
+	repoSched := new(mocks.ScheduledOrderRepository_internal_domain_order)
+	repoDeliv := new(mocks.DeliverymanRepository_internal_domain_order)
 
 	suite.repoAuth = repoAuth
 	suite.repoOrder = repoOrder
 	suite.repoViaCep = repoViaCep
-	suite.svc = order.NewService(val, repoOrder, repoAuth, repoViaCep)
+	suite.repoSched = repoSched
+	suite.repoDeliv = repoDeliv
+	suite.svc = order.NewService(val, repoOrder, repoAuth, repoViaCep, repoSched, repoDeliv)
 	suite.ctx = context.Background()
 	suite.pld = order.Payload{
 		EventDate: time.Now().Format(time.RFC3339),
@@ -56,6 +62,16 @@ func (suite *CreateOrderSuite) SetupTest() {
 	}
 }
 
+func (suite *CreateOrderSuite) availableDeliveryman(id string) {
+	suite.repoDeliv.On("GetDeliverymanProfile", suite.ctx, &pb.GetDeliverymanProfileRequest{UserId: id}).
+		Return(&pb.DeliverymanProfileResponse{
+			UserId:        id,
+			VehicleType:   "MOTORCYCLE",
+			LicenseExpiry: time.Now().AddDate(1, 0, 0).Format(time.RFC3339),
+			Available:     true,
+		}, nil)
+}
+
 func (suite *CreateOrderSuite) TestCreateOrderValidateFailure() {
 	pld := order.Payload{}
 
@@ -80,6 +96,46 @@ func (suite *CreateOrderSuite) TestCreateOrderWhenUserInative() {
 	suite.Equal(err.Error(), errUserUnauthorized.Error())
 }
 
+func (suite *CreateOrderSuite) TestCreateOrderWhenDeliverymanUnavailable() {
+	tests := []struct {
+		name    string
+		profile *pb.DeliverymanProfileResponse
+		err     error
+	}{
+		{
+			name:    "not available",
+			profile: &pb.DeliverymanProfileResponse{Available: false},
+		},
+		{
+			name: "license expired",
+			profile: &pb.DeliverymanProfileResponse{
+				Available:     true,
+				LicenseExpiry: time.Now().AddDate(0, 0, -1).Format(time.RFC3339),
+			},
+		},
+		{
+			name: "without profile",
+			err:  status.Error(codes.NotFound, "deliveryman profile not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+			pld := suite.pld
+
+			suite.repoAuth.On("IsActiveUser", suite.ctx, pld.Data.DeliverymanID).
+				Return(&shared.IsActiveUser{Active: true}, nil)
+			suite.repoDeliv.On("GetDeliverymanProfile", suite.ctx, mock.Anything).Return(tt.profile, tt.err)
+
+			_, err := suite.svc.CreateOrder(suite.ctx, pld)
+			suite.ErrorIs(err, shared.ErrDeliverymanUnavailable)
+			suite.repoAuth.AssertNotCalled(suite.T(), "FindRolesByID", mock.Anything, mock.Anything)
+			suite.repoOrder.AssertNotCalled(suite.T(), "Save", mock.Anything, mock.Anything)
+		})
+	}
+}
+
 func (suite *CreateOrderSuite) TestCreateOrderWhenUserRolesNotAdmin() {
 	pld := suite.pld
 	respIsActiveUser := &shared.IsActiveUser{
@@ -89,6 +145,8 @@ func (suite *CreateOrderSuite) TestCreateOrderWhenUserRolesNotAdmin() {
 	suite.repoAuth.On("IsActiveUser", suite.ctx, pld.Data.DeliverymanID).
 		Return(respIsActiveUser, nil)
 
+	suite.availableDeliveryman(pld.Data.DeliverymanID)
+
 	getRolesResp := &shared.GetRolesResponse{
 		Roles: []string{"USER"},
 	}
@@ -122,6 +180,8 @@ func (suite *CreateOrderSuite) TestCreateOrderWhenReturnViaCepRepositoryResponse
 	suite.repoAuth.On("IsActiveUser", suite.ctx, pld.Data.DeliverymanID).
 		Return(respIsActiveUser, nil)
 
+	suite.availableDeliveryman(pld.Data.DeliverymanID)
+
 	getRolesResp := &shared.GetRolesResponse{
 		Roles: []string{"ADMIN"},
 	}
@@ -146,6 +206,8 @@ func (suite *CreateOrderSuite) TestCreateOrderWhenAddressInvalid() {
 	suite.repoAuth.On("IsActiveUser", suite.ctx, pld.Data.DeliverymanID).
 		Return(respIsActiveUser, nil)
 
+	suite.availableDeliveryman(pld.Data.DeliverymanID)
+
 	getRolesResp := &shared.GetRolesResponse{
 		Roles: []string{"ADMIN"},
 	}
@@ -169,6 +231,8 @@ func (suite *CreateOrderSuite) TestCreateOrder() {
 	suite.repoAuth.On("IsActiveUser", suite.ctx, pld.Data.DeliverymanID).
 		Return(respIsActiveUser, nil)
 
+	suite.availableDeliveryman(pld.Data.DeliverymanID)
+
 	getRolesResp := &shared.GetRolesResponse{
 		Roles: []string{"ADMIN"},
 	}
@@ -199,6 +263,115 @@ func (suite *CreateOrderSuite) TestCreateOrder() {
 	suite.Equal(respOrderRepo, resp)
 }
 
+func (suite *CreateOrderSuite) TestCreateOrderScheduled() {
+	pld := suite.pld
+	scheduledFor := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	pld.Data.ScheduledFor = scheduledFor.Format(time.RFC3339)
+
+	suite.repoAuth.On("IsActiveUser", suite.ctx, pld.Data.DeliverymanID).
+		Return(&shared.IsActiveUser{Active: true}, nil)
+
+	suite.availableDeliveryman(pld.Data.DeliverymanID)
+
+	suite.repoAuth.On("FindRolesByID", suite.ctx, pld.Data.UserID).
+		Return(&shared.GetRolesResponse{Roles: []string{"ADMIN"}}, nil)
+
+	suite.repoSched.On("Schedule", suite.ctx, mock.MatchedBy(func(scheduled *order.ScheduledOrder) bool {
+		return scheduled.UserID == pld.Data.UserID &&
+			scheduled.ScheduledFor.Equal(scheduledFor) &&
+			scheduled.Payload == pld
+	})).Return(nil)
+
+	resp, err := suite.svc.CreateOrder(suite.ctx, pld)
+	suite.NoError(err)
+	suite.NotEmpty(resp.GetId())
+	suite.repoViaCep.AssertNotCalled(suite.T(), "GetAddress", mock.Anything, mock.Anything)
+	suite.repoOrder.AssertNotCalled(suite.T(), "Save", mock.Anything, mock.Anything)
+}
+
+func (suite *CreateOrderSuite) TestCreateOrderScheduledInThePast() {
+	pld := suite.pld
+	pld.Data.ScheduledFor = time.Now().Add(-time.Minute).Format(time.RFC3339)
+
+	suite.repoAuth.On("IsActiveUser", suite.ctx, pld.Data.DeliverymanID).
+		Return(&shared.IsActiveUser{Active: true}, nil)
+
+	suite.availableDeliveryman(pld.Data.DeliverymanID)
+
+	suite.repoAuth.On("FindRolesByID", suite.ctx, pld.Data.UserID).
+		Return(&shared.GetRolesResponse{Roles: []string{"ADMIN"}}, nil)
+
+	suite.repoViaCep.On("GetAddress", suite.ctx, pld.Data.Address.PostalCode).
+		Return(&shared.ViaCepAddressResponse{PostalCode: "12345667"}, nil)
+
+	respOrderRepo := &pb.OrderResponse{Id: "656caa24d0106f14d3aa2026"}
+	suite.repoOrder.On("Save", suite.ctx, mock.Anything).Return(respOrderRepo, nil)
+
+	resp, err := suite.svc.CreateOrder(suite.ctx, pld)
+	suite.NoError(err)
+	suite.Equal(respOrderRepo, resp)
+	suite.repoSched.AssertNotCalled(suite.T(), "Schedule", mock.Anything, mock.Anything)
+}
+
+func (suite *CreateOrderSuite) TestGetScheduledOrders() {
+	userID := suite.pld.Data.UserID
+	scheduledFor := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	suite.repoSched.On("FindByUserID", suite.ctx, userID).Return([]*order.ScheduledOrder{{
+		ID:           "8f0f6d2e-8a1c-4d3e-9b6f-1c2d3e4f5a6b",
+		UserID:       userID,
+		ScheduledFor: scheduledFor,
+		Payload:      suite.pld,
+	}}, nil)
+
+	resp, err := suite.svc.GetScheduledOrders(suite.ctx, &order.GetScheduledOrdersRequest{UserID: userID})
+	suite.NoError(err)
+	suite.Len(resp.GetOrders(), 1)
+	suite.Equal(scheduledFor.Format(time.RFC3339), resp.GetOrders()[0].GetScheduledFor())
+	suite.Equal(suite.pld.Data.DeliverymanID, resp.GetOrders()[0].GetDeliverymanId())
+	suite.Equal(order.ScheduledOrderPending, resp.GetOrders()[0].GetStatus())
+}
+
+func (suite *CreateOrderSuite) TestGetScheduledOrdersFailed() {
+	userID := suite.pld.Data.UserID
+	failedAt := time.Now().UTC().Truncate(time.Second)
+
+	suite.repoSched.On("FindByUserID", suite.ctx, userID).Return([]*order.ScheduledOrder{{
+		ID:            "8f0f6d2e-8a1c-4d3e-9b6f-1c2d3e4f5a6b",
+		UserID:        userID,
+		ScheduledFor:  failedAt.Add(-time.Minute),
+		Status:        order.ScheduledOrderFailed,
+		FailedAt:      failedAt,
+		FailureReason: "deliveryman not found",
+		Payload:       suite.pld,
+	}}, nil)
+
+	resp, err := suite.svc.GetScheduledOrders(suite.ctx, &order.GetScheduledOrdersRequest{UserID: userID})
+	suite.NoError(err)
+	suite.Len(resp.GetOrders(), 1)
+	suite.Equal(order.ScheduledOrderFailed, resp.GetOrders()[0].GetStatus())
+	suite.Equal(failedAt.Format(time.RFC3339), resp.GetOrders()[0].GetFailedAt())
+	suite.Equal("deliveryman not found", resp.GetOrders()[0].GetFailureReason())
+}
+
+func (suite *CreateOrderSuite) TestCancelScheduledOrder() {
+	userID := suite.pld.Data.UserID
+	id := "8f0f6d2e-8a1c-4d3e-9b6f-1c2d3e4f5a6b"
+
+	suite.repoSched.On("Cancel", suite.ctx, userID, id).Return(nil).Once()
+	resp, err := suite.svc.CancelScheduledOrder(suite.ctx, &order.CancelScheduledOrderRequest{UserID: userID, ID: id})
+	suite.NoError(err)
+	suite.Equal(id, resp.GetId())
+
+	suite.repoSched.On("Cancel", suite.ctx, userID, id).Return(shared.ErrScheduledOrderFired).Once()
+	_, err = suite.svc.CancelScheduledOrder(suite.ctx, &order.CancelScheduledOrderRequest{UserID: userID, ID: id})
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+
+	suite.repoSched.On("Cancel", suite.ctx, userID, id).Return(shared.ErrScheduledOrderNotFound).Once()
+	_, err = suite.svc.CancelScheduledOrder(suite.ctx, &order.CancelScheduledOrderRequest{UserID: userID, ID: id})
+	suite.Equal(codes.NotFound, status.Code(err))
+}
+
 func TestCreateOrderSuite(t *testing.T) {
 	suite.Run(t, new(CreateOrderSuite))
 }
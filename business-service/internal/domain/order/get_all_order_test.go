@@ -31,11 +31,13 @@ func (suite *GetAllOrderSuite) SetupTest() {
 	repoAuth := new(mocks.AuthRepository_internal_shared)
 	repoOrder := new(mocks.Repository_internal_domain_order)
 	repoViaCep := new(mocks.ViaCepRepository_internal_domain_order)
+	repoDeliv := new(mocks.DeliverymanRepository_internal_domain_order)
 
 	suite.repoAuth = repoAuth
 	suite.repoOrder = repoOrder
 	suite.repoViaCep = repoViaCep
-	suite.svc = order.NewService(val, repoOrder, repoAuth, repoViaCep)
+	suite.svc = order.NewService(val, repoOrder, repoAuth, repoViaCep,
+		new(mocks.ScheduledOrderRepository_internal_domain_order), repoDeliv)
 	suite.ctx = context.Background()
 	suite.getAllOrderReq = order.GetAllOrderRequest{
 		ID:            "656c916c3aa4eccdfb732a80",
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 
 	model "github.com/lucasd-coder/fast-feet/business-service/internal/domain/order"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
 	"github.com/lucasd-coder/fast-feet/pkg/logger"
 )
 
@@ -16,7 +17,7 @@ func (h *Handler) CreateOrderHandler(ctx context.Context, m []byte) error {
 	var pld model.Payload
 
 	if err := json.Unmarshal(m, &pld); err != nil {
-		return fmt.Errorf("err Unmarshal: %w", err)
+		return fmt.Errorf("%w: err Unmarshal: %w", shared.ErrUnprocessableMessage, err)
 	}
 
 	slog.With("payload", pld).Info("received payload")
@@ -30,3 +31,17 @@ func (h *Handler) CreateOrderHandler(ctx context.Context, m []byte) error {
 
 	return nil
 }
+
+// FireScheduledOrder creates an order scheduled by CreateOrderHandler.
+func (h *Handler) FireScheduledOrder(ctx context.Context, scheduled *model.ScheduledOrder) error {
+	log := logger.FromContext(ctx)
+
+	resp, err := h.service.CreateOrder(ctx, scheduled.Payload)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("scheduled order id: %s processed successfully id: %s generated", scheduled.ID, resp.GetId())
+
+	return nil
+}
@@ -28,6 +28,7 @@ type CreateOrderHandlerSuite struct {
 	repoAuth   *mocks.AuthRepository_internal_shared
 	repoOrder  *mocks.Repository_internal_domain_order
 	repoViaCep *mocks.ViaCepRepository_internal_domain_order
+	repoDeliv  *mocks.DeliverymanRepository_internal_domain_order
 	valErrs    noProviderVal.ValidationErrors
 }
 
@@ -57,12 +58,15 @@ func (suite *CreateOrderHandlerSuite) SetupTest() {
 	repoAuth := new(mocks.AuthRepository_internal_shared)
 	repoOrder := new(mocks.Repository_internal_domain_order)
 	repoViaCep := new(mocks.ViaCepRepository_internal_domain_order)
+	repoDeliv := new(mocks.DeliverymanRepository_internal_domain_order)
 
 	suite.repoAuth = repoAuth
 	suite.repoOrder = repoOrder
 	suite.repoViaCep = repoViaCep
+	suite.repoDeliv = repoDeliv
 
-	svc := order.NewService(val, repoOrder, repoAuth, repoViaCep)
+	svc := order.NewService(val, repoOrder, repoAuth, repoViaCep,
+		new(mocks.ScheduledOrderRepository_internal_domain_order), repoDeliv)
 	suite.handler = handler.NewHandler(svc, &suite.cfg)
 }
 
@@ -106,6 +110,14 @@ func (suite *CreateOrderHandlerSuite) TestCreateOrder() {
 		Active: true,
 	}, nil)
 
+	suite.repoDeliv.On("GetDeliverymanProfile", suite.ctx, &pb.GetDeliverymanProfileRequest{
+		UserId: deliverymanID,
+	}).Return(&pb.DeliverymanProfileResponse{
+		UserId:      deliverymanID,
+		VehicleType: "BICYCLE",
+		Available:   true,
+	}, nil)
+
 	suite.repoAuth.On("FindRolesByID", suite.ctx, userID).Return(&shared.GetRolesResponse{
 		Roles: []string{"admin"},
 	}, nil)
@@ -38,6 +38,43 @@ func (g *OrderHandler) GetAllOrder(ctx context.Context, req *pb.GetAllOrderReque
 	return resp, nil
 }
 
+func (g *OrderHandler) GetScheduledOrders(ctx context.Context, req *pb.GetScheduledOrdersRequest) (
+	*pb.GetScheduledOrdersResponse, error) {
+	log := logger.FromContext(ctx)
+
+	slog.With("payload", req).Info("received request")
+
+	resp, err := g.service.GetScheduledOrders(ctx, &order.GetScheduledOrdersRequest{
+		UserID: req.GetUserId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("successfully fetching scheduled orders")
+
+	return resp, nil
+}
+
+func (g *OrderHandler) CancelScheduledOrder(ctx context.Context, req *pb.CancelScheduledOrderRequest) (
+	*pb.CancelScheduledOrderResponse, error) {
+	log := logger.FromContext(ctx)
+
+	slog.With("payload", req).Info("received request")
+
+	resp, err := g.service.CancelScheduledOrder(ctx, &order.CancelScheduledOrderRequest{
+		UserID: req.GetUserId(),
+		ID:     req.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("successfully canceling scheduled order id: %s", req.GetId())
+
+	return resp, nil
+}
+
 func (g *OrderHandler) newGetAllOrderRequest(req *pb.GetAllOrderRequest) *order.GetAllOrderRequest {
 	address := order.GetAddress{
 		Address:      req.GetAddresses().GetAddress(),
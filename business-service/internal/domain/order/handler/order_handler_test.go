@@ -75,11 +75,13 @@ func (suite *OrderHandlerSuite) SetupTest() {
 	repoAuth := new(mocks.AuthRepository_internal_shared)
 	repoOrder := new(mocks.Repository_internal_domain_order)
 	repoViaCep := new(mocks.ViaCepRepository_internal_domain_order)
+	repoDeliv := new(mocks.DeliverymanRepository_internal_domain_order)
 
 	suite.repoAuth = repoAuth
 	suite.repoOrder = repoOrder
 	suite.repoViaCep = repoViaCep
-	svc := order.NewService(val, repoOrder, repoAuth, repoViaCep)
+	svc := order.NewService(val, repoOrder, repoAuth, repoViaCep,
+		new(mocks.ScheduledOrderRepository_internal_domain_order), repoDeliv)
 	hdler := handler.NewHandler(svc, &suite.cfg)
 	suite.orderHandler = handler.NewOrderHandler(*hdler)
 
@@ -18,8 +18,22 @@ type (
 			req *pb.GetOrderServiceAllOrderRequest) (*pb.GetAllOrderResponse, error)
 	}
 
+	DeliverymanRepository interface {
+		GetDeliverymanProfile(ctx context.Context,
+			req *pb.GetDeliverymanProfileRequest) (*pb.DeliverymanProfileResponse, error)
+	}
+
+	ScheduledOrderRepository interface {
+		Schedule(ctx context.Context, order *ScheduledOrder) error
+		FindByUserID(ctx context.Context, userID string) ([]*ScheduledOrder, error)
+		Cancel(ctx context.Context, userID, id string) error
+	}
+
 	Service interface {
 		GetAllOrder(ctx context.Context, pld *GetAllOrderRequest) (*pb.GetAllOrderResponse, error)
 		CreateOrder(ctx context.Context, pld Payload) (*pb.OrderResponse, error)
+		GetScheduledOrders(ctx context.Context, pld *GetScheduledOrdersRequest) (*pb.GetScheduledOrdersResponse, error)
+		CancelScheduledOrder(ctx context.Context,
+			pld *CancelScheduledOrderRequest) (*pb.CancelScheduledOrderResponse, error)
 	}
 )
@@ -1,6 +1,8 @@
 package order
 
 import (
+	"time"
+
 	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
 )
 
@@ -14,6 +16,7 @@ type Data struct {
 	DeliverymanID string  `json:"deliverymanId,omitempty" validate:"required,uuid4" `
 	Product       Product `json:"product,omitempty" validate:"required"`
 	Address       Address `json:"address,omitempty" validate:"required"`
+	ScheduledFor  string  `json:"scheduledFor,omitempty" validate:"rfc3339"`
 }
 
 type Product struct {
@@ -29,6 +32,59 @@ func (payload *Payload) Validate(val shared.Validator) error {
 	return val.ValidateStruct(payload)
 }
 
+// ScheduledAt returns when the order should be created, the zero time when
+// it is created right away.
+func (payload *Payload) ScheduledAt() time.Time {
+	scheduledAt, err := time.Parse(time.RFC3339, payload.Data.ScheduledFor)
+	if err != nil {
+		return time.Time{}
+	}
+	return scheduledAt
+}
+
+// The status of a scheduled order. A FAILED order ran out of attempts and is
+// kept so the user sees it was not created, until the user cancels it.
+const (
+	ScheduledOrderPending = "SCHEDULED"
+	ScheduledOrderFailed  = "FAILED"
+)
+
+// ScheduledOrder keeps an order event until its scheduled time, when the
+// payload goes through CreateOrder again.
+type ScheduledOrder struct {
+	ID            string    `json:"id"`
+	UserID        string    `json:"userId"`
+	ScheduledFor  time.Time `json:"scheduledFor"`
+	CreatedAt     time.Time `json:"createdAt"`
+	Attempts      int       `json:"attempts,omitempty"`
+	Status        string    `json:"status,omitempty"`
+	FailedAt      time.Time `json:"failedAt,omitempty"`
+	FailureReason string    `json:"failureReason,omitempty"`
+	Payload       Payload   `json:"payload"`
+}
+
+// Failed reports whether the order ran out of attempts.
+func (s *ScheduledOrder) Failed() bool {
+	return s.Status == ScheduledOrderFailed
+}
+
+type GetScheduledOrdersRequest struct {
+	UserID string `json:"userId,omitempty" validate:"required,uuid4"`
+}
+
+func (g *GetScheduledOrdersRequest) Validate(val shared.Validator) error {
+	return val.ValidateStruct(g)
+}
+
+type CancelScheduledOrderRequest struct {
+	UserID string `json:"userId,omitempty" validate:"required,uuid4"`
+	ID     string `json:"id,omitempty" validate:"required,uuid4"`
+}
+
+func (c *CancelScheduledOrderRequest) Validate(val shared.Validator) error {
+	return val.ValidateStruct(c)
+}
+
 type GetAllOrderRequest struct {
 	ID            string     `json:"id,omitempty" validate:"objectID"`
 	UserID        string     `json:"userId,omitempty" validate:"required,uuid4"`
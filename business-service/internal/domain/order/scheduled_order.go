@@ -0,0 +1,113 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+)
+
+// scheduleOrder keeps pld until scheduledAt, the deliveryman and the
+// permission are checked again when it fires.
+func (s *ServiceImpl) scheduleOrder(ctx context.Context, pld Payload, scheduledAt time.Time) (*pb.OrderResponse, error) {
+	log := logger.FromContext(ctx)
+
+	now := time.Now().UTC()
+	scheduled := &ScheduledOrder{
+		ID:           uuid.NewString(),
+		UserID:       pld.Data.UserID,
+		ScheduledFor: scheduledAt.UTC(),
+		CreatedAt:    now,
+		Status:       ScheduledOrderPending,
+		Payload:      pld,
+	}
+
+	if err := s.scheduledRepo.Schedule(ctx, scheduled); err != nil {
+		return nil, fmt.Errorf("error when schedule order err: %w", err)
+	}
+
+	log.Infof("order id: %s scheduled for: %s", scheduled.ID, pld.Data.ScheduledFor)
+
+	return &pb.OrderResponse{
+		Id:        scheduled.ID,
+		CreatedAt: now.Format(time.RFC3339),
+	}, nil
+}
+
+func (s *ServiceImpl) GetScheduledOrders(ctx context.Context,
+	pld *GetScheduledOrdersRequest) (*pb.GetScheduledOrdersResponse, error) {
+	if err := pld.Validate(s.validate); err != nil {
+		return nil, shared.ValidationErrors(err)
+	}
+
+	orders, err := s.scheduledRepo.FindByUserID(ctx, pld.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error when find scheduled orders err: %w", err)
+	}
+
+	resp := &pb.GetScheduledOrdersResponse{
+		Orders: make([]*pb.ScheduledOrder, 0, len(orders)),
+	}
+	for _, order := range orders {
+		resp.Orders = append(resp.Orders, newScheduledOrderResponse(order))
+	}
+
+	return resp, nil
+}
+
+func (s *ServiceImpl) CancelScheduledOrder(ctx context.Context,
+	pld *CancelScheduledOrderRequest) (*pb.CancelScheduledOrderResponse, error) {
+	log := logger.FromContext(ctx)
+
+	if err := pld.Validate(s.validate); err != nil {
+		return nil, shared.ValidationErrors(err)
+	}
+
+	if err := s.scheduledRepo.Cancel(ctx, pld.UserID, pld.ID); err != nil {
+		switch {
+		case errors.Is(err, shared.ErrScheduledOrderNotFound):
+			return nil, shared.NotFoundError(err)
+		case errors.Is(err, shared.ErrScheduledOrderFired):
+			return nil, shared.FailedPreconditionError(err)
+		default:
+			return nil, fmt.Errorf("error when cancel scheduled order err: %w", err)
+		}
+	}
+
+	log.Infof("scheduled order id: %s canceled", pld.ID)
+
+	return &pb.CancelScheduledOrderResponse{
+		Id:         pld.ID,
+		CanceledAt: time.Now().UTC().Format(time.RFC3339),
+	}, nil
+}
+
+func newScheduledOrderResponse(order *ScheduledOrder) *pb.ScheduledOrder {
+	data := order.Payload.Data
+	resp := &pb.ScheduledOrder{
+		Id:            order.ID,
+		UserId:        order.UserID,
+		DeliverymanId: data.DeliverymanID,
+		Product:       &pb.Product{Name: data.Product.Name},
+		Addresses: &pb.Address{
+			PostalCode: data.Address.PostalCode,
+			Number:     data.Address.Number,
+		},
+		ScheduledFor: order.ScheduledFor.Format(time.RFC3339),
+		CreatedAt:    order.CreatedAt.Format(time.RFC3339),
+		Status:       ScheduledOrderPending,
+	}
+
+	if order.Failed() {
+		resp.Status = ScheduledOrderFailed
+		resp.FailedAt = order.FailedAt.Format(time.RFC3339)
+		resp.FailureReason = order.FailureReason
+	}
+
+	return resp
+}
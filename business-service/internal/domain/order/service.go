@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/wire"
 	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
 	"github.com/lucasd-coder/fast-feet/pkg/logger"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -22,6 +24,8 @@ type ServiceImpl struct {
 	orderRepository  Repository
 	authRepository   shared.AuthRepository
 	viaCepRepository ViaCepRepository
+	scheduledRepo    ScheduledOrderRepository
+	deliverymanRepo  DeliverymanRepository
 }
 
 func NewService(
@@ -29,12 +33,16 @@ func NewService(
 	orderRepo Repository,
 	authRepo shared.AuthRepository,
 	viaCepRepo ViaCepRepository,
+	scheduledRepo ScheduledOrderRepository,
+	deliverymanRepo DeliverymanRepository,
 ) *ServiceImpl {
 	return &ServiceImpl{
 		validate:         val,
 		orderRepository:  orderRepo,
 		authRepository:   authRepo,
 		viaCepRepository: viaCepRepo,
+		scheduledRepo:    scheduledRepo,
+		deliverymanRepo:  deliverymanRepo,
 	}
 }
 
@@ -58,6 +66,41 @@ func (s *ServiceImpl) hasActiveUser(ctx context.Context, id string) error {
 	return nil
 }
 
+// isAvailableDeliveryman requires a deliveryman profile flagged available
+// with a licence, when the vehicle needs one, that has not expired.
+func (s *ServiceImpl) isAvailableDeliveryman(ctx context.Context, id string) error {
+	log := logger.FromContext(ctx)
+
+	log.Infof("get started to check is available deliveryman with id: %s", id)
+
+	profile, err := s.deliverymanRepo.GetDeliverymanProfile(ctx, &pb.GetDeliverymanProfileRequest{UserId: id})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			log.Errorf("deliveryman profile not found with id: %s", id)
+			return fmt.Errorf("%w: deliveryman profile not found with id: %s", shared.ErrDeliverymanUnavailable, id)
+		}
+		return err
+	}
+
+	if !profile.GetAvailable() {
+		log.Errorf("deliveryman not available with id: %s", id)
+		return fmt.Errorf("%w: deliveryman not available with id: %s", shared.ErrDeliverymanUnavailable, id)
+	}
+
+	if expiry := profile.GetLicenseExpiry(); expiry != "" {
+		licenseExpiry, err := time.Parse(time.RFC3339, expiry)
+		if err != nil {
+			return fmt.Errorf("fail to parse license expiry of deliveryman id: %s err: %w", id, err)
+		}
+		if licenseExpiry.Before(time.Now()) {
+			log.Errorf("deliveryman license expired with id: %s", id)
+			return fmt.Errorf("%w: deliveryman license expired with id: %s", shared.ErrDeliverymanUnavailable, id)
+		}
+	}
+
+	return nil
+}
+
 func (s *ServiceImpl) hasPermissionIsAdmin(ctx context.Context, id string) (bool, error) {
 	log := logger.FromContext(ctx)
 
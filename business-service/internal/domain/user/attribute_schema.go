@@ -0,0 +1,26 @@
+package user
+
+import (
+	"context"
+
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+)
+
+// SaveAttributeSchema is validated by user-manager-service, which owns the
+// schema and reports each invalid field.
+func (s *ServiceImpl) SaveAttributeSchema(ctx context.Context, req *pb.AttributeSchemaRequest) (*pb.AttributeSchemaResponse, error) {
+	log := logger.FromContext(ctx)
+
+	log.Info("calling userRepository")
+
+	return s.userRepository.SaveAttributeSchema(ctx, req)
+}
+
+func (s *ServiceImpl) GetAttributeSchema(ctx context.Context) (*pb.AttributeSchemaResponse, error) {
+	log := logger.FromContext(ctx)
+
+	log.Info("calling userRepository")
+
+	return s.userRepository.GetAttributeSchema(ctx, &pb.GetAttributeSchemaRequest{})
+}
@@ -2,7 +2,9 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
 	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
@@ -28,14 +30,95 @@ func (s *ServiceImpl) Save(ctx context.Context, pld *Payload) (*pb.UserResponse,
 		return nil, err
 	}
 
-	register, err := s.registerAndReturn(ctx, pld)
+	reg, resumed, err := s.beginRegistration(ctx, pld.Data.Email)
 	if err != nil {
-		log.Errorf("error while call auth-service err: %v", err)
+		log.Errorf("error when begin registration: %v", err)
 		return nil, err
 	}
 
+	return s.register(ctx, reg, resumed, pld)
+}
+
+// beginRegistration returns the registration of the email, resuming a
+// pending one started by the same request once its lease expired. A pending
+// registration of another request is not taken over, as the account it
+// created is not ours, and one still leased is reported as in progress. A
+// finished registration is started again and a compensation left behind is
+// completed first.
+func (s *ServiceImpl) beginRegistration(ctx context.Context, email string) (*Registration, bool, error) {
+	reg := NewRegistration(email, registrationOwner(ctx))
+
+	created, err := s.registrationRepository.Create(ctx, reg)
+	if err != nil {
+		return nil, false, fmt.Errorf("error when create registration err: %w", err)
+	}
+
+	if created {
+		return reg, false, nil
+	}
+
+	prev, err := s.registrationRepository.Find(ctx, reg.Key)
+	if err != nil && !errors.Is(err, shared.ErrRegistrationNotFound) {
+		return nil, false, fmt.Errorf("error when find registration err: %w", err)
+	}
+
+	next, resumed := reg, false
+	if prev != nil {
+		switch prev.Status {
+		case RegistrationPending:
+			if prev.Leased(time.Now()) {
+				return nil, false, fmt.Errorf("error registration key: %s: %w", prev.Key, shared.ErrRegistrationInProgress)
+			}
+			if prev.Owner == reg.Owner {
+				resume := *prev
+				next, resumed = &resume, true
+			}
+		case RegistrationCompensating:
+			if err := s.Compensate(ctx, prev); err != nil {
+				return nil, false, err
+			}
+		}
+	}
+
+	replaced, err := s.registrationRepository.Replace(ctx, prev, next)
+	if err != nil {
+		return nil, false, fmt.Errorf("error when replace registration err: %w", err)
+	}
+
+	if !replaced {
+		return nil, false, fmt.Errorf("error registration key: %s: %w", reg.Key, shared.ErrRegistrationInProgress)
+	}
+
+	return next, resumed, nil
+}
+
+// register runs the pending steps of reg, saving it after each one. When the
+// profile can't be saved the account is deleted, so a failed registration
+// leaves no partially created user behind.
+func (s *ServiceImpl) register(ctx context.Context, reg *Registration, resumed bool,
+	pld *Payload) (*pb.UserResponse, error) {
+	log := logger.FromContext(ctx)
+
+	if reg.Steps == nil {
+		reg.Steps = map[string]time.Time{}
+	}
+
+	if !reg.Done(StepRegisterAccount) {
+		accountID, err := s.registerAccount(ctx, pld, resumed)
+		if err != nil {
+			log.Errorf("error while call auth-service err: %v", err)
+			return nil, s.failRegistration(ctx, reg, StepRegisterAccount, err)
+		}
+
+		reg.AccountID = accountID
+		reg.Steps[StepRegisterAccount] = time.Now().UTC()
+		if err := s.registrationRepository.Update(ctx, reg); err != nil {
+			return nil, fmt.Errorf("error when update registration err: %w", err)
+		}
+	}
+
 	req := &pb.UserRequest{
-		Id:         register.ID,
+		Id:         reg.AccountID,
 		Name:       pld.Data.Name,
 		Email:      pld.Data.Email,
 		Cpf:        pld.Data.CPF,
@@ -43,13 +126,138 @@ func (s *ServiceImpl) Save(ctx context.Context, pld *Payload) (*pb.UserResponse,
 	}
 
 	user, err := s.userRepository.Save(ctx, req)
+	if status.Code(err) == codes.AlreadyExists {
+		user, err = s.savedProfile(ctx, reg, pld.Data.Email, err)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("error when calling save: %w", err)
+		return nil, fmt.Errorf("error when calling save: %w",
+			s.failRegistration(ctx, reg, StepSaveProfile, err))
+	}
+
+	reg.Steps[StepSaveProfile] = time.Now().UTC()
+	reg.Status = RegistrationCompleted
+	reg.CompletedAt = time.Now().UTC()
+	reg.LastError = ""
+
+	if err := s.registrationRepository.Complete(ctx, reg); err != nil {
+		log.Errorf("error completing registration of account id: %s, err: %v", reg.AccountID, err)
 	}
 
 	return user, nil
 }
 
+// savedProfile returns the profile of the email when it is the one of the
+// registration account, saved by an earlier attempt, or errSave otherwise.
+func (s *ServiceImpl) savedProfile(ctx context.Context, reg *Registration, email string,
+	errSave error) (*pb.UserResponse, error) {
+	user, err := s.userRepository.FindByEmail(ctx, &pb.UserByEmailRequest{Email: email})
+	if err != nil || user.GetId() != reg.AccountID {
+		return nil, errSave
+	}
+	return user, nil
+}
+
+// registerAccount creates the account of the user. An account found with the
+// same email is only taken over by a resumed registration, which may have
+// created it before failing, otherwise it is reported as left behind.
+func (s *ServiceImpl) registerAccount(ctx context.Context, pld *Payload, resumed bool) (string, error) {
+	log := logger.FromContext(ctx)
+
+	account, err := s.authRepository.FindByEmail(ctx, pld.Data.Email)
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			log.Errorf("err while call auth-service FindByEmail: %v", err)
+			return "", err
+		}
+	}
+
+	if account != nil && account.ID != "" {
+		if resumed {
+			return account.ID, nil
+		}
+		return "", fmt.Errorf("error registering account id: %s: %w", account.ID, shared.ErrAccountWithoutProfile)
+	}
+
+	register, err := s.authRepository.Register(ctx, pld.ToRegister())
+	if err != nil {
+		log.Errorf("err while call auth-service Register: %v", err)
+		return "", err
+	}
+
+	return register.ID, nil
+}
+
+// failRegistration records the failure of step and returns err. The
+// registration is resumed when the outcome is unknown, it fails for good
+// when an account is left behind or a profile with the email already exists,
+// as the account may be the one of that profile, and is compensated
+// otherwise. A known failure releases the lease so a retry resumes it.
+func (s *ServiceImpl) failRegistration(ctx context.Context, reg *Registration, step string, err error) error {
+	log := logger.FromContext(ctx)
+
+	reg.Attempts++
+	reg.LastError = fmt.Sprintf("%s: %v", step, err)
+
+	switch {
+	case errors.Is(err, shared.ErrAccountWithoutProfile),
+		step == StepSaveProfile && status.Code(err) == codes.AlreadyExists:
+		log.Errorf("registration key: %s failed, %v", reg.Key, err)
+		reg.Status = RegistrationFailed
+		reg.Reason = reg.LastError
+		reg.CompletedAt = time.Now().UTC()
+		if err := s.registrationRepository.Complete(ctx, reg); err != nil {
+			log.Errorf("error completing registration key: %s, err: %v", reg.Key, err)
+		}
+	case step == StepRegisterAccount || uncertain(err):
+		if !uncertain(err) {
+			reg.LeaseUntil = time.Time{}
+		}
+		if err := s.registrationRepository.Update(ctx, reg); err != nil {
+			log.Errorf("error updating registration key: %s, err: %v", reg.Key, err)
+		}
+	default:
+		log.Errorf("registration of account id: %s partially created, compensating: %v", reg.AccountID, err)
+		reg.Status = RegistrationCompensating
+		reg.Reason = reg.LastError
+		if err := s.registrationRepository.Update(ctx, reg); err != nil {
+			log.Errorf("error updating registration key: %s, err: %v", reg.Key, err)
+		}
+		if err := s.registrationRepository.Enqueue(ctx, reg.Key); err != nil {
+			log.Errorf("error enqueuing registration key: %s, err: %v", reg.Key, err)
+		}
+		if err := s.Compensate(ctx, reg); err != nil {
+			log.Errorf("error compensating registration of account id: %s, err: %v", reg.AccountID, err)
+		}
+	}
+
+	return err
+}
+
+// Compensate deletes the account created by the registration r. On error r
+// is saved with the failure and compensated again by the worker.
+func (s *ServiceImpl) Compensate(ctx context.Context, r *Registration) error {
+	log := logger.FromContext(ctx)
+
+	if r.AccountID != "" {
+		if err := ignoreNotFound(s.authRepository.DeleteUser(ctx, r.AccountID)); err != nil {
+			r.Attempts++
+			r.LastError = fmt.Sprintf("compensate: %v", err)
+			if err := s.registrationRepository.Update(ctx, r); err != nil {
+				log.Errorf("error updating registration key: %s, err: %v", r.Key, err)
+			}
+			return fmt.Errorf("error when delete account err: %w", err)
+		}
+	}
+
+	log.Warnf("registration of account id: %s compensated, reason: %s", r.AccountID, r.Reason)
+
+	r.Status = RegistrationCompensated
+	r.CompletedAt = time.Now().UTC()
+	r.LastError = ""
+
+	return s.registrationRepository.Complete(ctx, r)
+}
+
 func (s *ServiceImpl) validadeUserWithEmail(ctx context.Context, email string) error {
 	log := logger.FromContext(ctx)
 
@@ -91,28 +299,3 @@ func (s *ServiceImpl) validadeUserWithCpf(ctx context.Context, cpf string) error
 	}
 	return nil
 }
-
-func (s *ServiceImpl) registerAndReturn(ctx context.Context, pld *Payload) (*shared.RegisterUserResponse, error) {
-	log := logger.FromContext(ctx)
-
-	user, err := s.authRepository.FindByEmail(ctx, pld.Data.Email)
-	if err != nil {
-		if status.Code(err) != codes.NotFound {
-			log.Errorf("err while call auth-service FindByEmail: %v", err)
-			return nil, err
-		}
-	}
-
-	if user == nil || user.ID == "" {
-		register, err := s.authRepository.Register(ctx, pld.ToRegister())
-		if err != nil {
-			log.Errorf("err while call auth-service Register: %v", err)
-			return nil, err
-		}
-		return register, nil
-	}
-
-	return &shared.RegisterUserResponse{
-		ID: user.ID,
-	}, nil
-}
@@ -13,18 +13,22 @@ import (
 	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/validator"
 	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
 	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+	"github.com/lucasd-coder/fast-feet/pkg/envelope"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const owner = "8a1d6f2e-3c4b-4f0e-9a7d-5b2c1e0f9d3a"
+
 type CreateUserSuite struct {
 	suite.Suite
 	ctx      context.Context
 	valErrs  noProviderVal.ValidationErrors
 	repoAuth *mocks.AuthRepository_internal_shared
 	repoUser *mocks.Repository_internal_domain_user
+	repoReg  *mocks.RegistrationRepository_internal_domain_user
 	svc      user.Service
 }
 
@@ -32,11 +36,13 @@ func (suite *CreateUserSuite) SetupTest() {
 	val := validator.NewValidation()
 	repoAuth := new(mocks.AuthRepository_internal_shared)
 	repoUser := new(mocks.Repository_internal_domain_user)
+	repoReg := new(mocks.RegistrationRepository_internal_domain_user)
 
 	suite.repoAuth = repoAuth
 	suite.repoUser = repoUser
-	suite.svc = user.NewService(repoUser, repoAuth, val)
-	suite.ctx = context.Background()
+	suite.repoReg = repoReg
+	suite.svc = user.NewService(repoUser, repoAuth, repoReg, val)
+	suite.ctx = envelope.NewContext(context.Background(), &envelope.Envelope{ID: owner})
 }
 
 func (suite *CreateUserSuite) TestCreateUser_ValidateFailure() {
@@ -117,7 +123,7 @@ func (suite *CreateUserSuite) TestCreateUser_ValidadeUserWithCpf() {
 	suite.EqualError(err, errUserAlreadyExist.Error())
 }
 
-func (suite *CreateUserSuite) TestCreateUser_RegisterAndReturnWhenUserAlreadyExist() {
+func (suite *CreateUserSuite) TestCreateUser_AccountWithoutProfile() {
 	pld := &user.Payload{
 		Data: user.Data{
 			Name:      "maria",
@@ -144,25 +150,21 @@ func (suite *CreateUserSuite) TestCreateUser_RegisterAndReturnWhenUserAlreadyExi
 		Enabled:  true,
 	}
 
-	userResp := &pb.UserResponse{
-		Id:         "46c77402-ba50-4b48-9bd9-1c4f97e36565",
-		Name:       pld.Data.Name,
-		Email:      pld.Data.Email,
-		Attributes: pld.Data.Attributes,
-	}
-
 	suite.repoUser.On("FindByEmail", suite.ctx, userByEmailRequest).Return(&pb.UserResponse{}, nil)
 
 	suite.repoUser.On("FindByCpf", suite.ctx, userByCpfRequest).Return(&pb.UserResponse{}, nil)
 
+	suite.repoReg.On("Create", suite.ctx, mock.Anything).Return(true, nil)
+
 	suite.repoAuth.On("FindByEmail", suite.ctx, pld.Data.Email).Return(getUserResp, nil)
 
-	suite.repoUser.On("Save", suite.ctx, mock.Anything).Return(userResp, nil)
+	suite.repoReg.On("Complete", suite.ctx, mock.Anything).Return(nil)
 
-	resp, err := suite.svc.Save(suite.ctx, pld)
-	suite.NoError(err)
-	suite.Equal(resp.GetEmail(), pld.Data.Email)
-	suite.Equal(resp.GetName(), pld.Data.Name)
+	_, err := suite.svc.Save(suite.ctx, pld)
+	suite.ErrorIs(err, shared.ErrAccountWithoutProfile)
+	suite.repoAuth.AssertNotCalled(suite.T(), "Register", mock.Anything, mock.Anything)
+	suite.repoUser.AssertNotCalled(suite.T(), "Save", mock.Anything, mock.Anything)
+	suite.Equal(user.RegistrationFailed, suite.lastRegistration("Complete").Status)
 }
 
 func (suite *CreateUserSuite) TestCreateUser() {
@@ -206,10 +208,17 @@ func (suite *CreateUserSuite) TestCreateUser() {
 
 	suite.repoUser.On("Save", suite.ctx, mock.Anything).Return(userResp, nil)
 
+	suite.registration()
+
 	resp, err := suite.svc.Save(suite.ctx, pld)
 	suite.NoError(err)
 	suite.Equal(resp.GetEmail(), pld.Data.Email)
 	suite.Equal(resp.GetName(), pld.Data.Name)
+
+	reg := suite.lastRegistration("Complete")
+	suite.Equal(user.RegistrationCompleted, reg.Status)
+	suite.Equal(register.ID, reg.AccountID)
+	suite.True(reg.Done(user.StepSaveProfile))
 }
 
 func (suite *CreateUserSuite) TestCreateUser_ValidadeUserWithEmailWhenUnknownFailure() {
@@ -299,11 +308,286 @@ func (suite *CreateUserSuite) TestCreateUser_RegisterAndReturnWhenUserAlreadyExi
 
 	suite.repoAuth.On("FindByEmail", suite.ctx, pld.Data.Email).Return(nil, errResp)
 
+	suite.registration()
+
 	_, err := suite.svc.Save(suite.ctx, pld)
 	suite.Error(err)
 	suite.EqualError(err, errResp.Error())
 }
 
+func (suite *CreateUserSuite) TestCreateUser_ResumesPendingRegistration() {
+	pld := suite.payload()
+	accountID := "46c77402-ba50-4b48-9bd9-1c4f97e36565"
+
+	pending := user.NewRegistration(pld.Data.Email, owner)
+	pending.Attempts = 1
+	pending.LeaseUntil = time.Now().Add(-time.Second)
+
+	suite.uniqueUser(pld)
+	suite.repoReg.On("Create", suite.ctx, mock.Anything).Return(false, nil)
+	suite.repoReg.On("Find", suite.ctx, pending.Key).Return(pending, nil)
+	suite.repoReg.On("Replace", suite.ctx, pending, mock.Anything).Return(true, nil)
+	suite.repoReg.On("Update", suite.ctx, mock.Anything).Return(nil)
+	suite.repoReg.On("Complete", suite.ctx, mock.Anything).Return(nil)
+
+	suite.repoAuth.On("FindByEmail", suite.ctx, pld.Data.Email).Return(&shared.GetUserResponse{ID: accountID}, nil)
+
+	suite.repoUser.On("Save", suite.ctx, mock.MatchedBy(func(req *pb.UserRequest) bool {
+		return req.GetId() == accountID
+	})).Return(&pb.UserResponse{Id: accountID}, nil)
+
+	_, err := suite.svc.Save(suite.ctx, pld)
+	suite.NoError(err)
+	suite.repoAuth.AssertNotCalled(suite.T(), "Register", mock.Anything, mock.Anything)
+	suite.Equal(user.RegistrationCompleted, suite.lastRegistration("Complete").Status)
+}
+
+func (suite *CreateUserSuite) TestCreateUser_CompensatesWhenSaveFails() {
+	pld := suite.payload()
+	register := &shared.RegisterUserResponse{ID: "46c77402-ba50-4b48-9bd9-1c4f97e36565"}
+	errSave := status.Error(codes.InvalidArgument, "invalid attributes")
+
+	suite.uniqueUser(pld)
+	suite.registration()
+	suite.repoReg.On("Enqueue", suite.ctx, user.RegistrationKey(pld.Data.Email)).Return(nil)
+
+	suite.repoAuth.On("FindByEmail", suite.ctx, pld.Data.Email).Return(&shared.GetUserResponse{}, nil)
+	suite.repoAuth.On("Register", suite.ctx, mock.Anything).Return(register, nil)
+	suite.repoAuth.On("DeleteUser", suite.ctx, register.ID).Return(nil)
+
+	suite.repoUser.On("Save", suite.ctx, mock.Anything).Return(nil, errSave)
+
+	_, err := suite.svc.Save(suite.ctx, pld)
+	suite.ErrorIs(err, errSave)
+	suite.repoAuth.AssertCalled(suite.T(), "DeleteUser", suite.ctx, register.ID)
+
+	reg := suite.lastRegistration("Complete")
+	suite.Equal(user.RegistrationCompensated, reg.Status)
+	suite.Contains(reg.Reason, user.StepSaveProfile)
+}
+
+func (suite *CreateUserSuite) TestCreateUser_KeepsCompensationWhenDeleteFails() {
+	pld := suite.payload()
+	register := &shared.RegisterUserResponse{ID: "46c77402-ba50-4b48-9bd9-1c4f97e36565"}
+
+	suite.uniqueUser(pld)
+	suite.registration()
+	suite.repoReg.On("Enqueue", suite.ctx, user.RegistrationKey(pld.Data.Email)).Return(nil)
+
+	suite.repoAuth.On("FindByEmail", suite.ctx, pld.Data.Email).Return(&shared.GetUserResponse{}, nil)
+	suite.repoAuth.On("Register", suite.ctx, mock.Anything).Return(register, nil)
+	suite.repoAuth.On("DeleteUser", suite.ctx, register.ID).Return(status.Error(codes.Unavailable, "unavailable"))
+
+	suite.repoUser.On("Save", suite.ctx, mock.Anything).Return(nil, status.Error(codes.Internal, "internal"))
+
+	_, err := suite.svc.Save(suite.ctx, pld)
+	suite.Error(err)
+	suite.repoReg.AssertNotCalled(suite.T(), "Complete", mock.Anything, mock.Anything)
+
+	reg := suite.lastRegistration("Update")
+	suite.Equal(user.RegistrationCompensating, reg.Status)
+	suite.Equal(register.ID, reg.AccountID)
+	suite.NotEmpty(reg.LastError)
+}
+
+func (suite *CreateUserSuite) TestCreateUser_KeepsPendingWhenSaveTimesOut() {
+	pld := suite.payload()
+	register := &shared.RegisterUserResponse{ID: "46c77402-ba50-4b48-9bd9-1c4f97e36565"}
+
+	suite.uniqueUser(pld)
+	suite.registration()
+
+	suite.repoAuth.On("FindByEmail", suite.ctx, pld.Data.Email).Return(&shared.GetUserResponse{}, nil)
+	suite.repoAuth.On("Register", suite.ctx, mock.Anything).Return(register, nil)
+
+	suite.repoUser.On("Save", suite.ctx, mock.Anything).Return(nil, status.Error(codes.DeadlineExceeded, "timeout"))
+
+	_, err := suite.svc.Save(suite.ctx, pld)
+	suite.Error(err)
+	suite.repoAuth.AssertNotCalled(suite.T(), "DeleteUser", mock.Anything, mock.Anything)
+
+	reg := suite.lastRegistration("Update")
+	suite.Equal(user.RegistrationPending, reg.Status)
+	suite.True(reg.Done(user.StepRegisterAccount))
+	suite.Equal(1, reg.Attempts)
+}
+
+func (suite *CreateUserSuite) TestCreateUser_FinishesCompensationBeforeStarting() {
+	pld := suite.payload()
+	register := &shared.RegisterUserResponse{ID: "46c77402-ba50-4b48-9bd9-1c4f97e36565"}
+
+	left := user.NewRegistration(pld.Data.Email, "0c4e2b7a-6d1f-4a3b-8e5c-9f7d2a1b3c4d")
+	left.Status = user.RegistrationCompensating
+	left.AccountID = "0f8fad5b-d9cb-469f-a165-70867728950e"
+
+	suite.uniqueUser(pld)
+	suite.repoReg.On("Create", suite.ctx, mock.Anything).Return(false, nil)
+	suite.repoReg.On("Find", suite.ctx, left.Key).Return(left, nil)
+	suite.repoReg.On("Replace", suite.ctx, left, mock.Anything).Return(true, nil)
+	suite.repoReg.On("Update", suite.ctx, mock.Anything).Return(nil)
+	suite.repoReg.On("Complete", suite.ctx, mock.Anything).Return(nil)
+
+	suite.repoAuth.On("DeleteUser", suite.ctx, left.AccountID).Return(status.Error(codes.NotFound, "not found"))
+	suite.repoAuth.On("FindByEmail", suite.ctx, pld.Data.Email).Return(&shared.GetUserResponse{}, nil)
+	suite.repoAuth.On("Register", suite.ctx, mock.Anything).Return(register, nil)
+
+	suite.repoUser.On("Save", suite.ctx, mock.Anything).Return(&pb.UserResponse{Id: register.ID}, nil)
+
+	_, err := suite.svc.Save(suite.ctx, pld)
+	suite.NoError(err)
+	suite.Equal(user.RegistrationCompensated, left.Status)
+
+	reg := suite.lastRegistration("Complete")
+	suite.Equal(user.RegistrationCompleted, reg.Status)
+	suite.Equal(register.ID, reg.AccountID)
+}
+
+func (suite *CreateUserSuite) TestCreateUser_DoesNotTakeOverLeasedRegistration() {
+	pld := suite.payload()
+
+	for _, o := range []string{owner, "0c4e2b7a-6d1f-4a3b-8e5c-9f7d2a1b3c4d"} {
+		suite.SetupTest()
+
+		pending := user.NewRegistration(pld.Data.Email, o)
+		pending.LeaseUntil = time.Now().Add(time.Minute)
+
+		suite.uniqueUser(pld)
+		suite.repoReg.On("Create", suite.ctx, mock.Anything).Return(false, nil)
+		suite.repoReg.On("Find", suite.ctx, pending.Key).Return(pending, nil)
+
+		_, err := suite.svc.Save(suite.ctx, pld)
+		suite.ErrorIs(err, shared.ErrRegistrationInProgress)
+		suite.repoAuth.AssertNotCalled(suite.T(), "FindByEmail", mock.Anything, mock.Anything)
+		suite.repoReg.AssertNotCalled(suite.T(), "Replace", mock.Anything, mock.Anything, mock.Anything)
+	}
+}
+
+func (suite *CreateUserSuite) TestCreateUser_StartsAgainWhenAnotherRequestAbandonedIt() {
+	pld := suite.payload()
+	accountID := "46c77402-ba50-4b48-9bd9-1c4f97e36565"
+
+	abandoned := user.NewRegistration(pld.Data.Email, "0c4e2b7a-6d1f-4a3b-8e5c-9f7d2a1b3c4d")
+	abandoned.LeaseUntil = time.Now().Add(-time.Second)
+
+	suite.uniqueUser(pld)
+	suite.repoReg.On("Create", suite.ctx, mock.Anything).Return(false, nil)
+	suite.repoReg.On("Find", suite.ctx, abandoned.Key).Return(abandoned, nil)
+	suite.repoReg.On("Replace", suite.ctx, abandoned, mock.MatchedBy(func(r *user.Registration) bool {
+		return r.Owner == owner
+	})).Return(true, nil)
+	suite.repoReg.On("Complete", suite.ctx, mock.Anything).Return(nil)
+
+	suite.repoAuth.On("FindByEmail", suite.ctx, pld.Data.Email).Return(&shared.GetUserResponse{ID: accountID}, nil)
+
+	_, err := suite.svc.Save(suite.ctx, pld)
+	suite.ErrorIs(err, shared.ErrAccountWithoutProfile)
+	suite.repoUser.AssertNotCalled(suite.T(), "Save", mock.Anything, mock.Anything)
+	suite.repoAuth.AssertNotCalled(suite.T(), "DeleteUser", mock.Anything, mock.Anything)
+}
+
+func (suite *CreateUserSuite) TestCreateUser_ReportsInProgressWhenTakenOverMeanwhile() {
+	pld := suite.payload()
+
+	pending := user.NewRegistration(pld.Data.Email, owner)
+	pending.LeaseUntil = time.Now().Add(-time.Second)
+
+	suite.uniqueUser(pld)
+	suite.repoReg.On("Create", suite.ctx, mock.Anything).Return(false, nil)
+	suite.repoReg.On("Find", suite.ctx, pending.Key).Return(pending, nil)
+	suite.repoReg.On("Replace", suite.ctx, pending, mock.Anything).Return(false, nil)
+
+	_, err := suite.svc.Save(suite.ctx, pld)
+	suite.ErrorIs(err, shared.ErrRegistrationInProgress)
+	suite.repoAuth.AssertNotCalled(suite.T(), "FindByEmail", mock.Anything, mock.Anything)
+}
+
+func (suite *CreateUserSuite) TestCreateUser_DoesNotCompensateWhenProfileExists() {
+	pld := suite.payload()
+	register := &shared.RegisterUserResponse{ID: "46c77402-ba50-4b48-9bd9-1c4f97e36565"}
+	errSave := status.Error(codes.AlreadyExists, "duplicate")
+
+	suite.repoUser.On("FindByEmail", suite.ctx, &pb.UserByEmailRequest{Email: pld.Data.Email}).
+		Return(&pb.UserResponse{}, nil).Once()
+	suite.repoUser.On("FindByCpf", suite.ctx, &pb.UserByCpfRequest{Cpf: pld.Data.CPF}).
+		Return(&pb.UserResponse{}, nil)
+	suite.repoUser.On("FindByEmail", suite.ctx, &pb.UserByEmailRequest{Email: pld.Data.Email}).
+		Return(&pb.UserResponse{Id: "0f8fad5b-d9cb-469f-a165-70867728950e"}, nil)
+	suite.registration()
+
+	suite.repoAuth.On("FindByEmail", suite.ctx, pld.Data.Email).Return(&shared.GetUserResponse{}, nil)
+	suite.repoAuth.On("Register", suite.ctx, mock.Anything).Return(register, nil)
+
+	suite.repoUser.On("Save", suite.ctx, mock.Anything).Return(nil, errSave)
+
+	_, err := suite.svc.Save(suite.ctx, pld)
+	suite.ErrorIs(err, errSave)
+	suite.repoAuth.AssertNotCalled(suite.T(), "DeleteUser", mock.Anything, mock.Anything)
+	suite.repoReg.AssertNotCalled(suite.T(), "Enqueue", mock.Anything, mock.Anything)
+	suite.Equal(user.RegistrationFailed, suite.lastRegistration("Complete").Status)
+}
+
+func (suite *CreateUserSuite) TestCreateUser_CompletesWhenProfileWasSavedBefore() {
+	pld := suite.payload()
+	register := &shared.RegisterUserResponse{ID: "46c77402-ba50-4b48-9bd9-1c4f97e36565"}
+
+	suite.repoUser.On("FindByEmail", suite.ctx, &pb.UserByEmailRequest{Email: pld.Data.Email}).
+		Return(&pb.UserResponse{}, nil).Once()
+	suite.repoUser.On("FindByCpf", suite.ctx, &pb.UserByCpfRequest{Cpf: pld.Data.CPF}).
+		Return(&pb.UserResponse{}, nil)
+	suite.repoUser.On("FindByEmail", suite.ctx, &pb.UserByEmailRequest{Email: pld.Data.Email}).
+		Return(&pb.UserResponse{Id: register.ID}, nil)
+	suite.registration()
+
+	suite.repoAuth.On("FindByEmail", suite.ctx, pld.Data.Email).Return(&shared.GetUserResponse{}, nil)
+	suite.repoAuth.On("Register", suite.ctx, mock.Anything).Return(register, nil)
+
+	suite.repoUser.On("Save", suite.ctx, mock.Anything).Return(nil, status.Error(codes.AlreadyExists, "duplicate"))
+
+	resp, err := suite.svc.Save(suite.ctx, pld)
+	suite.NoError(err)
+	suite.Equal(register.ID, resp.GetId())
+	suite.Equal(user.RegistrationCompleted, suite.lastRegistration("Complete").Status)
+}
+
+func (suite *CreateUserSuite) payload() *user.Payload {
+	return &user.Payload{
+		Data: user.Data{
+			Name:      "maria",
+			Email:     "maria@gmail.com",
+			CPF:       "857.484.630-91",
+			Password:  "12345678@*",
+			Authority: "USER",
+		},
+		EventDate: time.Now().Format(time.RFC3339),
+	}
+}
+
+func (suite *CreateUserSuite) uniqueUser(pld *user.Payload) {
+	suite.repoUser.On("FindByEmail", suite.ctx, &pb.UserByEmailRequest{Email: pld.Data.Email}).
+		Return(&pb.UserResponse{}, nil)
+	suite.repoUser.On("FindByCpf", suite.ctx, &pb.UserByCpfRequest{Cpf: pld.Data.CPF}).
+		Return(&pb.UserResponse{}, nil)
+}
+
+// registration starts a new registration and accepts its updates.
+func (suite *CreateUserSuite) registration() {
+	suite.repoReg.On("Create", suite.ctx, mock.Anything).Return(true, nil)
+	suite.repoReg.On("Update", suite.ctx, mock.Anything).Return(nil)
+	suite.repoReg.On("Complete", suite.ctx, mock.Anything).Return(nil)
+}
+
+// lastRegistration returns the registration passed to the last call to method.
+func (suite *CreateUserSuite) lastRegistration(method string) *user.Registration {
+	var reg *user.Registration
+	for _, call := range suite.repoReg.Calls {
+		if call.Method == method {
+			reg = call.Arguments.Get(1).(*user.Registration)
+		}
+	}
+	suite.Require().NotNil(reg, "%s not called", method)
+	return reg
+}
+
 func TestCreateUserSuite(t *testing.T) {
 	suite.Run(t, new(CreateUserSuite))
 }
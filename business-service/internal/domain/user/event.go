@@ -0,0 +1,60 @@
+package user
+
+import (
+	"fmt"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared/ciphers"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared/codec"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// DecodePayload reads a user event published by router-service. Events
+// sealed with a key ID are protobuf encoded; older events are gob encoded
+// and encrypted with legacyKey, and are still accepted while they drain.
+func DecodePayload(keys map[string]string, legacyKey string, body []byte) (*Payload, error) {
+	if !ciphers.IsSealed(body) {
+		return decodeLegacyPayload(legacyKey, body)
+	}
+
+	dec, err := ciphers.Open(keys, body)
+	if err != nil {
+		return nil, fmt.Errorf("err Decrypt: %w", err)
+	}
+
+	var event pb.UserEvent
+	if err := proto.Unmarshal(dec, &event); err != nil {
+		return nil, fmt.Errorf("err Decode: %w", err)
+	}
+
+	return NewPayloadFromEvent(&event), nil
+}
+
+func NewPayloadFromEvent(event *pb.UserEvent) *Payload {
+	data := event.GetData()
+	return &Payload{
+		Data: Data{
+			Name:       data.GetName(),
+			Email:      data.GetEmail(),
+			CPF:        data.GetCpf(),
+			Attributes: data.GetAttributes(),
+			Password:   data.GetPassword(),
+			Authority:  data.GetAuthority(),
+		},
+		EventDate: event.GetEventDate(),
+	}
+}
+
+func decodeLegacyPayload(legacyKey string, body []byte) (*Payload, error) {
+	dec, err := ciphers.Decrypt(ciphers.ExtractKey([]byte(legacyKey)), body)
+	if err != nil {
+		return nil, fmt.Errorf("err Decrypt: %w", err)
+	}
+
+	var pld Payload
+	if err := codec.New[Payload]().Decode(dec, &pld); err != nil {
+		return nil, fmt.Errorf("err Decode: %w", err)
+	}
+
+	return &pld, nil
+}
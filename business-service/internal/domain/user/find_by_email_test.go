@@ -31,7 +31,7 @@ func (suite *FindByEmailSuite) SetupTest() {
 
 	suite.repoAuth = repoAuth
 	suite.repoUser = repoUser
-	suite.svc = user.NewService(repoUser, repoAuth, val)
+	suite.svc = user.NewService(repoUser, repoAuth, new(mocks.RegistrationRepository_internal_domain_user), val)
 	suite.ctx = context.Background()
 }
 
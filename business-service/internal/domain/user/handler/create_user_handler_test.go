@@ -21,6 +21,7 @@ import (
 	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
 )
 
 type CreateUserHandlerSuite struct {
@@ -30,6 +31,7 @@ type CreateUserHandlerSuite struct {
 	handler  *handler.Handler
 	repoUser *mocks.UserRepository_internal_domain_user
 	repoAuth *mocks.AuthRepository_internal_shared
+	repoReg  *mocks.RegistrationRepository_internal_domain_user
 	valErrs  noProviderVal.ValidationErrors
 }
 
@@ -58,10 +60,16 @@ func (suite *CreateUserHandlerSuite) SetupTest() {
 	val := validator.NewValidation()
 	repoAuth := new(mocks.AuthRepository_internal_shared)
 	repoUser := new(mocks.UserRepository_internal_domain_user)
+	repoReg := new(mocks.RegistrationRepository_internal_domain_user)
+
+	repoReg.On("Create", mock.Anything, mock.Anything).Return(true, nil)
+	repoReg.On("Update", mock.Anything, mock.Anything).Return(nil)
+	repoReg.On("Complete", mock.Anything, mock.Anything).Return(nil)
 
 	suite.repoAuth = repoAuth
 	suite.repoUser = repoUser
-	svc := user.NewService(repoUser, repoAuth, val)
+	suite.repoReg = repoReg
+	svc := user.NewService(repoUser, repoAuth, repoReg, val)
 	suite.handler = handler.NewHandler(svc, &suite.cfg)
 }
 
@@ -86,7 +94,7 @@ func (suite *CreateUserHandlerSuite) TestCreateUser_ValidateFailure() {
 	suite.ErrorAs(err, &suite.valErrs)
 }
 
-func (suite *CreateUserHandlerSuite) TestCreateUser_AuthAlreadyExist() {
+func (suite *CreateUserHandlerSuite) TestCreateUser_AccountWithoutProfile() {
 	payload := &user.Payload{
 		Data: user.Data{
 			Name:       "maria",
@@ -99,12 +107,6 @@ func (suite *CreateUserHandlerSuite) TestCreateUser_AuthAlreadyExist() {
 		EventDate: time.Now().Format(time.RFC3339),
 	}
 
-	userResp := &pb.UserResponse{
-		Id:         "46c77402-ba50-4b48-9bd9-1c4f97e36565",
-		Name:       payload.Data.Name,
-		Email:      payload.Data.Email,
-		Attributes: payload.Data.Attributes,
-	}
 	userByCpfRequest := &pb.UserByCpfRequest{
 		Cpf: payload.Data.CPF,
 	}
@@ -128,10 +130,11 @@ func (suite *CreateUserHandlerSuite) TestCreateUser_AuthAlreadyExist() {
 
 	suite.repoAuth.On("FindByEmail", suite.ctx, payload.Data.Email).Return(getUserResp, nil)
 
-	suite.repoUser.On("Save", suite.ctx, mock.Anything).Return(userResp, nil)
-
 	err = suite.handler.CreateUser(suite.ctx, pld)
-	suite.Nil(err)
+	suite.ErrorIs(err, shared.ErrAccountWithoutProfile)
+	suite.repoUser.AssertNotCalled(suite.T(), "Save", mock.Anything, mock.Anything)
+	suite.repoReg.AssertCalled(suite.T(), "Complete", mock.Anything,
+		mock.MatchedBy(func(r *user.Registration) bool { return r.Status == user.RegistrationFailed }))
 }
 
 func (suite *CreateUserHandlerSuite) TestCreateUser() {
@@ -181,7 +184,54 @@ func (suite *CreateUserHandlerSuite) TestCreateUser() {
 	suite.NoError(err)
 }
 
+func (suite *CreateUserHandlerSuite) TestCreateUser_LegacyPayload() {
+	pld := &user.Payload{
+		Data: user.Data{Name: "maria", Email: "maria@gmail.com"},
+	}
+
+	enc, err := suite.encodeLegacy(pld)
+	suite.NoError(err)
+
+	err = suite.handler.CreateUser(suite.ctx, enc)
+	suite.Error(err)
+	suite.ErrorAs(err, &suite.valErrs)
+}
+
+func (suite *CreateUserHandlerSuite) TestCreateUser_UnknownKeyID() {
+	event := &pb.UserEvent{Data: &pb.UserEventData{Name: "maria"}}
+	enc, err := proto.Marshal(event)
+	suite.NoError(err)
+
+	sealed, err := ciphers.Seal("v9", []byte("retired-key"), enc)
+	suite.NoError(err)
+
+	err = suite.handler.CreateUser(suite.ctx, sealed)
+	suite.ErrorIs(err, shared.ErrUnprocessableMessage)
+	suite.ErrorIs(err, shared.ErrUnknownKeyID)
+}
+
 func (suite *CreateUserHandlerSuite) encode(pld *user.Payload) ([]byte, error) {
+	event := &pb.UserEvent{
+		Data: &pb.UserEventData{
+			Name:       pld.Data.Name,
+			Email:      pld.Data.Email,
+			Cpf:        pld.Data.CPF,
+			Password:   pld.Data.Password,
+			Authority:  pld.Data.Authority,
+			Attributes: pld.Data.Attributes,
+		},
+		EventDate: pld.EventDate,
+	}
+
+	enc, err := proto.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return ciphers.Seal(suite.cfg.AesKeyID, []byte(suite.cfg.AesKey), enc)
+}
+
+func (suite *CreateUserHandlerSuite) encodeLegacy(pld *user.Payload) ([]byte, error) {
 	codec := codec.New[user.Payload]()
 
 	enc, err := codec.Encode(*pld)
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"context"
+
 	"github.com/lucasd-coder/fast-feet/business-service/config"
 	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/user"
 )
@@ -16,3 +18,9 @@ func NewHandler(s user.Service, cfg *config.Config) *Handler {
 		cfg:     cfg,
 	}
 }
+
+// Compensate is the function the registration worker calls for each failed
+// registration.
+func (h *Handler) Compensate(ctx context.Context, r *user.Registration) error {
+	return h.service.Compensate(ctx, r)
+}
@@ -34,3 +34,82 @@ func (h *UserHandler) FindByEmail(ctx context.Context, req *pb.UserByEmailReques
 
 	return resp, nil
 }
+
+func (h *UserHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UserResponse, error) {
+	slog.With("id", req.GetId(), "updateMask", req.GetUpdateMask().GetPaths()).
+		Info("received request")
+
+	pld := user.UpdateUserRequest{
+		ID:         req.GetId(),
+		Name:       req.GetName(),
+		Email:      req.GetEmail(),
+		CPF:        req.GetCpf(),
+		Attributes: req.GetAttributes(),
+		UpdateMask: req.GetUpdateMask().GetPaths(),
+	}
+
+	resp, err := h.service.Update(ctx, &pld)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (h *UserHandler) ListUsers(ctx context.Context, req *pb.UserListRequest) (*pb.UserListResponse, error) {
+	slog.With("cursor", req.GetCursor(), "limit", req.GetLimit()).
+		Info("received request")
+
+	pld := user.ListUsersRequest{
+		Cursor:      req.GetCursor(),
+		Limit:       req.GetLimit(),
+		NamePrefix:  req.GetNamePrefix(),
+		CreatedFrom: req.GetCreatedFrom(),
+		CreatedTo:   req.GetCreatedTo(),
+		Attributes:  req.GetAttributes(),
+		Role:        req.GetRole(),
+		Status:      req.GetStatus(),
+	}
+
+	resp, err := h.service.List(ctx, &pld)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (h *UserHandler) SaveAttributeSchema(ctx context.Context, req *pb.AttributeSchemaRequest) (*pb.AttributeSchemaResponse, error) {
+	slog.With("fields", len(req.GetFields())).
+		Info("received request")
+
+	return h.service.SaveAttributeSchema(ctx, req)
+}
+
+func (h *UserHandler) GetAttributeSchema(ctx context.Context, _ *pb.GetAttributeSchemaRequest) (*pb.AttributeSchemaResponse, error) {
+	slog.Info("received request")
+
+	return h.service.GetAttributeSchema(ctx)
+}
+
+func (h *UserHandler) DisableUser(ctx context.Context, req *pb.UserStatusRequest) (*pb.UserStatusResponse, error) {
+	slog.With("id", req.GetId()).
+		Info("received request")
+
+	pld := user.UserStatusRequest{
+		ID: req.GetId(),
+	}
+
+	return h.service.Disable(ctx, &pld)
+}
+
+func (h *UserHandler) EnableUser(ctx context.Context, req *pb.UserStatusRequest) (*pb.UserStatusResponse, error) {
+	slog.With("id", req.GetId()).
+		Info("received request")
+
+	pld := user.UserStatusRequest{
+		ID: req.GetId(),
+	}
+
+	return h.service.Enable(ctx, &pld)
+}
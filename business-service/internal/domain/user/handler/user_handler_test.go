@@ -24,6 +24,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 type UserHandlerSuite struct {
@@ -74,7 +75,7 @@ func (suite *UserHandlerSuite) SetupTest() {
 
 	suite.repoAuth = repoAuth
 	suite.repoUser = repoUser
-	svc := user.NewService(repoUser, repoAuth, val)
+	svc := user.NewService(repoUser, repoAuth, new(mocks.RegistrationRepository_internal_domain_user), val)
 	hdler := handler.NewHandler(svc, &suite.cfg)
 	suite.userHandler = handler.NewUserHandler(*hdler)
 
@@ -155,6 +156,40 @@ func (suite *UserHandlerSuite) TestFindByEmail_UserNotFound() {
 	suite.Equal(st.Code(), codes.NotFound)
 }
 
+func (suite *UserHandlerSuite) TestUpdateUser() {
+	client := pb.NewUserHandlerClient(suite.conn)
+
+	in := &pb.UpdateUserRequest{
+		Id:         "1c42d3bf-6f10-40b6-94d6-e412c6287e5a",
+		Name:       "maria souza",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	}
+
+	user := &pb.UserResponse{
+		Id:    "1c42d3bf-6f10-40b6-94d6-e412c6287e5a",
+		Name:  "maria souza",
+		Email: "maria12@gmail.com",
+	}
+
+	suite.repoUser.On("UpdateUser", mock.Anything, mock.Anything).Return(user, nil)
+
+	resp, err := client.UpdateUser(suite.ctx, in)
+	suite.NoError(err)
+	suite.Equal(resp.GetName(), user.GetName())
+}
+
+func (suite *UserHandlerSuite) TestUpdateUser_ValidateFailure() {
+	client := pb.NewUserHandlerClient(suite.conn)
+
+	in := &pb.UpdateUserRequest{
+		Id: "1c42d3bf-6f10-40b6-94d6-e412c6287e5a",
+	}
+
+	_, err := client.UpdateUser(suite.ctx, in)
+	suite.Error(err)
+	suite.Equal(status.Code(err), codes.InvalidArgument)
+}
+
 func TestUserHandlerSuite(t *testing.T) {
 	suite.Run(t, new(UserHandlerSuite))
 }
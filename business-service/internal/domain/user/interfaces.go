@@ -11,10 +11,38 @@ type (
 		Save(ctx context.Context, req *pb.UserRequest) (*pb.UserResponse, error)
 		FindByEmail(ctx context.Context, req *pb.UserByEmailRequest) (*pb.UserResponse, error)
 		FindByCpf(ctx context.Context, req *pb.UserByCpfRequest) (*pb.UserResponse, error)
+		UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UserResponse, error)
+		ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error)
+		SaveAttributeSchema(ctx context.Context, req *pb.AttributeSchemaRequest) (*pb.AttributeSchemaResponse, error)
+		GetAttributeSchema(ctx context.Context, req *pb.GetAttributeSchemaRequest) (*pb.AttributeSchemaResponse, error)
+	}
+
+	RegistrationRepository interface {
+		// Create stores a new registration leased to its owner, it returns
+		// false when one with the same key already exists.
+		Create(ctx context.Context, r *Registration) (bool, error)
+		// Replace stores r leased to its owner in place of prev, read before,
+		// or where none exists when prev is nil. It returns false when the
+		// registration was changed in between.
+		Replace(ctx context.Context, prev, r *Registration) (bool, error)
+		Find(ctx context.Context, key string) (*Registration, error)
+		// Enqueue schedules the compensation of the registration now.
+		Enqueue(ctx context.Context, key string) error
+		Update(ctx context.Context, r *Registration) error
+		// Complete saves a finished registration, it is kept for the
+		// retention period and removed from the compensation queue.
+		Complete(ctx context.Context, r *Registration) error
 	}
 
 	Service interface {
 		Save(ctx context.Context, pld *Payload) (*pb.UserResponse, error)
 		FindByEmail(ctx context.Context, pld *FindByEmailRequest) (*pb.UserResponse, error)
+		Update(ctx context.Context, pld *UpdateUserRequest) (*pb.UserResponse, error)
+		List(ctx context.Context, pld *ListUsersRequest) (*pb.UserListResponse, error)
+		SaveAttributeSchema(ctx context.Context, req *pb.AttributeSchemaRequest) (*pb.AttributeSchemaResponse, error)
+		GetAttributeSchema(ctx context.Context) (*pb.AttributeSchemaResponse, error)
+		Disable(ctx context.Context, pld *UserStatusRequest) (*pb.UserStatusResponse, error)
+		Enable(ctx context.Context, pld *UserStatusRequest) (*pb.UserStatusResponse, error)
+		Compensate(ctx context.Context, r *Registration) error
 	}
 )
@@ -0,0 +1,104 @@
+package user
+
+import (
+	"context"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+)
+
+const (
+	defaultListLimit int64 = 20
+	// maxListFetches bounds the pages read from user-manager-service when
+	// the Role or Status filters leave a page short.
+	maxListFetches = 5
+)
+
+// List pages through the users of user-manager-service and adds the roles
+// and enabled state with one call to auth-service per page read.
+func (s *ServiceImpl) List(ctx context.Context, pld *ListUsersRequest) (*pb.UserListResponse, error) {
+	if err := pld.Validate(s.validate); err != nil {
+		return nil, shared.ValidationErrors(err)
+	}
+	log := logger.FromContext(ctx)
+
+	limit := pld.GetLimit()
+	resp := &pb.UserListResponse{}
+	cursor := pld.Cursor
+
+	// each page asks only for the users still missing, so the cursor of the
+	// last page read never skips a user that matched.
+	for fetches := 0; fetches < maxListFetches; fetches++ {
+		page, err := s.userRepository.ListUsers(ctx, pld.ToListUsersRequest(cursor, limit-int64(len(resp.Users))))
+		if err != nil {
+			return nil, err
+		}
+
+		accounts, err := s.findAccounts(ctx, page.GetUsers())
+		if err != nil {
+			log.Errorf("err while call auth-service GetAccounts: %v", err)
+			return nil, err
+		}
+
+		for _, user := range page.GetUsers() {
+			item := newUserListItem(user, accounts[user.GetId()])
+			if pld.Match(item) {
+				resp.Users = append(resp.Users, item)
+			}
+		}
+
+		cursor = page.GetNextCursor()
+		if cursor == "" || int64(len(resp.Users)) >= limit || !pld.HasAccountFilter() {
+			break
+		}
+	}
+
+	resp.NextCursor = cursor
+
+	return resp, nil
+}
+
+func (s *ServiceImpl) findAccounts(ctx context.Context,
+	users []*pb.UserResponse) (map[string]*shared.GetAccountResponse, error) {
+	result := make(map[string]*shared.GetAccountResponse, len(users))
+	if len(users) == 0 {
+		return result, nil
+	}
+
+	ids := make([]string, 0, len(users))
+	for _, user := range users {
+		ids = append(ids, user.GetId())
+	}
+
+	accounts, err := s.authRepository.GetAccounts(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, account := range accounts {
+		result[account.ID] = account
+	}
+
+	return result, nil
+}
+
+// newUserListItem leaves roles empty and enabled false for a user without
+// an account.
+func newUserListItem(user *pb.UserResponse, account *shared.GetAccountResponse) *pb.UserListItem {
+	item := &pb.UserListItem{
+		Id:         user.GetId(),
+		Name:       user.GetName(),
+		Email:      user.GetEmail(),
+		Attributes: user.GetAttributes(),
+		CreatedAt:  user.GetCreatedAt(),
+		UpdatedAt:  user.GetUpdatedAt(),
+	}
+
+	if account != nil {
+		item.Roles = account.Roles
+		item.Enabled = account.Enabled
+	}
+
+	return item
+}
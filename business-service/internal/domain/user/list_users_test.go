@@ -0,0 +1,128 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/user"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/mocks"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/validator"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	adminID = "1c8d463a-8247-4ac5-aef5-012dffd52fc3"
+	userID  = "7d0f8b8e-5b8a-4f61-9f57-3a1d9c2e6b10"
+	otherID = "433c311b-93a5-45c3-99c9-b52f3c4aef4f"
+)
+
+type ListUsersSuite struct {
+	suite.Suite
+	ctx      context.Context
+	repoAuth *mocks.AuthRepository_internal_shared
+	repoUser *mocks.Repository_internal_domain_user
+	svc      user.Service
+}
+
+func (suite *ListUsersSuite) SetupTest() {
+	val := validator.NewValidation()
+	repoAuth := new(mocks.AuthRepository_internal_shared)
+	repoUser := new(mocks.Repository_internal_domain_user)
+
+	suite.repoAuth = repoAuth
+	suite.repoUser = repoUser
+	suite.svc = user.NewService(repoUser, repoAuth, new(mocks.RegistrationRepository_internal_domain_user), val)
+	suite.ctx = context.Background()
+}
+
+func (suite *ListUsersSuite) TestListUsersValidateFailure() {
+	_, err := suite.svc.List(suite.ctx, &user.ListUsersRequest{Status: "blocked"})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	suite.repoUser.AssertNotCalled(suite.T(), "ListUsers", mock.Anything, mock.Anything)
+}
+
+func (suite *ListUsersSuite) TestListUsersEnrichesInOneCall() {
+	suite.repoUser.On("ListUsers", suite.ctx, &pb.ListUsersRequest{Limit: 20, NamePrefix: "mar"}).
+		Return(&pb.ListUsersResponse{
+			Users:      []*pb.UserResponse{{Id: adminID}, {Id: userID}, {Id: otherID}},
+			NextCursor: "65f1c2a4b3d2e1f0a9b8c7d6",
+		}, nil)
+	suite.repoAuth.On("GetAccounts", suite.ctx, []string{adminID, userID, otherID}).
+		Return([]*shared.GetAccountResponse{
+			{ID: adminID, Enabled: true, Roles: []string{"ADMIN"}},
+			{ID: userID, Enabled: true, Roles: []string{"USER"}},
+		}, nil)
+
+	resp, err := suite.svc.List(suite.ctx, &user.ListUsersRequest{NamePrefix: "mar"})
+	suite.NoError(err)
+	suite.Len(resp.GetUsers(), 3)
+	suite.Equal([]string{"ADMIN"}, resp.GetUsers()[0].GetRoles())
+	suite.True(resp.GetUsers()[1].GetEnabled())
+	suite.False(resp.GetUsers()[2].GetEnabled())
+	suite.Empty(resp.GetUsers()[2].GetRoles())
+	suite.Equal("65f1c2a4b3d2e1f0a9b8c7d6", resp.GetNextCursor())
+	suite.repoAuth.AssertNumberOfCalls(suite.T(), "GetAccounts", 1)
+}
+
+func (suite *ListUsersSuite) TestListUsersFillsPageWithAccountFilter() {
+	suite.repoUser.On("ListUsers", suite.ctx, &pb.ListUsersRequest{Limit: 2}).
+		Return(&pb.ListUsersResponse{
+			Users:      []*pb.UserResponse{{Id: adminID}, {Id: userID}},
+			NextCursor: "65f1c2a4b3d2e1f0a9b8c7d6",
+		}, nil)
+	suite.repoUser.On("ListUsers", suite.ctx, &pb.ListUsersRequest{Cursor: "65f1c2a4b3d2e1f0a9b8c7d6", Limit: 1}).
+		Return(&pb.ListUsersResponse{
+			Users: []*pb.UserResponse{{Id: otherID}},
+		}, nil)
+	suite.repoAuth.On("GetAccounts", suite.ctx, []string{adminID, userID}).
+		Return([]*shared.GetAccountResponse{
+			{ID: adminID, Enabled: true, Roles: []string{"ADMIN"}},
+			{ID: userID, Enabled: false, Roles: []string{"USER"}},
+		}, nil)
+	suite.repoAuth.On("GetAccounts", suite.ctx, []string{otherID}).
+		Return([]*shared.GetAccountResponse{
+			{ID: otherID, Enabled: true, Roles: []string{"USER"}},
+		}, nil)
+
+	resp, err := suite.svc.List(suite.ctx, &user.ListUsersRequest{Limit: 2, Status: user.StatusEnabled})
+	suite.NoError(err)
+	suite.Len(resp.GetUsers(), 2)
+	suite.Equal(adminID, resp.GetUsers()[0].GetId())
+	suite.Equal(otherID, resp.GetUsers()[1].GetId())
+	suite.Empty(resp.GetNextCursor())
+}
+
+func (suite *ListUsersSuite) TestListUsersRoleFilter() {
+	suite.repoUser.On("ListUsers", suite.ctx, mock.Anything).
+		Return(&pb.ListUsersResponse{
+			Users: []*pb.UserResponse{{Id: adminID}, {Id: userID}},
+		}, nil)
+	suite.repoAuth.On("GetAccounts", suite.ctx, mock.Anything).
+		Return([]*shared.GetAccountResponse{
+			{ID: adminID, Enabled: true, Roles: []string{"admin"}},
+			{ID: userID, Enabled: true, Roles: []string{"USER"}},
+		}, nil)
+
+	resp, err := suite.svc.List(suite.ctx, &user.ListUsersRequest{Role: shared.ADMIN})
+	suite.NoError(err)
+	suite.Len(resp.GetUsers(), 1)
+	suite.Equal(adminID, resp.GetUsers()[0].GetId())
+}
+
+func (suite *ListUsersSuite) TestListUsersEmptyPage() {
+	suite.repoUser.On("ListUsers", suite.ctx, mock.Anything).Return(&pb.ListUsersResponse{}, nil)
+
+	resp, err := suite.svc.List(suite.ctx, &user.ListUsersRequest{})
+	suite.NoError(err)
+	suite.Empty(resp.GetUsers())
+	suite.repoAuth.AssertNotCalled(suite.T(), "GetAccounts", mock.Anything, mock.Anything)
+}
+
+func TestListUsersSuite(t *testing.T) {
+	suite.Run(t, new(ListUsersSuite))
+}
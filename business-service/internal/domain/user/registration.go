@@ -0,0 +1,109 @@
+package user
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucasd-coder/fast-feet/pkg/envelope"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	RegistrationPending      = "PENDING"
+	RegistrationCompleted    = "COMPLETED"
+	RegistrationCompensating = "COMPENSATING"
+	RegistrationCompensated  = "COMPENSATED"
+	RegistrationFailed       = "FAILED"
+)
+
+// The steps of a registration, they run in this order. When a step after
+// StepRegisterAccount fails the account is deleted to undo the registration.
+const (
+	StepRegisterAccount = "register-account"
+	StepSaveProfile     = "save-profile"
+)
+
+var RegistrationSteps = []string{StepRegisterAccount, StepSaveProfile}
+
+// Registration is the saga state of a user registration, keyed by a hash of
+// the email so no personal data is kept in it. AccountID is the auth-service
+// account created by the saga and Reason why it was compensated. Owner is the
+// request running it, it is leased to it until LeaseUntil.
+type Registration struct {
+	Key         string               `json:"key"`
+	AccountID   string               `json:"accountId,omitempty"`
+	Status      string               `json:"status"`
+	Steps       map[string]time.Time `json:"steps"`
+	StartedAt   time.Time            `json:"startedAt"`
+	CompletedAt time.Time            `json:"completedAt,omitempty"`
+	Attempts    int                  `json:"attempts"`
+	Reason      string               `json:"reason,omitempty"`
+	LastError   string               `json:"lastError,omitempty"`
+	Owner       string               `json:"owner,omitempty"`
+	LeaseUntil  time.Time            `json:"leaseUntil,omitempty"`
+}
+
+func NewRegistration(email, owner string) *Registration {
+	return &Registration{
+		Key:       RegistrationKey(email),
+		Status:    RegistrationPending,
+		Steps:     map[string]time.Time{},
+		StartedAt: time.Now().UTC(),
+		Owner:     owner,
+	}
+}
+
+func RegistrationKey(email string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return hex.EncodeToString(sum[:])
+}
+
+func (r *Registration) Done(step string) bool {
+	_, ok := r.Steps[step]
+	return ok
+}
+
+// Leased reports whether the owner of r may still be running it.
+func (r *Registration) Leased(now time.Time) bool {
+	return now.Before(r.LeaseUntil)
+}
+
+// registrationOwner identifies the request of ctx by its message ID, which
+// is kept when the message is retried. Messages without one never resume a
+// registration.
+func registrationOwner(ctx context.Context) string {
+	if id := envelope.FromContext(ctx).ID; id != "" {
+		return id
+	}
+	return uuid.NewString()
+}
+
+// uncertain reports whether err leaves the outcome of the call unknown, the
+// profile may have been saved so the registration is resumed instead of
+// compensated.
+func uncertain(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return true
+	}
+
+	switch status.Code(err) {
+	case codes.DeadlineExceeded, codes.Canceled:
+		return true
+	default:
+		return false
+	}
+}
+
+// ignoreNotFound treats an account already gone as deleted.
+func ignoreNotFound(err error) error {
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	return err
+}
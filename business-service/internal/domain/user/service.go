@@ -11,18 +11,21 @@ var InitializeService = wire.NewSet(
 )
 
 type ServiceImpl struct {
-	userRepository Repository
-	authRepository shared.AuthRepository
-	validate       shared.Validator
+	userRepository         Repository
+	authRepository         shared.AuthRepository
+	registrationRepository RegistrationRepository
+	validate               shared.Validator
 }
 
 func NewService(userRepo Repository,
 	authRepo shared.AuthRepository,
+	registrationRepo RegistrationRepository,
 	val shared.Validator,
 ) *ServiceImpl {
 	return &ServiceImpl{
-		userRepository: userRepo,
-		authRepository: authRepo,
-		validate:       val,
+		userRepository:         userRepo,
+		authRepository:         authRepo,
+		registrationRepository: registrationRepo,
+		validate:               val,
 	}
 }
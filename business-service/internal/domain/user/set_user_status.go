@@ -0,0 +1,53 @@
+package user
+
+import (
+	"context"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+)
+
+// Disable disables the account in auth-service, which revokes its sessions
+// and publishes the event that flags the open orders of a deliveryman.
+func (s *ServiceImpl) Disable(ctx context.Context, pld *UserStatusRequest) (*pb.UserStatusResponse, error) {
+	if err := pld.Validate(s.validate); err != nil {
+		return nil, shared.ValidationErrors(err)
+	}
+	log := logger.FromContext(ctx)
+
+	log.Info("calling authRepository")
+
+	account, err := s.authRepository.DisableUser(ctx, pld.ID)
+	if err != nil {
+		log.Errorf("err while call auth-service DisableUser: %v", err)
+		return nil, err
+	}
+
+	return toUserStatusResponse(account), nil
+}
+
+func (s *ServiceImpl) Enable(ctx context.Context, pld *UserStatusRequest) (*pb.UserStatusResponse, error) {
+	if err := pld.Validate(s.validate); err != nil {
+		return nil, shared.ValidationErrors(err)
+	}
+	log := logger.FromContext(ctx)
+
+	log.Info("calling authRepository")
+
+	account, err := s.authRepository.EnableUser(ctx, pld.ID)
+	if err != nil {
+		log.Errorf("err while call auth-service EnableUser: %v", err)
+		return nil, err
+	}
+
+	return toUserStatusResponse(account), nil
+}
+
+func toUserStatusResponse(account *shared.GetUserResponse) *pb.UserStatusResponse {
+	return &pb.UserStatusResponse{
+		Id:      account.ID,
+		Email:   account.Email,
+		Enabled: account.Enabled,
+	}
+}
@@ -0,0 +1,72 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/user"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/mocks"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/validator"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SetUserStatusSuite struct {
+	suite.Suite
+	ctx      context.Context
+	repoAuth *mocks.AuthRepository_internal_shared
+	svc      user.Service
+	pld      *user.UserStatusRequest
+}
+
+func (suite *SetUserStatusSuite) SetupTest() {
+	val := validator.NewValidation()
+	repoAuth := new(mocks.AuthRepository_internal_shared)
+
+	suite.repoAuth = repoAuth
+	suite.svc = user.NewService(new(mocks.Repository_internal_domain_user), repoAuth,
+		new(mocks.RegistrationRepository_internal_domain_user), val)
+	suite.ctx = context.Background()
+	suite.pld = &user.UserStatusRequest{ID: "1c8d463a-8247-4ac5-aef5-012dffd52fc3"}
+}
+
+func (suite *SetUserStatusSuite) TestDisableUser() {
+	account := &shared.GetUserResponse{ID: suite.pld.ID, Email: "maria@gmail.com"}
+	suite.repoAuth.On("DisableUser", suite.ctx, suite.pld.ID).Return(account, nil)
+
+	resp, err := suite.svc.Disable(suite.ctx, suite.pld)
+	suite.NoError(err)
+	suite.Equal(suite.pld.ID, resp.GetId())
+	suite.Equal("maria@gmail.com", resp.GetEmail())
+	suite.False(resp.GetEnabled())
+}
+
+func (suite *SetUserStatusSuite) TestEnableUser() {
+	account := &shared.GetUserResponse{ID: suite.pld.ID, Email: "maria@gmail.com", Enabled: true}
+	suite.repoAuth.On("EnableUser", suite.ctx, suite.pld.ID).Return(account, nil)
+
+	resp, err := suite.svc.Enable(suite.ctx, suite.pld)
+	suite.NoError(err)
+	suite.True(resp.GetEnabled())
+}
+
+func (suite *SetUserStatusSuite) TestDisableUserValidateFailure() {
+	_, err := suite.svc.Disable(suite.ctx, &user.UserStatusRequest{ID: "1234"})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	suite.repoAuth.AssertNotCalled(suite.T(), "DisableUser", mock.Anything, mock.Anything)
+}
+
+func (suite *SetUserStatusSuite) TestDisableUserNotFound() {
+	suite.repoAuth.On("DisableUser", suite.ctx, suite.pld.ID).
+		Return(nil, status.Error(codes.NotFound, "User not found"))
+
+	_, err := suite.svc.Disable(suite.ctx, suite.pld)
+	suite.Equal(codes.NotFound, status.Code(err))
+}
+
+func TestSetUserStatusSuite(t *testing.T) {
+	suite.Run(t, new(SetUserStatusSuite))
+}
@@ -0,0 +1,34 @@
+package user
+
+import (
+	"context"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+)
+
+func (s *ServiceImpl) Update(ctx context.Context, pld *UpdateUserRequest) (*pb.UserResponse, error) {
+	if err := pld.Validate(s.validate); err != nil {
+		return nil, shared.ValidationErrors(err)
+	}
+	log := logger.FromContext(ctx)
+
+	log.Info("calling userRepository")
+
+	user, err := s.userRepository.UpdateUser(ctx, pld.ToUpdateUserRequest())
+	if err != nil {
+		return nil, err
+	}
+
+	// user-manager-service is the source of truth, so a failed sync is
+	// returned to the caller and repeating the same PATCH re-syncs Keycloak.
+	if pld.HasPath("email") {
+		if _, err := s.authRepository.UpdateEmail(ctx, pld.ID, user.GetEmail()); err != nil {
+			log.Errorf("err while call auth-service UpdateEmail: %v", err)
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
@@ -0,0 +1,120 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/user"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/mocks"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/validator"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UpdateUserSuite struct {
+	suite.Suite
+	ctx      context.Context
+	repoAuth *mocks.AuthRepository_internal_shared
+	repoUser *mocks.Repository_internal_domain_user
+	svc      user.Service
+}
+
+func (suite *UpdateUserSuite) SetupTest() {
+	val := validator.NewValidation()
+	repoAuth := new(mocks.AuthRepository_internal_shared)
+	repoUser := new(mocks.Repository_internal_domain_user)
+
+	suite.repoAuth = repoAuth
+	suite.repoUser = repoUser
+	suite.svc = user.NewService(repoUser, repoAuth, new(mocks.RegistrationRepository_internal_domain_user), val)
+	suite.ctx = context.Background()
+}
+
+func (suite *UpdateUserSuite) TestUpdateUserValidateFailure() {
+	pld := &user.UpdateUserRequest{
+		ID:         "1c8d463a-8247-4ac5-aef5-012dffd52fc3",
+		UpdateMask: []string{"password"},
+	}
+
+	_, err := suite.svc.Update(suite.ctx, pld)
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	suite.repoUser.AssertNotCalled(suite.T(), "UpdateUser", mock.Anything, mock.Anything)
+}
+
+func (suite *UpdateUserSuite) TestUpdateUserName() {
+	pld := &user.UpdateUserRequest{
+		ID:         "1c8d463a-8247-4ac5-aef5-012dffd52fc3",
+		Name:       "maria",
+		UpdateMask: []string{"name"},
+	}
+
+	resp := &pb.UserResponse{Id: pld.ID, Name: pld.Name, Email: "maria@gmail.com"}
+
+	suite.repoUser.On("UpdateUser", suite.ctx, mock.MatchedBy(func(req *pb.UpdateUserRequest) bool {
+		return req.GetId() == pld.ID && req.GetName() == pld.Name &&
+			len(req.GetUpdateMask().GetPaths()) == 1
+	})).Return(resp, nil)
+
+	user, err := suite.svc.Update(suite.ctx, pld)
+	suite.NoError(err)
+	suite.Equal(resp, user)
+	suite.repoAuth.AssertNotCalled(suite.T(), "UpdateEmail", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *UpdateUserSuite) TestUpdateUserEmailSyncsAuthService() {
+	pld := &user.UpdateUserRequest{
+		ID:         "1c8d463a-8247-4ac5-aef5-012dffd52fc3",
+		Email:      "maria@gmail.com",
+		UpdateMask: []string{"email"},
+	}
+
+	resp := &pb.UserResponse{Id: pld.ID, Email: pld.Email}
+
+	suite.repoUser.On("UpdateUser", suite.ctx, mock.Anything).Return(resp, nil)
+	suite.repoAuth.On("UpdateEmail", suite.ctx, pld.ID, pld.Email).
+		Return(&shared.GetUserResponse{ID: pld.ID, Email: pld.Email}, nil)
+
+	user, err := suite.svc.Update(suite.ctx, pld)
+	suite.NoError(err)
+	suite.Equal(resp, user)
+	suite.repoAuth.AssertExpectations(suite.T())
+}
+
+func (suite *UpdateUserSuite) TestUpdateUserEmailAlreadyExists() {
+	pld := &user.UpdateUserRequest{
+		ID:         "1c8d463a-8247-4ac5-aef5-012dffd52fc3",
+		Email:      "maria@gmail.com",
+		UpdateMask: []string{"email"},
+	}
+
+	suite.repoUser.On("UpdateUser", suite.ctx, mock.Anything).
+		Return(nil, status.Error(codes.AlreadyExists, shared.ErrUserAlreadyExist.Error()))
+
+	_, err := suite.svc.Update(suite.ctx, pld)
+	suite.Equal(codes.AlreadyExists, status.Code(err))
+	suite.repoAuth.AssertNotCalled(suite.T(), "UpdateEmail", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *UpdateUserSuite) TestUpdateUserEmailSyncFailure() {
+	pld := &user.UpdateUserRequest{
+		ID:         "1c8d463a-8247-4ac5-aef5-012dffd52fc3",
+		Email:      "maria@gmail.com",
+		UpdateMask: []string{"email"},
+	}
+
+	suite.repoUser.On("UpdateUser", suite.ctx, mock.Anything).
+		Return(&pb.UserResponse{Id: pld.ID, Email: pld.Email}, nil)
+	suite.repoAuth.On("UpdateEmail", suite.ctx, pld.ID, pld.Email).
+		Return(nil, status.Error(codes.Unavailable, "auth-service unavailable"))
+
+	_, err := suite.svc.Update(suite.ctx, pld)
+	suite.Equal(codes.Unavailable, status.Code(err))
+}
+
+func TestUpdateUserSuite(t *testing.T) {
+	suite.Run(t, new(UpdateUserSuite))
+}
@@ -1,7 +1,12 @@
 package user
 
 import (
+	"slices"
+	"strings"
+
 	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 type Payload struct {
@@ -30,6 +35,37 @@ type FindByEmailRequest struct {
 	Email string `json:"email,omitempty" validate:"required,email,pattern"`
 }
 
+type UserStatusRequest struct {
+	ID string `json:"id,omitempty" validate:"required,uuid4"`
+}
+
+type UpdateUserRequest struct {
+	ID         string            `json:"id,omitempty" validate:"required,uuid4"`
+	Name       string            `json:"name,omitempty" validate:"omitempty,pattern"`
+	Email      string            `json:"email,omitempty" validate:"omitempty,email,pattern"`
+	CPF        string            `json:"cpf,omitempty" validate:"omitempty,isCPF"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	UpdateMask []string          `json:"updateMask,omitempty" validate:"required,min=1,dive,oneof=name email cpf attributes"`
+}
+
+const (
+	StatusEnabled  = "enabled"
+	StatusDisabled = "disabled"
+)
+
+// ListUsersRequest filters the users of user-manager-service, Role and
+// Status filter on the account kept by auth-service.
+type ListUsersRequest struct {
+	Cursor      string            `json:"cursor,omitempty" validate:"objectID"`
+	Limit       int64             `json:"limit,omitempty" validate:"gte=0,lte=100"`
+	NamePrefix  string            `json:"namePrefix,omitempty" validate:"pattern"`
+	CreatedFrom string            `json:"createdFrom,omitempty" validate:"rfc3339"`
+	CreatedTo   string            `json:"createdTo,omitempty" validate:"rfc3339"`
+	Attributes  map[string]string `json:"attributes,omitempty"`
+	Role        string            `json:"role,omitempty" validate:"omitempty,oneof=ADMIN USER"`
+	Status      string            `json:"status,omitempty" validate:"omitempty,oneof=enabled disabled"`
+}
+
 func (payload *Payload) Validate(val shared.Validator) error {
 	return val.ValidateStruct(payload)
 }
@@ -38,6 +74,73 @@ func (f *FindByEmailRequest) Validate(val shared.Validator) error {
 	return val.ValidateStruct(f)
 }
 
+func (u *UserStatusRequest) Validate(val shared.Validator) error {
+	return val.ValidateStruct(u)
+}
+
+func (u *UpdateUserRequest) Validate(val shared.Validator) error {
+	return val.ValidateStruct(u)
+}
+
+func (u *UpdateUserRequest) HasPath(path string) bool {
+	return slices.Contains(u.UpdateMask, path)
+}
+
+func (u *UpdateUserRequest) ToUpdateUserRequest() *pb.UpdateUserRequest {
+	return &pb.UpdateUserRequest{
+		Id:         u.ID,
+		Name:       u.Name,
+		Email:      u.Email,
+		Cpf:        u.CPF,
+		Attributes: u.Attributes,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: u.UpdateMask},
+	}
+}
+
+func (l *ListUsersRequest) Validate(val shared.Validator) error {
+	return val.ValidateStruct(l)
+}
+
+func (l *ListUsersRequest) GetLimit() int64 {
+	if l.Limit <= 0 {
+		return defaultListLimit
+	}
+	return l.Limit
+}
+
+func (l *ListUsersRequest) HasAccountFilter() bool {
+	return l.Role != "" || l.Status != ""
+}
+
+func (l *ListUsersRequest) ToListUsersRequest(cursor string, limit int64) *pb.ListUsersRequest {
+	return &pb.ListUsersRequest{
+		Cursor:      cursor,
+		Limit:       limit,
+		NamePrefix:  l.NamePrefix,
+		CreatedFrom: l.CreatedFrom,
+		CreatedTo:   l.CreatedTo,
+		Attributes:  l.Attributes,
+	}
+}
+
+// Match reports whether the user passes the Role and Status filters.
+func (l *ListUsersRequest) Match(item *pb.UserListItem) bool {
+	if l.Role != "" && !slices.ContainsFunc(item.GetRoles(), func(role string) bool {
+		return strings.EqualFold(role, l.Role)
+	}) {
+		return false
+	}
+
+	switch l.Status {
+	case StatusEnabled:
+		return item.GetEnabled()
+	case StatusDisabled:
+		return !item.GetEnabled()
+	default:
+		return true
+	}
+}
+
 func (payload *Payload) ToRegister() *shared.Register {
 	return &shared.Register{
 		Name:      payload.Data.Name,
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/userstatus"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+)
+
+type Handler struct {
+	service userstatus.Service
+	cfg     *config.Config
+}
+
+func NewHandler(s userstatus.Service, cfg *config.Config) *Handler {
+	return &Handler{
+		service: s,
+		cfg:     cfg,
+	}
+}
+
+func (h *Handler) UserStatusChanged(ctx context.Context, m []byte) error {
+	e, err := userstatus.DecodeEvent(m)
+	if err != nil {
+		return fmt.Errorf("%w: err Unmarshal: %w", shared.ErrUnprocessableMessage, err)
+	}
+
+	slog.With("userId", e.UserID, "enabled", e.Enabled).
+		Info("received payload")
+
+	return h.service.UserStatusChanged(ctx, e)
+}
@@ -0,0 +1,17 @@
+package userstatus
+
+import (
+	"context"
+
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+)
+
+type (
+	OrderRepository interface {
+		FlagOrders(ctx context.Context, req *pb.FlagOrdersRequest) (*pb.FlagOrdersResponse, error)
+	}
+
+	Service interface {
+		UserStatusChanged(ctx context.Context, e *Event) error
+	}
+)
@@ -0,0 +1,29 @@
+package userstatus
+
+import (
+	"github.com/google/wire"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+)
+
+var InitializeService = wire.NewSet(
+	wire.Bind(new(Service), new(*ServiceImpl)),
+	NewService,
+)
+
+type ServiceImpl struct {
+	validate        shared.Validator
+	authRepository  shared.AuthRepository
+	orderRepository OrderRepository
+}
+
+func NewService(
+	val shared.Validator,
+	authRepo shared.AuthRepository,
+	orderRepo OrderRepository,
+) *ServiceImpl {
+	return &ServiceImpl{
+		validate:        val,
+		authRepository:  authRepo,
+		orderRepository: orderRepo,
+	}
+}
@@ -0,0 +1,53 @@
+package userstatus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UserStatusChanged flags the open orders of a disabled deliveryman and
+// clears the flag when the account is enabled again. Events can arrive out
+// of order after a retry, so the current status is read from auth-service
+// and the event only triggers the sync.
+func (s *ServiceImpl) UserStatusChanged(ctx context.Context, e *Event) error {
+	if err := e.Validate(s.validate); err != nil {
+		return fmt.Errorf("%w: %w", shared.ErrUnprocessableMessage, shared.ValidationErrors(err))
+	}
+	log := logger.FromContext(ctx)
+
+	active, err := s.authRepository.IsActiveUser(ctx, e.UserID)
+	if status.Code(err) == codes.NotFound {
+		log.Infof("user not found, nothing to flag for id: %s", e.UserID)
+		return nil
+	}
+	if err != nil {
+		log.Errorf("err while call auth-service IsActiveUser: %v", err)
+		return err
+	}
+
+	if active.Active != e.Enabled {
+		log.Infof("status of user id: %s changed since the event, syncing enabled: %t", e.UserID, active.Active)
+	}
+
+	req := &pb.FlagOrdersRequest{
+		DeliverymanId: e.UserID,
+		Reason:        FlagDeliverymanDisabled,
+		Flagged:       !active.Active,
+	}
+
+	resp, err := s.orderRepository.FlagOrders(ctx, req)
+	if err != nil {
+		log.Errorf("err while call order-data-service FlagOrders: %v", err)
+		return err
+	}
+
+	log.Infof("flagged: %t %d orders of deliveryman id: %s", req.Flagged, resp.GetModified(), e.UserID)
+
+	return nil
+}
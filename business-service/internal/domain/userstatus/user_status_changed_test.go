@@ -0,0 +1,103 @@
+package userstatus_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/userstatus"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/mocks"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/validator"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const userID = "9a0e3a5c-3f5b-4f0d-9d5e-2b0c1f6a7e21"
+
+type UserStatusChangedSuite struct {
+	suite.Suite
+	ctx       context.Context
+	repoAuth  *mocks.AuthRepository_internal_shared
+	repoOrder *mocks.OrderRepository_internal_domain_userstatus
+	svc       userstatus.Service
+}
+
+func (suite *UserStatusChangedSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.repoAuth = new(mocks.AuthRepository_internal_shared)
+	suite.repoOrder = new(mocks.OrderRepository_internal_domain_userstatus)
+
+	suite.svc = userstatus.NewService(validator.NewValidation(), suite.repoAuth, suite.repoOrder)
+}
+
+func (suite *UserStatusChangedSuite) event(enabled bool) *userstatus.Event {
+	return &userstatus.Event{
+		UserID:     userID,
+		Enabled:    enabled,
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+func flagOrders(flagged bool) interface{} {
+	return mock.MatchedBy(func(req *pb.FlagOrdersRequest) bool {
+		return req.GetDeliverymanId() == userID &&
+			req.GetReason() == userstatus.FlagDeliverymanDisabled && req.GetFlagged() == flagged
+	})
+}
+
+func (suite *UserStatusChangedSuite) TestDisabledFlagsOrders() {
+	suite.repoAuth.On("IsActiveUser", suite.ctx, userID).Return(&shared.IsActiveUser{Active: false}, nil)
+	suite.repoOrder.On("FlagOrders", suite.ctx, flagOrders(true)).Return(&pb.FlagOrdersResponse{Modified: 2}, nil)
+
+	suite.NoError(suite.svc.UserStatusChanged(suite.ctx, suite.event(false)))
+	suite.repoOrder.AssertNumberOfCalls(suite.T(), "FlagOrders", 1)
+}
+
+func (suite *UserStatusChangedSuite) TestEnabledUnflagsOrders() {
+	suite.repoAuth.On("IsActiveUser", suite.ctx, userID).Return(&shared.IsActiveUser{Active: true}, nil)
+	suite.repoOrder.On("FlagOrders", suite.ctx, flagOrders(false)).Return(&pb.FlagOrdersResponse{Modified: 2}, nil)
+
+	suite.NoError(suite.svc.UserStatusChanged(suite.ctx, suite.event(true)))
+	suite.repoOrder.AssertNumberOfCalls(suite.T(), "FlagOrders", 1)
+}
+
+func (suite *UserStatusChangedSuite) TestStaleEventFollowsCurrentStatus() {
+	suite.repoAuth.On("IsActiveUser", suite.ctx, userID).Return(&shared.IsActiveUser{Active: true}, nil)
+	suite.repoOrder.On("FlagOrders", suite.ctx, flagOrders(false)).Return(&pb.FlagOrdersResponse{}, nil)
+
+	suite.NoError(suite.svc.UserStatusChanged(suite.ctx, suite.event(false)))
+	suite.repoOrder.AssertNumberOfCalls(suite.T(), "FlagOrders", 1)
+}
+
+func (suite *UserStatusChangedSuite) TestUserNotFound() {
+	suite.repoAuth.On("IsActiveUser", suite.ctx, userID).
+		Return(nil, status.Error(codes.NotFound, "User not found"))
+
+	suite.NoError(suite.svc.UserStatusChanged(suite.ctx, suite.event(false)))
+	suite.repoOrder.AssertNotCalled(suite.T(), "FlagOrders", mock.Anything, mock.Anything)
+}
+
+func (suite *UserStatusChangedSuite) TestInvalidEventIsUnprocessable() {
+	err := suite.svc.UserStatusChanged(suite.ctx, &userstatus.Event{UserID: "1234"})
+	suite.ErrorIs(err, shared.ErrUnprocessableMessage)
+	suite.repoAuth.AssertNotCalled(suite.T(), "IsActiveUser", mock.Anything, mock.Anything)
+}
+
+func (suite *UserStatusChangedSuite) TestFlagOrdersFailure() {
+	suite.repoAuth.On("IsActiveUser", suite.ctx, userID).Return(&shared.IsActiveUser{Active: false}, nil)
+	suite.repoOrder.On("FlagOrders", suite.ctx, mock.Anything).
+		Return(nil, status.Error(codes.Unavailable, "order-data-service unavailable"))
+
+	err := suite.svc.UserStatusChanged(suite.ctx, suite.event(false))
+	suite.Equal(codes.Unavailable, status.Code(err))
+	suite.False(errors.Is(err, shared.ErrUnprocessableMessage))
+}
+
+func TestUserStatusChangedSuite(t *testing.T) {
+	suite.Run(t, new(UserStatusChangedSuite))
+}
@@ -0,0 +1,32 @@
+package userstatus
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+)
+
+// FlagDeliverymanDisabled is the flag set on the open orders of a disabled
+// deliveryman, order-data-service only accepts the reasons it knows.
+const FlagDeliverymanDisabled = "DELIVERYMAN_DISABLED"
+
+// Event is the user status event published by auth-service when an account
+// is enabled or disabled.
+type Event struct {
+	UserID     string    `json:"userId" validate:"required,uuid4"`
+	Enabled    bool      `json:"enabled"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+func DecodeEvent(m []byte) (*Event, error) {
+	var e Event
+	if err := json.Unmarshal(m, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (e *Event) Validate(val shared.Validator) error {
+	return val.ValidateStruct(e)
+}
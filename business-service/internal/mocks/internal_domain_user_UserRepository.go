@@ -67,6 +67,58 @@ func (_m *UserRepository_internal_domain_user) FindByEmail(ctx context.Context,
 	return r0, r1
 }
 
+// GetAttributeSchema provides a mock function with given fields: ctx, req
+func (_m *UserRepository_internal_domain_user) GetAttributeSchema(ctx context.Context, req *pb.GetAttributeSchemaRequest) (*pb.AttributeSchemaResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *pb.AttributeSchemaResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAttributeSchemaRequest) (*pb.AttributeSchemaResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAttributeSchemaRequest) *pb.AttributeSchemaResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.AttributeSchemaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetAttributeSchemaRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUsers provides a mock function with given fields: ctx, req
+func (_m *UserRepository_internal_domain_user) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *pb.ListUsersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListUsersRequest) (*pb.ListUsersResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListUsersRequest) *pb.ListUsersResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ListUsersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ListUsersRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: ctx, req
 func (_m *UserRepository_internal_domain_user) Save(ctx context.Context, req *pb.UserRequest) (*pb.UserResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -93,6 +145,58 @@ func (_m *UserRepository_internal_domain_user) Save(ctx context.Context, req *pb
 	return r0, r1
 }
 
+// SaveAttributeSchema provides a mock function with given fields: ctx, req
+func (_m *UserRepository_internal_domain_user) SaveAttributeSchema(ctx context.Context, req *pb.AttributeSchemaRequest) (*pb.AttributeSchemaResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *pb.AttributeSchemaResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.AttributeSchemaRequest) (*pb.AttributeSchemaResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.AttributeSchemaRequest) *pb.AttributeSchemaResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.AttributeSchemaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.AttributeSchemaRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateUser provides a mock function with given fields: ctx, req
+func (_m *UserRepository_internal_domain_user) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UserResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *pb.UserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.UpdateUserRequest) (*pb.UserResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.UpdateUserRequest) *pb.UserResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.UserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.UpdateUserRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewUserRepository_internal_domain_user interface {
 	mock.TestingT
 	Cleanup(func())
@@ -14,6 +14,72 @@ type AuthRepository_internal_shared struct {
 	mock.Mock
 }
 
+// DeleteUser provides a mock function with given fields: ctx, id
+func (_m *AuthRepository_internal_shared) DeleteUser(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DisableUser provides a mock function with given fields: ctx, id
+func (_m *AuthRepository_internal_shared) DisableUser(ctx context.Context, id string) (*shared.GetUserResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *shared.GetUserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*shared.GetUserResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *shared.GetUserResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.GetUserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnableUser provides a mock function with given fields: ctx, id
+func (_m *AuthRepository_internal_shared) EnableUser(ctx context.Context, id string) (*shared.GetUserResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *shared.GetUserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*shared.GetUserResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *shared.GetUserResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.GetUserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindByEmail provides a mock function with given fields: ctx, email
 func (_m *AuthRepository_internal_shared) FindByEmail(ctx context.Context, email string) (*shared.GetUserResponse, error) {
 	ret := _m.Called(ctx, email)
@@ -66,6 +132,58 @@ func (_m *AuthRepository_internal_shared) FindRolesByID(ctx context.Context, id
 	return r0, r1
 }
 
+// GetAccount provides a mock function with given fields: ctx, id
+func (_m *AuthRepository_internal_shared) GetAccount(ctx context.Context, id string) (*shared.GetAccountResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *shared.GetAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*shared.GetAccountResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *shared.GetAccountResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.GetAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccounts provides a mock function with given fields: ctx, ids
+func (_m *AuthRepository_internal_shared) GetAccounts(ctx context.Context, ids []string) ([]*shared.GetAccountResponse, error) {
+	ret := _m.Called(ctx, ids)
+
+	var r0 []*shared.GetAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*shared.GetAccountResponse, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*shared.GetAccountResponse); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*shared.GetAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsActiveUser provides a mock function with given fields: ctx, id
 func (_m *AuthRepository_internal_shared) IsActiveUser(ctx context.Context, id string) (*shared.IsActiveUser, error) {
 	ret := _m.Called(ctx, id)
@@ -118,6 +236,32 @@ func (_m *AuthRepository_internal_shared) Register(ctx context.Context, pld *sha
 	return r0, r1
 }
 
+// UpdateEmail provides a mock function with given fields: ctx, id, email
+func (_m *AuthRepository_internal_shared) UpdateEmail(ctx context.Context, id string, email string) (*shared.GetUserResponse, error) {
+	ret := _m.Called(ctx, id, email)
+
+	var r0 *shared.GetUserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*shared.GetUserResponse, error)); ok {
+		return rf(ctx, id, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *shared.GetUserResponse); ok {
+		r0 = rf(ctx, id, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.GetUserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewAuthRepository_internal_shared creates a new instance of AuthRepository_internal_shared. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAuthRepository_internal_shared(t interface {
@@ -7,6 +7,7 @@ import (
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
 
 	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/circuitbreaker"
 	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
 	"github.com/lucasd-coder/fast-feet/pkg/logger"
 	"github.com/prometheus/client_golang/prometheus"
@@ -51,6 +52,7 @@ func NewClient(_ context.Context, cfg *config.Config) (*grpc.ClientConn, error)
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithChainUnaryInterceptor(
 			clMetrics.UnaryClientInterceptor(grpcprom.WithExemplarFromContext(exemplarFromContext)),
+			circuitbreaker.UnaryClientInterceptor(cfg, circuitbreaker.AuthService),
 			grpc_retry.UnaryClientInterceptor(opts...),
 			logger.GetLogUnaryClientInterceptor(),
 		),
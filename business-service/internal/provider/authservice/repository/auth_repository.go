@@ -124,6 +124,174 @@ func (r *AuthRepository) IsActiveUser(ctx context.Context, id string) (*shared.I
 	}, nil
 }
 
+func (r *AuthRepository) UpdateEmail(ctx context.Context, id, email string) (*shared.GetUserResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := authservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration updateEmail: %+v", err)
+		return nil, fmt.Errorf("err while integration updateEmail: %w", err)
+	}
+	defer conn.Close()
+
+	client := pb.NewAuthHandlerClient(conn)
+
+	in := &pb.UpdateEmailRequest{Email: email}
+	header := metadata.New(map[string]string{"id": id})
+	ctx = metadata.NewOutgoingContext(ctx, header)
+
+	resp, err := client.UpdateEmail(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &shared.GetUserResponse{
+		ID:       resp.GetId(),
+		Email:    resp.GetEmail(),
+		Username: resp.GetEmail(),
+		Enabled:  resp.GetEnabled(),
+	}, nil
+}
+
+func (r *AuthRepository) DeleteUser(ctx context.Context, id string) error {
+	log := logger.FromContext(ctx)
+
+	conn, err := authservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration deleteUser: %+v", err)
+		return fmt.Errorf("err while integration deleteUser: %w", err)
+	}
+	defer conn.Close()
+
+	client := pb.NewAuthHandlerClient(conn)
+
+	in := &pb.EmptyRequest{}
+	header := metadata.New(map[string]string{"id": id})
+	ctx = metadata.NewOutgoingContext(ctx, header)
+
+	_, err = client.DeleteUser(ctx, in)
+
+	return err
+}
+
+func (r *AuthRepository) DisableUser(ctx context.Context, id string) (*shared.GetUserResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := authservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration disableUser: %+v", err)
+		return nil, fmt.Errorf("err while integration disableUser: %w", err)
+	}
+	defer conn.Close()
+
+	client := pb.NewAuthHandlerClient(conn)
+
+	in := &pb.EmptyRequest{}
+	header := metadata.New(map[string]string{"id": id})
+	ctx = metadata.NewOutgoingContext(ctx, header)
+
+	resp, err := client.DisableUser(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &shared.GetUserResponse{
+		ID:       resp.GetId(),
+		Email:    resp.GetEmail(),
+		Username: resp.GetEmail(),
+		Enabled:  resp.GetEnabled(),
+	}, nil
+}
+
+func (r *AuthRepository) EnableUser(ctx context.Context, id string) (*shared.GetUserResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := authservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration enableUser: %+v", err)
+		return nil, fmt.Errorf("err while integration enableUser: %w", err)
+	}
+	defer conn.Close()
+
+	client := pb.NewAuthHandlerClient(conn)
+
+	in := &pb.EmptyRequest{}
+	header := metadata.New(map[string]string{"id": id})
+	ctx = metadata.NewOutgoingContext(ctx, header)
+
+	resp, err := client.EnableUser(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &shared.GetUserResponse{
+		ID:       resp.GetId(),
+		Email:    resp.GetEmail(),
+		Username: resp.GetEmail(),
+		Enabled:  resp.GetEnabled(),
+	}, nil
+}
+
+func (r *AuthRepository) GetAccount(ctx context.Context, id string) (*shared.GetAccountResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := authservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration getAccount: %+v", err)
+		return nil, fmt.Errorf("err while integration getAccount: %w", err)
+	}
+	defer conn.Close()
+
+	client := pb.NewAuthHandlerClient(conn)
+
+	in := &pb.EmptyRequest{}
+	header := metadata.New(map[string]string{"id": id})
+	ctx = metadata.NewOutgoingContext(ctx, header)
+
+	resp, err := client.GetAccount(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildGetAccountResponse(resp), nil
+}
+
+func (r *AuthRepository) GetAccounts(ctx context.Context, ids []string) ([]*shared.GetAccountResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := authservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration getAccounts: %+v", err)
+		return nil, fmt.Errorf("err while integration getAccounts: %w", err)
+	}
+	defer conn.Close()
+
+	client := pb.NewAuthHandlerClient(conn)
+
+	resp, err := client.GetAccounts(ctx, &pb.GetAccountsRequest{Ids: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*shared.GetAccountResponse, 0, len(resp.GetAccounts()))
+	for _, account := range resp.GetAccounts() {
+		accounts = append(accounts, buildGetAccountResponse(account))
+	}
+
+	return accounts, nil
+}
+
+func buildGetAccountResponse(resp *pb.GetAccountResponse) *shared.GetAccountResponse {
+	return &shared.GetAccountResponse{
+		ID:        resp.GetId(),
+		Email:     resp.GetEmail(),
+		Username:  resp.GetUsername(),
+		Enabled:   resp.GetEnabled(),
+		Roles:     resp.GetRoles(),
+		CreatedAt: resp.GetCreatedAt(),
+	}
+}
+
 func buildRegisterUserResponse(resp *pb.RegisterResponse) *shared.RegisterUserResponse {
 	return &shared.RegisterUserResponse{
 		ID: resp.GetId(),
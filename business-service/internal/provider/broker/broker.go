@@ -0,0 +1,69 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gocloud.dev/pubsub"
+
+	// revive
+	_ "gocloud.dev/pubsub/kafkapubsub"
+	// revive
+	_ "gocloud.dev/pubsub/mempubsub"
+	// revive
+	_ "gocloud.dev/pubsub/natspubsub"
+	// revive
+	_ "gocloud.dev/pubsub/rabbitpubsub"
+)
+
+const (
+	SchemeMem    = "mem"
+	SchemeRabbit = "rabbit"
+	SchemeNats   = "nats"
+	SchemeKafka  = "kafka"
+)
+
+var ErrUnsupportedScheme = fmt.Errorf("unsupported broker scheme, expected one of %s, %s, %s or %s",
+	SchemeMem, SchemeRabbit, SchemeNats, SchemeKafka)
+
+func Scheme(queueURL string) string {
+	scheme, _, found := strings.Cut(queueURL, "://")
+	if !found {
+		return ""
+	}
+	return scheme
+}
+
+func OpenTopic(ctx context.Context, queueURL string) (*pubsub.Topic, error) {
+	if err := validate(queueURL); err != nil {
+		return nil, err
+	}
+	return pubsub.OpenTopic(ctx, queueURL)
+}
+
+func OpenSubscription(ctx context.Context, queueURL string) (*pubsub.Subscription, error) {
+	if err := validate(queueURL); err != nil {
+		return nil, err
+	}
+	return pubsub.OpenSubscription(ctx, queueURL)
+}
+
+// TopicURL returns the URL to publish to for messages to reach queueURL. A
+// RabbitMQ queue is fed by the exchange it is bound to, on the other brokers
+// the topic and the subscription share the URL.
+func TopicURL(queueURL, exchange string) string {
+	if Scheme(queueURL) == SchemeRabbit && exchange != "" {
+		return SchemeRabbit + "://" + exchange
+	}
+	return queueURL
+}
+
+func validate(queueURL string) error {
+	switch Scheme(queueURL) {
+	case SchemeMem, SchemeRabbit, SchemeNats, SchemeKafka:
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedScheme, queueURL)
+	}
+}
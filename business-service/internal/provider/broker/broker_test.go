@@ -0,0 +1,72 @@
+package broker_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/broker"
+	"gocloud.dev/pubsub"
+)
+
+func TestOpenUnsupportedScheme(t *testing.T) {
+	ctx := context.Background()
+
+	if _, err := broker.OpenTopic(ctx, "sqs://business-service-user-events"); !errors.Is(err, broker.ErrUnsupportedScheme) {
+		t.Errorf("OpenTopic() error = %v, want %v", err, broker.ErrUnsupportedScheme)
+	}
+	if _, err := broker.OpenSubscription(ctx, "business-service-user-events"); !errors.Is(err, broker.ErrUnsupportedScheme) {
+		t.Errorf("OpenSubscription() error = %v, want %v", err, broker.ErrUnsupportedScheme)
+	}
+}
+
+func TestOpenMem(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	url := fmt.Sprintf("mem://business-service-user-events-%d", time.Now().UnixNano())
+
+	topic, err := broker.OpenTopic(ctx, url)
+	if err != nil {
+		t.Fatalf("OpenTopic() error = %v", err)
+	}
+	defer func() { _ = topic.Shutdown(ctx) }()
+
+	sub, err := broker.OpenSubscription(ctx, url)
+	if err != nil {
+		t.Fatalf("OpenSubscription() error = %v", err)
+	}
+	defer func() { _ = sub.Shutdown(ctx) }()
+
+	if err := topic.Send(ctx, &pubsub.Message{Body: []byte("payload")}); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	msg, err := sub.Receive(ctx)
+	if err != nil {
+		t.Fatalf("Receive() error = %v", err)
+	}
+	msg.Ack()
+
+	if string(msg.Body) != "payload" {
+		t.Errorf("Receive() body = %s, want payload", msg.Body)
+	}
+}
+
+func TestTopicURL(t *testing.T) {
+	tests := []struct {
+		queueURL, exchange, want string
+	}{
+		{"rabbit://business-service-user-events", "router-service-user-events", "rabbit://router-service-user-events"},
+		{"rabbit://business-service-user-events", "", "rabbit://business-service-user-events"},
+		{"mem://business-service-user-events", "router-service-user-events", "mem://business-service-user-events"},
+	}
+
+	for _, tt := range tests {
+		if got := broker.TopicURL(tt.queueURL, tt.exchange); got != tt.want {
+			t.Errorf("TopicURL(%q, %q) = %q, want %q", tt.queueURL, tt.exchange, got, tt.want)
+		}
+	}
+}
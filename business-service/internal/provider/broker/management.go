@@ -0,0 +1,86 @@
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Management reads queue statistics from the RabbitMQ management HTTP API.
+type Management struct {
+	baseURL string
+	vhost   string
+	user    *url.Userinfo
+	client  *http.Client
+}
+
+type queueStats struct {
+	MessagesReady int `json:"messages_ready"`
+}
+
+// NewManagement creates a client for managementURL, e.g.
+// http://localhost:15672. The vhost, and the credentials when managementURL
+// has none, come from the AMQP serverURL.
+func NewManagement(managementURL, serverURL string) (*Management, error) {
+	mgmt, err := url.Parse(managementURL)
+	if err != nil {
+		return nil, fmt.Errorf("fail parse management url err: %w", err)
+	}
+	server, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, fmt.Errorf("fail parse server url err: %w", err)
+	}
+
+	user := mgmt.User
+	if user == nil {
+		user = server.User
+	}
+	mgmt.User = nil
+
+	vhost := strings.TrimPrefix(server.Path, "/")
+	if vhost == "" {
+		vhost = "/"
+	}
+
+	return &Management{
+		baseURL: strings.TrimSuffix(mgmt.String(), "/"),
+		vhost:   vhost,
+		user:    user,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// QueueDepth returns the messages ready to be delivered in queue.
+func (m *Management) QueueDepth(ctx context.Context, queue string) (int, error) {
+	endpoint := fmt.Sprintf("%s/api/queues/%s/%s", m.baseURL, url.PathEscape(m.vhost), url.PathEscape(queue))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+	if err != nil {
+		return 0, fmt.Errorf("fail create request err: %w", err)
+	}
+	if m.user != nil {
+		password, _ := m.user.Password()
+		req.SetBasicAuth(m.user.Username(), password)
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("fail get queue: %s err: %w", queue, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("fail get queue: %s status: %d", queue, resp.StatusCode)
+	}
+
+	var stats queueStats
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return 0, fmt.Errorf("fail decode queue: %s err: %w", queue, err)
+	}
+
+	return stats.MessagesReady, nil
+}
@@ -0,0 +1,102 @@
+package broker
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// DeclarePriorityQueue declares a durable priority queue and, when exchange
+// is informed, binds it to that fanout exchange. RabbitMQ cannot add
+// x-max-priority to an existing queue, so a queue declared before priorities
+// fails with PRECONDITION_FAILED and has to be recreated.
+func DeclarePriorityQueue(queue, exchange string, maxPriority int) error {
+	return withChannel(func(ch *amqp.Channel) error {
+		return declareBoundQueue(ch, queue, exchange, amqp.Table{
+			"x-max-priority": int32(maxPriority),
+		})
+	})
+}
+
+// DeclareDeadLetterQueue declares the fanout exchange the dead-letter topic
+// publishes to and the queue of the same name the dlq command reads from.
+func DeclareDeadLetterQueue(name string) error {
+	return withChannel(func(ch *amqp.Channel) error {
+		return declareBoundQueue(ch, name, name, nil)
+	})
+}
+
+// DeclareRetryQueues declares the retry topology of queue without the delayed
+// message plugin. exchange is a headers exchange that routes a message by its
+// attemptHeader to a queue whose TTL is the delay of that attempt, delays[0]
+// being the first one. An expired message is dead-lettered back to queue
+// through the default exchange. RabbitMQ cannot change the TTL of an existing
+// queue, so a retry queue has to be deleted when its delay changes.
+func DeclareRetryQueues(queue, exchange, attemptHeader string, delays []time.Duration) error {
+	return withChannel(func(ch *amqp.Channel) error {
+		if err := ch.ExchangeDeclare(exchange, amqp.ExchangeHeaders, true, false, false, false, nil); err != nil {
+			return fmt.Errorf("fail declare exchange: %s err: %w", exchange, err)
+		}
+
+		for i, delay := range delays {
+			attempt := strconv.Itoa(i + 1)
+			name := fmt.Sprintf("%s-%s", exchange, attempt)
+
+			if _, err := ch.QueueDeclare(name, true, false, false, false, amqp.Table{
+				"x-message-ttl":             delay.Milliseconds(),
+				"x-dead-letter-exchange":    "",
+				"x-dead-letter-routing-key": queue,
+			}); err != nil {
+				return fmt.Errorf("fail declare queue: %s err: %w", name, err)
+			}
+
+			if err := ch.QueueBind(name, "", exchange, false, amqp.Table{
+				"x-match":     "all",
+				attemptHeader: attempt,
+			}); err != nil {
+				return fmt.Errorf("fail bind queue: %s to exchange: %s err: %w", name, exchange, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func declareBoundQueue(ch *amqp.Channel, queue, exchange string, args amqp.Table) error {
+	if exchange != "" {
+		if err := ch.ExchangeDeclare(exchange, amqp.ExchangeFanout, true, false, false, false, nil); err != nil {
+			return fmt.Errorf("fail declare exchange: %s err: %w", exchange, err)
+		}
+	}
+
+	if _, err := ch.QueueDeclare(queue, true, false, false, false, args); err != nil {
+		return fmt.Errorf("fail declare queue: %s err: %w", queue, err)
+	}
+
+	if exchange != "" {
+		if err := ch.QueueBind(queue, "", exchange, false, nil); err != nil {
+			return fmt.Errorf("fail bind queue: %s to exchange: %s err: %w", queue, exchange, err)
+		}
+	}
+
+	return nil
+}
+
+func withChannel(fn func(ch *amqp.Channel) error) error {
+	conn, err := amqp.Dial(os.Getenv("RABBIT_SERVER_URL"))
+	if err != nil {
+		return fmt.Errorf("fail dial rabbitmq err: %w", err)
+	}
+	defer conn.Close()
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return fmt.Errorf("fail open channel err: %w", err)
+	}
+	defer ch.Close()
+
+	return fn(ch)
+}
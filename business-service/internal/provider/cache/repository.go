@@ -38,3 +38,24 @@ func (repo *Repository[T]) Save(ctx context.Context, key string, value T, ttl ti
 func (repo *Repository[T]) Get(ctx context.Context, key string) (string, error) {
 	return repo.client.Get(ctx, key).Result()
 }
+
+// SaveIfAbsent stores value only when key does not exist yet and reports
+// whether it was stored.
+func (repo *Repository[T]) SaveIfAbsent(ctx context.Context, key string, value T, ttl time.Duration) (bool, error) {
+	enc := codec.New[T]()
+	val, err := enc.Encode(value)
+	if err != nil {
+		return false, err
+	}
+
+	return repo.client.SetNX(ctx, key, val, ttl).Result()
+}
+
+func (repo *Repository[T]) Exists(ctx context.Context, key string) (bool, error) {
+	n, err := repo.client.Exists(ctx, key).Result()
+	return n > 0, err
+}
+
+func (repo *Repository[T]) Delete(ctx context.Context, key string) error {
+	return repo.client.Del(ctx, key).Err()
+}
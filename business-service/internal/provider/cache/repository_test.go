@@ -68,6 +68,24 @@ func (suite *RepositorySuite) TestGet() {
 	suite.Equal(cached, "something here")
 }
 
+func (suite *RepositorySuite) TestSaveIfAbsent() {
+	ctx := suite.ctx
+
+	saved, err := suite.repository.SaveIfAbsent(ctx, "dedup", "first", time.Minute)
+	suite.NoError(err)
+	suite.True(saved)
+
+	saved, err = suite.repository.SaveIfAbsent(ctx, "dedup", "second", time.Minute)
+	suite.NoError(err)
+	suite.False(saved)
+
+	suite.NoError(suite.repository.Delete(ctx, "dedup"))
+
+	saved, err = suite.repository.SaveIfAbsent(ctx, "dedup", "third", time.Minute)
+	suite.NoError(err)
+	suite.True(saved)
+}
+
 func TestRepositorySuite(t *testing.T) {
 	suite.Run(t, new(RepositorySuite))
 }
@@ -0,0 +1,101 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"github.com/sony/gobreaker"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	UserManagerService = "user-manager-service"
+	AuthService        = "auth-service"
+	OrderDataService   = "order-data-service"
+)
+
+var (
+	mutex    sync.Mutex
+	breakers = map[string]*gobreaker.CircuitBreaker{}
+)
+
+// Get returns the breaker shared by every client of the service name, as
+// the repositories dial a new connection per call.
+func Get(cfg *config.Config, name string) *gobreaker.CircuitBreaker {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if cb, ok := breakers[name]; ok {
+		return cb
+	}
+
+	maxFailures := cfg.CircuitBreaker.MaxFailures
+	cb := gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:     name,
+		Interval: cfg.CircuitBreaker.Interval,
+		Timeout:  cfg.CircuitBreaker.OpenTimeout,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= maxFailures
+		},
+		IsSuccessful: isSuccessful,
+		OnStateChange: func(name string, from, to gobreaker.State) {
+			logger.FromContext(context.Background()).Warnf("circuit breaker %s changed from %s to %s", name, from, to)
+		},
+	})
+	breakers[name] = cb
+
+	return cb
+}
+
+// isSuccessful only counts the service being unreachable as a failure,
+// business errors such as NotFound keep the circuit closed.
+func isSuccessful(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return false
+	default:
+		return true
+	}
+}
+
+// UnaryClientInterceptor fails fast with shared.ErrCircuitOpen while the
+// breaker of name is open. It must wrap the retry interceptor so retries
+// count as a single call.
+func UnaryClientInterceptor(cfg *config.Config, name string) grpc.UnaryClientInterceptor {
+	cb := Get(cfg, name)
+	return func(ctx context.Context, method string, req, reply any,
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+	) error {
+		_, err := cb.Execute(func() (interface{}, error) {
+			return nil, invoker(ctx, method, req, reply, cc, opts...)
+		})
+		if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+			return &OpenError{Name: name}
+		}
+		return err
+	}
+}
+
+// OpenError is returned instead of calling a service whose circuit is open.
+type OpenError struct {
+	Name string
+}
+
+func (e *OpenError) Error() string {
+	return fmt.Sprintf("%s: %s", shared.ErrCircuitOpen, e.Name)
+}
+
+func (e *OpenError) Unwrap() error {
+	return shared.ErrCircuitOpen
+}
+
+func (e *OpenError) GRPCStatus() *status.Status {
+	return status.New(codes.Unavailable, e.Error())
+}
@@ -0,0 +1,105 @@
+package circuitbreaker_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/circuitbreaker"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/sony/gobreaker"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newConfig(openTimeout time.Duration) *config.Config {
+	cfg := &config.Config{}
+	cfg.CircuitBreaker.MaxFailures = 2
+	cfg.CircuitBreaker.OpenTimeout = openTimeout
+	return cfg
+}
+
+func name(t *testing.T) string {
+	return fmt.Sprintf("%s-%d", t.Name(), time.Now().UnixNano())
+}
+
+func invoke(interceptor grpc.UnaryClientInterceptor, err error, calls *int) error {
+	return interceptor(context.Background(), "/UserService/Save", nil, nil, nil,
+		func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			*calls++
+			return err
+		})
+}
+
+func TestInterceptorOpensAfterUnavailable(t *testing.T) {
+	interceptor := circuitbreaker.UnaryClientInterceptor(newConfig(time.Minute), name(t))
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+
+	var calls int
+	for i := 0; i < 2; i++ {
+		if err := invoke(interceptor, unavailable, &calls); status.Code(err) != codes.Unavailable {
+			t.Fatalf("call %d error = %v, want unavailable", i, err)
+		}
+	}
+
+	err := invoke(interceptor, nil, &calls)
+	if !errors.Is(err, shared.ErrCircuitOpen) {
+		t.Errorf("error = %v, want %v", err, shared.ErrCircuitOpen)
+	}
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("status code = %v, want %v", status.Code(err), codes.Unavailable)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+}
+
+func TestInterceptorIgnoresBusinessErrors(t *testing.T) {
+	interceptor := circuitbreaker.UnaryClientInterceptor(newConfig(time.Minute), name(t))
+	notFound := status.Error(codes.NotFound, "user not found")
+
+	var calls int
+	for i := 0; i < 5; i++ {
+		if err := invoke(interceptor, notFound, &calls); status.Code(err) != codes.NotFound {
+			t.Fatalf("call %d error = %v, want not found", i, err)
+		}
+	}
+	if calls != 5 {
+		t.Errorf("calls = %d, want 5", calls)
+	}
+}
+
+func TestGateWaitsUntilHalfOpen(t *testing.T) {
+	cfg := newConfig(100 * time.Millisecond)
+	breaker := name(t)
+	interceptor := circuitbreaker.UnaryClientInterceptor(cfg, breaker)
+	gate := circuitbreaker.NewGate(cfg, breaker)
+
+	if err := gate.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+
+	var calls int
+	for i := 0; i < 2; i++ {
+		_ = invoke(interceptor, status.Error(codes.Unavailable, "connection refused"), &calls)
+	}
+	if state := circuitbreaker.Get(cfg, breaker).State(); state != gobreaker.StateOpen {
+		t.Fatalf("State() = %v, want open", state)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := gate.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	if err := gate.Wait(ctx); err != nil {
+		t.Errorf("Wait() error = %v, want half-open", err)
+	}
+}
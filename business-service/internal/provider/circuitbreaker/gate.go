@@ -0,0 +1,61 @@
+package circuitbreaker
+
+import (
+	"context"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"github.com/sony/gobreaker"
+)
+
+const gatePollInterval = time.Second
+
+// Gate holds a subscription while any of its breakers is open, so messages
+// stay in the queue instead of burning their retries.
+type Gate struct {
+	breakers []*gobreaker.CircuitBreaker
+}
+
+func NewGate(cfg *config.Config, names ...string) *Gate {
+	g := &Gate{}
+	for _, name := range names {
+		g.breakers = append(g.breakers, Get(cfg, name))
+	}
+	return g
+}
+
+// Wait blocks until no breaker is open, a half-open breaker lets the trial
+// messages through.
+func (g *Gate) Wait(ctx context.Context) error {
+	cb := g.open()
+	if cb == nil {
+		return nil
+	}
+
+	logger.FromContext(ctx).Warnf("circuit breaker %s is open, pausing subscription", cb.Name())
+
+	ticker := time.NewTicker(gatePollInterval)
+	defer ticker.Stop()
+
+	for cb != nil {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			cb = g.open()
+		}
+	}
+
+	logger.FromContext(ctx).Infof("circuit breakers no longer open, resuming subscription")
+	return nil
+}
+
+func (g *Gate) open() *gobreaker.CircuitBreaker {
+	for _, cb := range g.breakers {
+		if cb.State() == gobreaker.StateOpen {
+			return cb
+		}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package erasurestore_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/erasure"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/erasurestore"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+const userID = "9a0e3a5c-3f5b-4f0d-9d5e-2b0c1f6a7e21"
+
+type ErasureStoreSuite struct {
+	suite.Suite
+	ctx         context.Context
+	redisServer *miniredis.Miniredis
+	repo        *erasurestore.Repository
+	cfg         *config.Config
+}
+
+func (suite *ErasureStoreSuite) SetupTest() {
+	suite.ctx = context.Background()
+
+	var err error
+	suite.redisServer, err = miniredis.Run()
+	suite.Require().NoError(err)
+
+	suite.cfg = &config.Config{}
+	suite.cfg.Erasure.Lease = time.Minute
+	suite.cfg.Erasure.BatchSize = 10
+
+	client := redis.NewClient(&redis.Options{Addr: suite.redisServer.Addr()})
+	suite.repo = erasurestore.NewRepository(client)
+}
+
+func (suite *ErasureStoreSuite) TearDownTest() {
+	suite.redisServer.Close()
+}
+
+func (suite *ErasureStoreSuite) create() *erasure.Erasure {
+	e := &erasure.Erasure{
+		UserID:      userID,
+		Status:      erasure.StatusPending,
+		Steps:       map[string]time.Time{},
+		RequestedAt: time.Now(),
+	}
+	created, err := suite.repo.Create(suite.ctx, e)
+	suite.Require().NoError(err)
+	suite.Require().True(created)
+	return e
+}
+
+func (suite *ErasureStoreSuite) TestCreateOnce() {
+	suite.create()
+
+	created, err := suite.repo.Create(suite.ctx, &erasure.Erasure{UserID: userID})
+	suite.NoError(err)
+	suite.False(created)
+
+	_, err = suite.repo.Find(suite.ctx, "another-user")
+	suite.ErrorIs(err, shared.ErrErasureNotFound)
+}
+
+func (suite *ErasureStoreSuite) TestClaimLeasesPendingErasures() {
+	suite.create()
+	now := time.Now()
+
+	erasures, err := suite.repo.Claim(suite.ctx, now, time.Minute, 10)
+	suite.NoError(err)
+	suite.Len(erasures, 1)
+
+	erasures, err = suite.repo.Claim(suite.ctx, now, time.Minute, 10)
+	suite.NoError(err)
+	suite.Empty(erasures)
+
+	erasures, err = suite.repo.Claim(suite.ctx, now.Add(2*time.Minute), time.Minute, 10)
+	suite.NoError(err)
+	suite.Len(erasures, 1)
+}
+
+func (suite *ErasureStoreSuite) TestRunDueKeepsFailedErasurePending() {
+	suite.cfg.Erasure.Lease = 0
+	suite.create()
+
+	var runs int
+	worker := erasurestore.NewWorker(suite.repo, suite.cfg, func(ctx context.Context, e *erasure.Erasure) error {
+		runs++
+		if runs == 1 {
+			return errors.New("auth-service unavailable")
+		}
+		e.Status = erasure.StatusCompleted
+		return suite.repo.Complete(ctx, e)
+	})
+
+	worker.RunDue(suite.ctx)
+	worker.RunDue(suite.ctx)
+	worker.RunDue(suite.ctx)
+	suite.Equal(2, runs)
+
+	e, err := suite.repo.Find(suite.ctx, userID)
+	suite.NoError(err)
+	suite.Equal(erasure.StatusCompleted, e.Status)
+	suite.False(suite.redisServer.Exists("erasures:pending"))
+	suite.Zero(suite.redisServer.TTL("erasure:" + userID))
+}
+
+func TestErasureStoreSuite(t *testing.T) {
+	suite.Run(t, new(ErasureStoreSuite))
+}
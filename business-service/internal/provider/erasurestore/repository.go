@@ -0,0 +1,130 @@
+package erasurestore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/erasure"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	pendingKey    = "erasures:pending"
+	erasureKeyFmt = "erasure:%s"
+)
+
+// claimScript moves the due erasures lease ahead so only one replica runs
+// them. An erasure whose lease expires, e.g. after a crash, is claimed again.
+var claimScript = redis.NewScript(`
+local ids = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[3])
+for _, id in ipairs(ids) do
+	redis.call('ZADD', KEYS[1], ARGV[2], id)
+end
+return ids
+`)
+
+// Repository stores the erasures in Redis: a key per user, never expired
+// since it is the audit record, and a sorted set of the pending ones by due
+// time.
+type Repository struct {
+	client *redis.Client
+}
+
+func NewRepository(client *redis.Client) *Repository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) Create(ctx context.Context, e *erasure.Erasure) (bool, error) {
+	val, err := json.Marshal(e)
+	if err != nil {
+		return false, fmt.Errorf("fail json.Marshal err: %w", err)
+	}
+
+	created, err := r.client.SetNX(ctx, erasureKey(e.UserID), val, 0).Result()
+	if err != nil || !created {
+		return false, err
+	}
+
+	return true, r.Enqueue(ctx, e.UserID)
+}
+
+func (r *Repository) Find(ctx context.Context, userID string) (*erasure.Erasure, error) {
+	val, err := r.client.Get(ctx, erasureKey(userID)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, shared.ErrErasureNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var e erasure.Erasure
+	if err := json.Unmarshal(val, &e); err != nil {
+		return nil, fmt.Errorf("fail json.Unmarshal err: %w", err)
+	}
+	return &e, nil
+}
+
+func (r *Repository) Enqueue(ctx context.Context, userID string) error {
+	return r.client.ZAddNX(ctx, pendingKey, redis.Z{Score: score(time.Now()), Member: userID}).Err()
+}
+
+func (r *Repository) Update(ctx context.Context, e *erasure.Erasure) error {
+	val, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("fail json.Marshal err: %w", err)
+	}
+	return r.client.Set(ctx, erasureKey(e.UserID), val, 0).Err()
+}
+
+// Complete saves a completed erasure and removes it from the pending ones.
+func (r *Repository) Complete(ctx context.Context, e *erasure.Erasure) error {
+	val, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("fail json.Marshal err: %w", err)
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, erasureKey(e.UserID), val, 0)
+		pipe.ZRem(ctx, pendingKey, e.UserID)
+		return nil
+	})
+	return err
+}
+
+// Claim returns up to limit erasures due at now and leases them until
+// now+lease.
+func (r *Repository) Claim(ctx context.Context, now time.Time, lease time.Duration,
+	limit int64) ([]*erasure.Erasure, error) {
+	ids, err := claimScript.Run(ctx, r.client, []string{pendingKey},
+		score(now), score(now.Add(lease)), limit).StringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("fail claim erasures err: %w", err)
+	}
+
+	erasures := make([]*erasure.Erasure, 0, len(ids))
+	for _, id := range ids {
+		e, err := r.Find(ctx, id)
+		if errors.Is(err, shared.ErrErasureNotFound) {
+			r.client.ZRem(ctx, pendingKey, id)
+			continue
+		}
+		if err != nil {
+			return erasures, err
+		}
+		erasures = append(erasures, e)
+	}
+
+	return erasures, nil
+}
+
+func score(t time.Time) float64 {
+	return float64(t.UnixMilli())
+}
+
+func erasureKey(userID string) string {
+	return fmt.Sprintf(erasureKeyFmt, userID)
+}
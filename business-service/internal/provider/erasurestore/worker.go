@@ -0,0 +1,68 @@
+package erasurestore
+
+import (
+	"context"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/erasure"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+)
+
+// Worker polls the repository and runs the pending erasures.
+type Worker struct {
+	repo *Repository
+	cfg  *config.Config
+	run  func(ctx context.Context, e *erasure.Erasure) error
+}
+
+func NewWorker(repo *Repository, cfg *config.Config,
+	run func(ctx context.Context, e *erasure.Erasure) error) *Worker {
+	return &Worker{
+		repo: repo,
+		cfg:  cfg,
+		run:  run,
+	}
+}
+
+func (w *Worker) Start(ctx context.Context) {
+	log := logger.FromContext(ctx)
+
+	log.Infof("Erasure worker has been started.... poll-interval: %s", w.cfg.Erasure.PollInterval)
+
+	ticker := time.NewTicker(w.cfg.Erasure.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("context cancelled, stopping Erasure worker...")
+			return
+		case <-ticker.C:
+			w.RunDue(ctx)
+		}
+	}
+}
+
+// RunDue runs the erasures due now. A failed erasure is kept pending and
+// runs again when its lease expires, erasure is mandatory so it is never
+// discarded.
+func (w *Worker) RunDue(ctx context.Context) {
+	log := logger.FromContext(ctx)
+
+	erasures, err := w.repo.Claim(ctx, time.Now(), w.cfg.Erasure.Lease, w.cfg.Erasure.BatchSize)
+	if err != nil {
+		log.Errorf("error claiming erasures: %v", err)
+	}
+
+	// the claimed erasures are leased, finish them even when shutting down.
+	ctx = context.WithoutCancel(ctx)
+
+	for _, e := range erasures {
+		if err := w.run(ctx, e); err != nil {
+			log.Errorf("error running erasure of user id: %s attempt %d, err: %v", e.UserID, e.Attempts, err)
+			continue
+		}
+		log.Infof("erasure of user id: %s completed", e.UserID)
+	}
+}
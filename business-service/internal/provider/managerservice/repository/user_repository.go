@@ -69,3 +69,118 @@ func (r *UserRepository) FindByCpf(ctx context.Context, req *pb.UserByCpfRequest
 
 	return resp, err
 }
+
+func (r *UserRepository) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UserResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := managerservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration updateUser: %+v", err)
+		return nil, fmt.Errorf("err while integration updateUser: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewUserServiceClient(conn)
+
+	return client.UpdateUser(ctx, req)
+}
+
+func (r *UserRepository) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := managerservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration deleteUser: %+v", err)
+		return nil, fmt.Errorf("err while integration deleteUser: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewUserServiceClient(conn)
+
+	return client.DeleteUser(ctx, req)
+}
+
+func (r *UserRepository) ExportUser(ctx context.Context, req *pb.ExportUserRequest) (*pb.ExportUserResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := managerservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration exportUser: %+v", err)
+		return nil, fmt.Errorf("err while integration exportUser: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewUserServiceClient(conn)
+
+	return client.ExportUser(ctx, req)
+}
+
+func (r *UserRepository) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := managerservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration listUsers: %+v", err)
+		return nil, fmt.Errorf("err while integration listUsers: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewUserServiceClient(conn)
+
+	return client.ListUsers(ctx, req)
+}
+
+func (r *UserRepository) GetDeliverymanProfile(ctx context.Context,
+	req *pb.GetDeliverymanProfileRequest) (*pb.DeliverymanProfileResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := managerservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration getDeliverymanProfile: %+v", err)
+		return nil, fmt.Errorf("err while integration getDeliverymanProfile: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewUserServiceClient(conn)
+
+	return client.GetDeliverymanProfile(ctx, req)
+}
+
+func (r *UserRepository) SaveAttributeSchema(ctx context.Context,
+	req *pb.AttributeSchemaRequest) (*pb.AttributeSchemaResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := managerservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration saveAttributeSchema: %+v", err)
+		return nil, fmt.Errorf("err while integration saveAttributeSchema: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewUserServiceClient(conn)
+
+	return client.SaveAttributeSchema(ctx, req)
+}
+
+func (r *UserRepository) GetAttributeSchema(ctx context.Context,
+	req *pb.GetAttributeSchemaRequest) (*pb.AttributeSchemaResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := managerservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration getAttributeSchema: %+v", err)
+		return nil, fmt.Errorf("err while integration getAttributeSchema: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewUserServiceClient(conn)
+
+	return client.GetAttributeSchema(ctx, req)
+}
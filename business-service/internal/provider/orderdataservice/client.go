@@ -10,6 +10,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/circuitbreaker"
 	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
 	"github.com/lucasd-coder/fast-feet/pkg/logger"
 	"google.golang.org/grpc"
@@ -52,6 +53,7 @@ func NewClient(_ context.Context, cfg *config.Config) (*grpc.ClientConn, error)
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithChainUnaryInterceptor(
 			clMetrics.UnaryClientInterceptor(grpcprom.WithExemplarFromContext(exemplarFromContext)),
+			circuitbreaker.UnaryClientInterceptor(cfg, circuitbreaker.OrderDataService),
 			grpc_retry.UnaryClientInterceptor(opts...),
 			logger.GetLogUnaryClientInterceptor(),
 		),
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BatchOrderDataRepository groups concurrent Save calls into a SaveBatch
+// call, flushed when BatchSize orders are pending or BatchWindow elapsed
+// since the first one. Every caller waits for the result of its own order.
+type BatchOrderDataRepository struct {
+	*OrderDataRepository
+	size   int
+	window time.Duration
+
+	mu      sync.Mutex
+	pending *batch
+}
+
+type batch struct {
+	items []*batchItem
+	timer *time.Timer
+}
+
+type batchItem struct {
+	ctx  context.Context
+	req  *pb.OrderRequest
+	done chan batchResult
+}
+
+type batchResult struct {
+	resp *pb.OrderResponse
+	err  error
+}
+
+func NewBatchOrderDataRepository(cfg *config.Config, repo *OrderDataRepository) *BatchOrderDataRepository {
+	return &BatchOrderDataRepository{
+		OrderDataRepository: repo,
+		size:                cfg.QueueOrderEvents.BatchSize,
+		window:              cfg.QueueOrderEvents.BatchWindow,
+	}
+}
+
+func (r *BatchOrderDataRepository) Save(ctx context.Context, req *pb.OrderRequest) (*pb.OrderResponse, error) {
+	item := &batchItem{ctx: ctx, req: req, done: make(chan batchResult, 1)}
+
+	r.mu.Lock()
+	if r.pending == nil {
+		b := &batch{}
+		b.timer = time.AfterFunc(r.window, func() {
+			r.flush(b)
+		})
+		r.pending = b
+	}
+	b := r.pending
+	b.items = append(b.items, item)
+	full := len(b.items) >= r.size
+	r.mu.Unlock()
+
+	if full {
+		r.flush(b)
+	}
+
+	select {
+	case res := <-item.done:
+		return res.resp, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// flush sends b unless it was already sent by the timer or a full batch.
+func (r *BatchOrderDataRepository) flush(b *batch) {
+	r.mu.Lock()
+	if r.pending != b {
+		r.mu.Unlock()
+		return
+	}
+	r.pending = nil
+	b.timer.Stop()
+	r.mu.Unlock()
+
+	// the batch outlives the context of the order that started it.
+	ctx := context.WithoutCancel(b.items[0].ctx)
+	log := logger.FromContext(ctx)
+
+	reqs := make([]*pb.OrderRequest, 0, len(b.items))
+	for _, item := range b.items {
+		reqs = append(reqs, item.req)
+	}
+
+	resp, err := r.SaveBatch(ctx, &pb.SaveBatchRequest{Orders: reqs})
+	if err == nil && len(resp.GetResults()) != len(reqs) {
+		err = fmt.Errorf("save batch returned %d results for %d orders", len(resp.GetResults()), len(reqs))
+	}
+	if err != nil {
+		log.Errorf("error while call order-repository saveBatch size %d err: %v", len(reqs), err)
+		for _, item := range b.items {
+			item.done <- batchResult{err: err}
+		}
+		return
+	}
+
+	log.Infof("order batch of %d orders saved", len(reqs))
+
+	for i, result := range resp.GetResults() {
+		if code := codes.Code(result.GetCode()); code != codes.OK {
+			b.items[i].done <- batchResult{err: status.Error(code, result.GetMessage())}
+			continue
+		}
+		b.items[i].done <- batchResult{resp: &pb.OrderResponse{
+			Id:        result.GetId(),
+			CreatedAt: result.GetCreatedAt(),
+		}}
+	}
+}
@@ -0,0 +1,184 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/orderdataservice/repository"
+	"github.com/lucasd-coder/fast-feet/business-service/pkg/pb"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type orderService struct {
+	pb.UnimplementedOrderServiceServer
+	mu      sync.Mutex
+	batches [][]*pb.OrderRequest
+}
+
+func (s *orderService) SaveBatch(_ context.Context, req *pb.SaveBatchRequest) (*pb.SaveBatchResponse, error) {
+	s.mu.Lock()
+	s.batches = append(s.batches, req.GetOrders())
+	s.mu.Unlock()
+
+	results := make([]*pb.SaveBatchResult, 0, len(req.GetOrders()))
+	for _, order := range req.GetOrders() {
+		if order.GetDeliverymanId() == "invalid" {
+			results = append(results, &pb.SaveBatchResult{
+				Code:    uint32(codes.InvalidArgument),
+				Message: "invalid parameters",
+			})
+			continue
+		}
+		results = append(results, &pb.SaveBatchResult{Id: "id-" + order.GetDeliverymanId()})
+	}
+	return &pb.SaveBatchResponse{Results: results}, nil
+}
+
+func (s *orderService) ExportOrders(req *pb.ExportOrdersRequest, stream pb.OrderService_ExportOrdersServer) error {
+	for _, id := range []string{"a", "b", "c"} {
+		if err := stream.Send(&pb.Order{Id: id, DeliverymanId: req.GetDeliverymanId()}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *orderService) sizes() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sizes := make([]int, 0, len(s.batches))
+	for _, batch := range s.batches {
+		sizes = append(sizes, len(batch))
+	}
+	return sizes
+}
+
+type BatchOrderDataRepositorySuite struct {
+	suite.Suite
+	ctx    context.Context
+	server *grpc.Server
+	svc    *orderService
+	cfg    *config.Config
+}
+
+func (suite *BatchOrderDataRepositorySuite) SetupTest() {
+	suite.ctx = context.Background()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	suite.Require().NoError(err)
+
+	suite.svc = &orderService{}
+	suite.server = grpc.NewServer()
+	pb.RegisterOrderServiceServer(suite.server, suite.svc)
+	go func() {
+		_ = suite.server.Serve(lis)
+	}()
+
+	suite.cfg = &config.Config{}
+	suite.cfg.Integration.OrderDataService.URL = lis.Addr().String()
+	suite.cfg.Integration.OrderDataService.OrderDataServiceRetryWaitTime = time.Second
+	suite.cfg.CircuitBreaker.MaxFailures = 5
+	suite.cfg.QueueOrderEvents.BatchSize = 3
+	suite.cfg.QueueOrderEvents.BatchWindow = 100 * time.Millisecond
+}
+
+func (suite *BatchOrderDataRepositorySuite) TearDownTest() {
+	suite.server.Stop()
+}
+
+func (suite *BatchOrderDataRepositorySuite) save(repo *repository.BatchOrderDataRepository,
+	ids ...string) ([]*pb.OrderResponse, []error) {
+	resps := make([]*pb.OrderResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			resps[i], errs[i] = repo.Save(suite.ctx, &pb.OrderRequest{DeliverymanId: id})
+		}(i, id)
+	}
+	wg.Wait()
+
+	return resps, errs
+}
+
+func (suite *BatchOrderDataRepositorySuite) TestSaveFlushesFullBatch() {
+	suite.cfg.QueueOrderEvents.BatchWindow = time.Minute
+	repo := repository.NewBatchOrderDataRepository(suite.cfg, repository.NewOrderDataRepository(suite.cfg))
+
+	resps, errs := suite.save(repo, "a", "invalid", "c")
+
+	suite.Equal([]int{3}, suite.svc.sizes())
+	suite.NoError(errs[0])
+	suite.Equal("id-a", resps[0].GetId())
+	suite.Equal(codes.InvalidArgument, status.Code(errs[1]))
+	suite.NoError(errs[2])
+	suite.Equal("id-c", resps[2].GetId())
+}
+
+func (suite *BatchOrderDataRepositorySuite) TestSaveFlushesAfterWindow() {
+	repo := repository.NewBatchOrderDataRepository(suite.cfg, repository.NewOrderDataRepository(suite.cfg))
+
+	start := time.Now()
+	resps, errs := suite.save(repo, "a", "b")
+
+	suite.GreaterOrEqual(time.Since(start), suite.cfg.QueueOrderEvents.BatchWindow)
+	suite.Equal([]int{2}, suite.svc.sizes())
+	for i, id := range []string{"a", "b"} {
+		suite.NoError(errs[i])
+		suite.Equal(fmt.Sprintf("id-%s", id), resps[i].GetId())
+	}
+}
+
+func (suite *BatchOrderDataRepositorySuite) TestSaveFailsEveryOrderOfBatch() {
+	suite.server.Stop()
+	repo := repository.NewBatchOrderDataRepository(suite.cfg, repository.NewOrderDataRepository(suite.cfg))
+
+	_, errs := suite.save(repo, "a", "b", "c")
+
+	for _, err := range errs {
+		suite.Error(err)
+	}
+}
+
+func (suite *BatchOrderDataRepositorySuite) TestExportOrdersStreams() {
+	repo := repository.NewOrderDataRepository(suite.cfg)
+
+	var ids []string
+	err := repo.ExportOrders(suite.ctx, &pb.ExportOrdersRequest{DeliverymanId: "a"}, func(order *pb.Order) error {
+		ids = append(ids, order.GetId())
+		return nil
+	})
+
+	suite.NoError(err)
+	suite.Equal([]string{"a", "b", "c"}, ids)
+}
+
+func (suite *BatchOrderDataRepositorySuite) TestExportOrdersStopsOnError() {
+	repo := repository.NewOrderDataRepository(suite.cfg)
+
+	var calls int
+	err := repo.ExportOrders(suite.ctx, &pb.ExportOrdersRequest{DeliverymanId: "a"}, func(_ *pb.Order) error {
+		calls++
+		return errors.New("client gone")
+	})
+
+	suite.EqualError(err, "client gone")
+	suite.Equal(1, calls)
+}
+
+func TestBatchOrderDataRepositorySuite(t *testing.T) {
+	suite.Run(t, new(BatchOrderDataRepositorySuite))
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/orderdataservice"
 
@@ -35,6 +37,22 @@ func (r *OrderDataRepository) Save(ctx context.Context, req *pb.OrderRequest) (*
 	return client.Save(ctx, req)
 }
 
+func (r *OrderDataRepository) SaveBatch(ctx context.Context, req *pb.SaveBatchRequest) (*pb.SaveBatchResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := orderdataservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration saveBatch: %+v", err)
+		return nil, fmt.Errorf("err while integration saveBatch: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewOrderServiceClient(conn)
+
+	return client.SaveBatch(ctx, req)
+}
+
 func (r *OrderDataRepository) GetAllOrder(ctx context.Context,
 	req *pb.GetOrderServiceAllOrderRequest) (*pb.GetAllOrderResponse, error) {
 	log := logger.FromContext(ctx)
@@ -50,3 +68,74 @@ func (r *OrderDataRepository) GetAllOrder(ctx context.Context,
 
 	return client.GetAllOrder(ctx, req)
 }
+
+func (r *OrderDataRepository) PseudonymizeOrders(ctx context.Context,
+	req *pb.PseudonymizeOrdersRequest) (*pb.PseudonymizeOrdersResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := orderdataservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration pseudonymizeOrders: %+v", err)
+		return nil, fmt.Errorf("err while integration pseudonymizeOrders: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewOrderServiceClient(conn)
+
+	return client.PseudonymizeOrders(ctx, req)
+}
+
+func (r *OrderDataRepository) FlagOrders(ctx context.Context,
+	req *pb.FlagOrdersRequest) (*pb.FlagOrdersResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := orderdataservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration flagOrders: %+v", err)
+		return nil, fmt.Errorf("err while integration flagOrders: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewOrderServiceClient(conn)
+
+	return client.FlagOrders(ctx, req)
+}
+
+// ExportOrders calls fn for every order received from the stream.
+func (r *OrderDataRepository) ExportOrders(ctx context.Context,
+	req *pb.ExportOrdersRequest, fn func(*pb.Order) error) error {
+	log := logger.FromContext(ctx)
+
+	conn, err := orderdataservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration exportOrders: %+v", err)
+		return fmt.Errorf("err while integration exportOrders: %w", err)
+	}
+
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	client := pb.NewOrderServiceClient(conn)
+
+	stream, err := client.ExportOrders(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		order, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(order); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package readiness
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	StatusReady        = "ready"
+	StatusNotReady     = "not-ready"
+	StatusShuttingDown = "shutting-down"
+)
+
+type Response struct {
+	Status string          `json:"status"`
+	Checks map[string]bool `json:"checks,omitempty"`
+}
+
+// Readiness aggregates the components that must be up before the service
+// receives traffic, it reports not ready as soon as the shutdown starts.
+type Readiness struct {
+	mu           sync.RWMutex
+	checks       map[string]func() bool
+	shuttingDown atomic.Bool
+}
+
+func New() *Readiness {
+	return &Readiness{
+		checks: make(map[string]func() bool),
+	}
+}
+
+func (r *Readiness) Register(name string, check func() bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks[name] = check
+}
+
+func (r *Readiness) Shutdown() {
+	r.shuttingDown.Store(true)
+}
+
+func (r *Readiness) Status() *Response {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	resp := &Response{
+		Status: StatusReady,
+		Checks: make(map[string]bool, len(r.checks)),
+	}
+	for name, check := range r.checks {
+		ok := check()
+		resp.Checks[name] = ok
+		if !ok {
+			resp.Status = StatusNotReady
+		}
+	}
+
+	if r.shuttingDown.Load() {
+		resp.Status = StatusShuttingDown
+	}
+
+	return resp
+}
+
+func (r *Readiness) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	resp := r.Status()
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Status != StatusReady {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
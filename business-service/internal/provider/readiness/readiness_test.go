@@ -0,0 +1,56 @@
+package readiness_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/readiness"
+)
+
+func TestReadiness(t *testing.T) {
+	subscribed := false
+	ready := readiness.New()
+	ready.Register("business-service-user-events", func() bool { return subscribed })
+
+	tests := []struct {
+		name       string
+		prepare    func()
+		wantStatus string
+		wantCode   int
+	}{
+		{
+			name:       "not ready while subscription is starting",
+			prepare:    func() {},
+			wantStatus: readiness.StatusNotReady,
+			wantCode:   http.StatusServiceUnavailable,
+		},
+		{
+			name:       "ready when all checks pass",
+			prepare:    func() { subscribed = true },
+			wantStatus: readiness.StatusReady,
+			wantCode:   http.StatusOK,
+		},
+		{
+			name:       "shutting down",
+			prepare:    ready.Shutdown,
+			wantStatus: readiness.StatusShuttingDown,
+			wantCode:   http.StatusServiceUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+
+			rec := httptest.NewRecorder()
+			ready.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("ServeHTTP() code = %d, want %d", rec.Code, tt.wantCode)
+			}
+			if got := ready.Status().Status; got != tt.wantStatus {
+				t.Errorf("Status() = %s, want %s", got, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package registrationstore_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/user"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/registrationstore"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	email = "maria@gmail.com"
+	owner = "8a1d6f2e-3c4b-4f0e-9a7d-5b2c1e0f9d3a"
+)
+
+type RegistrationStoreSuite struct {
+	suite.Suite
+	ctx         context.Context
+	redisServer *miniredis.Miniredis
+	repo        *registrationstore.Repository
+	cfg         *config.Config
+}
+
+func (suite *RegistrationStoreSuite) SetupTest() {
+	suite.ctx = context.Background()
+
+	var err error
+	suite.redisServer, err = miniredis.Run()
+	suite.Require().NoError(err)
+
+	suite.cfg = &config.Config{}
+	suite.cfg.Registration.Lease = time.Minute
+	suite.cfg.Registration.BatchSize = 10
+	suite.cfg.Registration.Retention = time.Hour
+	suite.cfg.Registration.AttemptLease = time.Minute
+
+	client := redis.NewClient(&redis.Options{Addr: suite.redisServer.Addr()})
+	suite.repo = registrationstore.NewRepository(client, suite.cfg)
+}
+
+func (suite *RegistrationStoreSuite) TearDownTest() {
+	suite.redisServer.Close()
+}
+
+func (suite *RegistrationStoreSuite) compensating() *user.Registration {
+	r := user.NewRegistration(email, owner)
+	created, err := suite.repo.Create(suite.ctx, r)
+	suite.Require().NoError(err)
+	suite.Require().True(created)
+
+	r.Status = user.RegistrationCompensating
+	r.AccountID = "46c77402-ba50-4b48-9bd9-1c4f97e36565"
+	suite.Require().NoError(suite.repo.Update(suite.ctx, r))
+	suite.Require().NoError(suite.repo.Enqueue(suite.ctx, r.Key))
+	return r
+}
+
+func (suite *RegistrationStoreSuite) TestCreateOnce() {
+	suite.compensating()
+
+	created, err := suite.repo.Create(suite.ctx, user.NewRegistration(email, owner))
+	suite.NoError(err)
+	suite.False(created)
+
+	_, err = suite.repo.Find(suite.ctx, user.RegistrationKey("joao@gmail.com"))
+	suite.ErrorIs(err, shared.ErrRegistrationNotFound)
+}
+
+func (suite *RegistrationStoreSuite) TestReplaceOnlyOnce() {
+	r := user.NewRegistration(email, owner)
+	created, err := suite.repo.Create(suite.ctx, r)
+	suite.Require().NoError(err)
+	suite.Require().True(created)
+	suite.True(r.Leased(time.Now()))
+
+	prev, err := suite.repo.Find(suite.ctx, r.Key)
+	suite.Require().NoError(err)
+
+	first, second := *prev, *prev
+	replaced, err := suite.repo.Replace(suite.ctx, prev, &first)
+	suite.NoError(err)
+	suite.True(replaced)
+
+	replaced, err = suite.repo.Replace(suite.ctx, prev, &second)
+	suite.NoError(err)
+	suite.False(replaced)
+
+	replaced, err = suite.repo.Replace(suite.ctx, nil, user.NewRegistration(email, owner))
+	suite.NoError(err)
+	suite.False(replaced)
+}
+
+func (suite *RegistrationStoreSuite) TestDelete() {
+	r := suite.compensating()
+
+	suite.NoError(suite.repo.Delete(suite.ctx, r.Key))
+
+	_, err := suite.repo.Find(suite.ctx, r.Key)
+	suite.ErrorIs(err, shared.ErrRegistrationNotFound)
+	suite.False(suite.redisServer.Exists("registrations:compensating"))
+}
+
+func (suite *RegistrationStoreSuite) TestClaimLeasesCompensations() {
+	suite.compensating()
+	now := time.Now()
+
+	registrations, err := suite.repo.Claim(suite.ctx, now, time.Minute, 10)
+	suite.NoError(err)
+	suite.Len(registrations, 1)
+
+	registrations, err = suite.repo.Claim(suite.ctx, now, time.Minute, 10)
+	suite.NoError(err)
+	suite.Empty(registrations)
+
+	registrations, err = suite.repo.Claim(suite.ctx, now.Add(2*time.Minute), time.Minute, 10)
+	suite.NoError(err)
+	suite.Len(registrations, 1)
+}
+
+func (suite *RegistrationStoreSuite) TestRunDueRetriesFailedCompensation() {
+	suite.cfg.Registration.Lease = 0
+	r := suite.compensating()
+
+	var runs int
+	worker := registrationstore.NewWorker(suite.repo, suite.cfg, func(ctx context.Context, r *user.Registration) error {
+		runs++
+		if runs == 1 {
+			return errors.New("auth-service unavailable")
+		}
+		r.Status = user.RegistrationCompensated
+		return suite.repo.Complete(ctx, r)
+	})
+
+	worker.RunDue(suite.ctx)
+	worker.RunDue(suite.ctx)
+	worker.RunDue(suite.ctx)
+	suite.Equal(2, runs)
+
+	r, err := suite.repo.Find(suite.ctx, r.Key)
+	suite.NoError(err)
+	suite.Equal(user.RegistrationCompensated, r.Status)
+	suite.False(suite.redisServer.Exists("registrations:compensating"))
+	suite.Equal(time.Hour, suite.redisServer.TTL("registration:"+r.Key))
+}
+
+func TestRegistrationStoreSuite(t *testing.T) {
+	suite.Run(t, new(RegistrationStoreSuite))
+}
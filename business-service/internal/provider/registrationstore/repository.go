@@ -0,0 +1,208 @@
+package registrationstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/user"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	compensatingKey    = "registrations:compensating"
+	registrationKeyFmt = "registration:%s"
+)
+
+// claimScript moves the due compensations lease ahead so only one replica
+// runs them. A compensation whose lease expires, e.g. after a crash, is
+// claimed again.
+var claimScript = redis.NewScript(`
+local keys = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[3])
+for _, key in ipairs(keys) do
+	redis.call('ZADD', KEYS[1], ARGV[2], key)
+end
+return keys
+`)
+
+// Repository stores the registrations in Redis: a key per registration,
+// expired after the retention period once finished, and a sorted set of the
+// ones to compensate by due time.
+type Repository struct {
+	client *redis.Client
+	cfg    *config.Config
+}
+
+func NewRepository(client *redis.Client, cfg *config.Config) *Repository {
+	return &Repository{
+		client: client,
+		cfg:    cfg,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, reg *user.Registration) (bool, error) {
+	reg.LeaseUntil = r.leaseUntil()
+
+	val, err := json.Marshal(reg)
+	if err != nil {
+		return false, fmt.Errorf("fail json.Marshal err: %w", err)
+	}
+
+	return r.client.SetNX(ctx, registrationKey(reg.Key), val, 0).Result()
+}
+
+// Replace compares the stored registration with prev and swaps it for reg
+// in a transaction, so only one of the requests reading prev takes it over.
+func (r *Repository) Replace(ctx context.Context, prev, reg *user.Registration) (bool, error) {
+	key := registrationKey(reg.Key)
+	reg.LeaseUntil = r.leaseUntil()
+
+	val, err := json.Marshal(reg)
+	if err != nil {
+		return false, fmt.Errorf("fail json.Marshal err: %w", err)
+	}
+
+	var replaced bool
+	err = r.client.Watch(ctx, func(tx *redis.Tx) error {
+		cur, err := tx.Get(ctx, key).Bytes()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return err
+		}
+
+		if !same(cur, prev) {
+			return nil
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, val, 0)
+			return nil
+		})
+		if errors.Is(err, redis.TxFailedErr) {
+			return nil
+		}
+		replaced = err == nil
+		return err
+	}, key)
+
+	return replaced, err
+}
+
+func (r *Repository) Find(ctx context.Context, key string) (*user.Registration, error) {
+	val, err := r.client.Get(ctx, registrationKey(key)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, shared.ErrRegistrationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var reg user.Registration
+	if err := json.Unmarshal(val, &reg); err != nil {
+		return nil, fmt.Errorf("fail json.Unmarshal err: %w", err)
+	}
+	return &reg, nil
+}
+
+func (r *Repository) Enqueue(ctx context.Context, key string) error {
+	return r.client.ZAddNX(ctx, compensatingKey, redis.Z{Score: score(time.Now()), Member: key}).Err()
+}
+
+func (r *Repository) Update(ctx context.Context, reg *user.Registration) error {
+	val, err := json.Marshal(reg)
+	if err != nil {
+		return fmt.Errorf("fail json.Marshal err: %w", err)
+	}
+	return r.client.Set(ctx, registrationKey(reg.Key), val, 0).Err()
+}
+
+// Complete saves a finished registration for the retention period and
+// removes it from the ones to compensate.
+func (r *Repository) Complete(ctx context.Context, reg *user.Registration) error {
+	val, err := json.Marshal(reg)
+	if err != nil {
+		return fmt.Errorf("fail json.Marshal err: %w", err)
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, registrationKey(reg.Key), val, r.cfg.Registration.Retention)
+		pipe.ZRem(ctx, compensatingKey, reg.Key)
+		return nil
+	})
+	return err
+}
+
+// Delete removes the registration of key, e.g. on the erasure of the user.
+func (r *Repository) Delete(ctx context.Context, key string) error {
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, registrationKey(key))
+		pipe.ZRem(ctx, compensatingKey, key)
+		return nil
+	})
+	return err
+}
+
+// Claim returns up to limit registrations to compensate due at now and
+// leases them until now+lease.
+func (r *Repository) Claim(ctx context.Context, now time.Time, lease time.Duration,
+	limit int64) ([]*user.Registration, error) {
+	keys, err := claimScript.Run(ctx, r.client, []string{compensatingKey},
+		score(now), score(now.Add(lease)), limit).StringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("fail claim registrations err: %w", err)
+	}
+
+	registrations := make([]*user.Registration, 0, len(keys))
+	for _, key := range keys {
+		reg, err := r.Find(ctx, key)
+		if errors.Is(err, shared.ErrRegistrationNotFound) {
+			r.client.ZRem(ctx, compensatingKey, key)
+			continue
+		}
+		if err != nil {
+			return registrations, err
+		}
+		if reg.Status != user.RegistrationCompensating {
+			r.client.ZRem(ctx, compensatingKey, key)
+			continue
+		}
+		registrations = append(registrations, reg)
+	}
+
+	return registrations, nil
+}
+
+func (r *Repository) leaseUntil() time.Time {
+	return time.Now().UTC().Add(r.cfg.Registration.AttemptLease)
+}
+
+// same reports whether the stored registration cur is still prev. Every
+// change of a registration moves its lease, status, account or attempts.
+func same(cur []byte, prev *user.Registration) bool {
+	if cur == nil || prev == nil {
+		return cur == nil && prev == nil
+	}
+
+	var reg user.Registration
+	if err := json.Unmarshal(cur, &reg); err != nil {
+		return false
+	}
+
+	return reg.Owner == prev.Owner &&
+		reg.LeaseUntil.Equal(prev.LeaseUntil) &&
+		reg.Status == prev.Status &&
+		reg.AccountID == prev.AccountID &&
+		reg.Attempts == prev.Attempts &&
+		len(reg.Steps) == len(prev.Steps)
+}
+
+func score(t time.Time) float64 {
+	return float64(t.UnixMilli())
+}
+
+func registrationKey(key string) string {
+	return fmt.Sprintf(registrationKeyFmt, key)
+}
@@ -0,0 +1,66 @@
+package registrationstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/user"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+)
+
+// Worker polls the repository and compensates the failed registrations.
+type Worker struct {
+	repo       *Repository
+	cfg        *config.Config
+	compensate func(ctx context.Context, r *user.Registration) error
+}
+
+func NewWorker(repo *Repository, cfg *config.Config,
+	compensate func(ctx context.Context, r *user.Registration) error) *Worker {
+	return &Worker{
+		repo:       repo,
+		cfg:        cfg,
+		compensate: compensate,
+	}
+}
+
+func (w *Worker) Start(ctx context.Context) {
+	log := logger.FromContext(ctx)
+
+	log.Infof("Registration worker has been started.... poll-interval: %s", w.cfg.Registration.PollInterval)
+
+	ticker := time.NewTicker(w.cfg.Registration.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("context cancelled, stopping Registration worker...")
+			return
+		case <-ticker.C:
+			w.RunDue(ctx)
+		}
+	}
+}
+
+// RunDue compensates the registrations due now. A failed compensation is
+// kept and runs again when its lease expires, so no account is left behind.
+func (w *Worker) RunDue(ctx context.Context) {
+	log := logger.FromContext(ctx)
+
+	registrations, err := w.repo.Claim(ctx, time.Now(), w.cfg.Registration.Lease, w.cfg.Registration.BatchSize)
+	if err != nil {
+		log.Errorf("error claiming registrations: %v", err)
+	}
+
+	// the claimed registrations are leased, finish them even when shutting down.
+	ctx = context.WithoutCancel(ctx)
+
+	for _, r := range registrations {
+		if err := w.compensate(ctx, r); err != nil {
+			log.Errorf("error compensating registration of account id: %s attempt %d, err: %v",
+				r.AccountID, r.Attempts, err)
+		}
+	}
+}
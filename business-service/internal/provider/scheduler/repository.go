@@ -0,0 +1,205 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/order"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	dueKey        = "scheduled-orders"
+	orderKeyFmt   = "scheduled-order:%s"
+	userOrdersFmt = "scheduled-orders:user:%s"
+)
+
+// claimScript moves the due orders lease ahead so only one replica fires
+// them. An order whose lease expires, e.g. after a crash, is claimed again.
+var claimScript = redis.NewScript(`
+local ids = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[3])
+for _, id in ipairs(ids) do
+	redis.call('ZADD', KEYS[1], ARGV[2], id)
+end
+return ids
+`)
+
+// Repository stores scheduled orders in Redis: a sorted set by due time, a
+// key per order and a set of order ids per user.
+type Repository struct {
+	client *redis.Client
+}
+
+func NewRepository(client *redis.Client) *Repository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) Schedule(ctx context.Context, scheduled *order.ScheduledOrder) error {
+	val, err := json.Marshal(scheduled)
+	if err != nil {
+		return fmt.Errorf("fail json.Marshal err: %w", err)
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, orderKey(scheduled.ID), val, 0)
+		pipe.SAdd(ctx, userOrdersKey(scheduled.UserID), scheduled.ID)
+		pipe.ZAdd(ctx, dueKey, redis.Z{Score: score(scheduled.ScheduledFor), Member: scheduled.ID})
+		return nil
+	})
+	return err
+}
+
+func (r *Repository) FindByUserID(ctx context.Context, userID string) ([]*order.ScheduledOrder, error) {
+	ids, err := r.client.SMembers(ctx, userOrdersKey(userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*order.ScheduledOrder, 0, len(ids))
+	for _, id := range ids {
+		scheduled, err := r.find(ctx, id)
+		if errors.Is(err, shared.ErrScheduledOrderNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, scheduled)
+	}
+
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].ScheduledFor.Before(orders[j].ScheduledFor)
+	})
+
+	return orders, nil
+}
+
+// Cancel removes a scheduled order of userID as long as it has not fired, a
+// failed order is removed too once the user saw it.
+func (r *Repository) Cancel(ctx context.Context, userID, id string) error {
+	scheduled, err := r.find(ctx, id)
+	if err != nil {
+		return err
+	}
+	if scheduled.UserID != userID {
+		return shared.ErrScheduledOrderNotFound
+	}
+	if scheduled.Failed() {
+		return r.delete(ctx, scheduled)
+	}
+	if !time.Now().Before(scheduled.ScheduledFor) {
+		return shared.ErrScheduledOrderFired
+	}
+
+	removed, err := r.client.ZRem(ctx, dueKey, id).Result()
+	if err != nil {
+		return err
+	}
+	if removed == 0 {
+		return shared.ErrScheduledOrderFired
+	}
+
+	return r.delete(ctx, scheduled)
+}
+
+// Claim returns up to limit orders due at now and leases them until
+// now+lease.
+func (r *Repository) Claim(ctx context.Context, now time.Time, lease time.Duration,
+	limit int64) ([]*order.ScheduledOrder, error) {
+	ids, err := claimScript.Run(ctx, r.client, []string{dueKey},
+		score(now), score(now.Add(lease)), limit).StringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("fail claim scheduled orders err: %w", err)
+	}
+
+	orders := make([]*order.ScheduledOrder, 0, len(ids))
+	for _, id := range ids {
+		scheduled, err := r.find(ctx, id)
+		if errors.Is(err, shared.ErrScheduledOrderNotFound) {
+			r.client.ZRem(ctx, dueKey, id)
+			continue
+		}
+		if err != nil {
+			return orders, err
+		}
+		orders = append(orders, scheduled)
+	}
+
+	return orders, nil
+}
+
+// Complete removes an order that fired.
+func (r *Repository) Complete(ctx context.Context, scheduled *order.ScheduledOrder) error {
+	if err := r.client.ZRem(ctx, dueKey, scheduled.ID).Err(); err != nil {
+		return err
+	}
+	return r.delete(ctx, scheduled)
+}
+
+// Fail stops firing an order that ran out of attempts and keeps it, with
+// its payload, in the orders of the user.
+func (r *Repository) Fail(ctx context.Context, scheduled *order.ScheduledOrder) error {
+	val, err := json.Marshal(scheduled)
+	if err != nil {
+		return fmt.Errorf("fail json.Marshal err: %w", err)
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, orderKey(scheduled.ID), val, 0)
+		pipe.ZRem(ctx, dueKey, scheduled.ID)
+		return nil
+	})
+	return err
+}
+
+// Update saves the attempts of a claimed order, it fires again when the
+// lease expires.
+func (r *Repository) Update(ctx context.Context, scheduled *order.ScheduledOrder) error {
+	val, err := json.Marshal(scheduled)
+	if err != nil {
+		return fmt.Errorf("fail json.Marshal err: %w", err)
+	}
+	return r.client.Set(ctx, orderKey(scheduled.ID), val, 0).Err()
+}
+
+func (r *Repository) find(ctx context.Context, id string) (*order.ScheduledOrder, error) {
+	val, err := r.client.Get(ctx, orderKey(id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, shared.ErrScheduledOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var scheduled order.ScheduledOrder
+	if err := json.Unmarshal(val, &scheduled); err != nil {
+		return nil, fmt.Errorf("fail json.Unmarshal err: %w", err)
+	}
+	return &scheduled, nil
+}
+
+func (r *Repository) delete(ctx context.Context, scheduled *order.ScheduledOrder) error {
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, orderKey(scheduled.ID))
+		pipe.SRem(ctx, userOrdersKey(scheduled.UserID), scheduled.ID)
+		return nil
+	})
+	return err
+}
+
+func score(t time.Time) float64 {
+	return float64(t.UnixMilli())
+}
+
+func orderKey(id string) string {
+	return fmt.Sprintf(orderKeyFmt, id)
+}
+
+func userOrdersKey(userID string) string {
+	return fmt.Sprintf(userOrdersFmt, userID)
+}
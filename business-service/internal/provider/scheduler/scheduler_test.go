@@ -0,0 +1,171 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/order"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/scheduler"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+type SchedulerSuite struct {
+	suite.Suite
+	ctx         context.Context
+	redisServer *miniredis.Miniredis
+	repo        *scheduler.Repository
+	cfg         *config.Config
+}
+
+func (suite *SchedulerSuite) SetupTest() {
+	suite.ctx = context.Background()
+
+	var err error
+	suite.redisServer, err = miniredis.Run()
+	suite.Require().NoError(err)
+
+	suite.cfg = &config.Config{}
+	suite.cfg.Scheduler.Lease = time.Minute
+	suite.cfg.Scheduler.BatchSize = 100
+	suite.cfg.Scheduler.MaxAttempts = 2
+
+	client := redis.NewClient(&redis.Options{Addr: suite.redisServer.Addr()})
+	suite.repo = scheduler.NewRepository(client)
+}
+
+func (suite *SchedulerSuite) TearDownTest() {
+	suite.redisServer.Close()
+}
+
+func (suite *SchedulerSuite) schedule(id string, scheduledFor time.Time) *order.ScheduledOrder {
+	scheduled := &order.ScheduledOrder{
+		ID:           id,
+		UserID:       "410bb5b0-429f-46b1-8621-b7da101b1e28",
+		ScheduledFor: scheduledFor,
+		CreatedAt:    time.Now(),
+	}
+	suite.Require().NoError(suite.repo.Schedule(suite.ctx, scheduled))
+	return scheduled
+}
+
+func (suite *SchedulerSuite) TestFindByUserIDSortedByScheduledFor() {
+	now := time.Now()
+	suite.schedule("b", now.Add(2*time.Hour))
+	suite.schedule("a", now.Add(time.Hour))
+
+	orders, err := suite.repo.FindByUserID(suite.ctx, "410bb5b0-429f-46b1-8621-b7da101b1e28")
+	suite.NoError(err)
+	suite.Len(orders, 2)
+	suite.Equal("a", orders[0].ID)
+	suite.Equal("b", orders[1].ID)
+}
+
+func (suite *SchedulerSuite) TestCancel() {
+	suite.schedule("a", time.Now().Add(time.Hour))
+
+	err := suite.repo.Cancel(suite.ctx, "another-user", "a")
+	suite.ErrorIs(err, shared.ErrScheduledOrderNotFound)
+
+	suite.NoError(suite.repo.Cancel(suite.ctx, "410bb5b0-429f-46b1-8621-b7da101b1e28", "a"))
+
+	err = suite.repo.Cancel(suite.ctx, "410bb5b0-429f-46b1-8621-b7da101b1e28", "a")
+	suite.ErrorIs(err, shared.ErrScheduledOrderNotFound)
+}
+
+func (suite *SchedulerSuite) TestCancelFired() {
+	suite.schedule("a", time.Now().Add(-time.Second))
+
+	err := suite.repo.Cancel(suite.ctx, "410bb5b0-429f-46b1-8621-b7da101b1e28", "a")
+	suite.ErrorIs(err, shared.ErrScheduledOrderFired)
+}
+
+func (suite *SchedulerSuite) TestClaimLeasesDueOrders() {
+	now := time.Now()
+	suite.schedule("due", now.Add(-time.Second))
+	suite.schedule("later", now.Add(time.Hour))
+
+	orders, err := suite.repo.Claim(suite.ctx, now, time.Minute, 100)
+	suite.NoError(err)
+	suite.Len(orders, 1)
+	suite.Equal("due", orders[0].ID)
+
+	orders, err = suite.repo.Claim(suite.ctx, now, time.Minute, 100)
+	suite.NoError(err)
+	suite.Empty(orders)
+
+	orders, err = suite.repo.Claim(suite.ctx, now.Add(2*time.Minute), time.Minute, 100)
+	suite.NoError(err)
+	suite.Len(orders, 1)
+	suite.Equal("due", orders[0].ID)
+}
+
+func (suite *SchedulerSuite) TestFireDueCompletes() {
+	suite.schedule("due", time.Now().Add(-time.Second))
+
+	var fired []string
+	worker := scheduler.NewWorker(suite.repo, suite.cfg, func(_ context.Context, scheduled *order.ScheduledOrder) error {
+		fired = append(fired, scheduled.ID)
+		return nil
+	})
+
+	worker.FireDue(suite.ctx)
+	suite.Equal([]string{"due"}, fired)
+
+	orders, err := suite.repo.FindByUserID(suite.ctx, "410bb5b0-429f-46b1-8621-b7da101b1e28")
+	suite.NoError(err)
+	suite.Empty(orders)
+}
+
+func (suite *SchedulerSuite) TestFireDueFailsAfterMaxAttempts() {
+	suite.cfg.Scheduler.Lease = 0
+	suite.schedule("due", time.Now().Add(-time.Second))
+
+	var attempts int
+	worker := scheduler.NewWorker(suite.repo, suite.cfg, func(_ context.Context, _ *order.ScheduledOrder) error {
+		attempts++
+		return errors.New("order-data-service unavailable")
+	})
+
+	worker.FireDue(suite.ctx)
+	orders, err := suite.repo.FindByUserID(suite.ctx, "410bb5b0-429f-46b1-8621-b7da101b1e28")
+	suite.NoError(err)
+	suite.Len(orders, 1)
+	suite.Equal(1, orders[0].Attempts)
+
+	worker.FireDue(suite.ctx)
+	worker.FireDue(suite.ctx)
+	suite.Equal(2, attempts)
+
+	orders, err = suite.repo.FindByUserID(suite.ctx, "410bb5b0-429f-46b1-8621-b7da101b1e28")
+	suite.NoError(err)
+	suite.Len(orders, 1)
+	suite.True(orders[0].Failed())
+	suite.Equal("order-data-service unavailable", orders[0].FailureReason)
+	suite.False(orders[0].FailedAt.IsZero())
+}
+
+func (suite *SchedulerSuite) TestCancelFailed() {
+	scheduled := suite.schedule("due", time.Now().Add(-time.Second))
+	scheduled.Status = order.ScheduledOrderFailed
+	suite.Require().NoError(suite.repo.Fail(suite.ctx, scheduled))
+
+	orders, err := suite.repo.Claim(suite.ctx, time.Now(), time.Minute, 100)
+	suite.NoError(err)
+	suite.Empty(orders)
+
+	suite.NoError(suite.repo.Cancel(suite.ctx, "410bb5b0-429f-46b1-8621-b7da101b1e28", "due"))
+
+	orders, err = suite.repo.FindByUserID(suite.ctx, "410bb5b0-429f-46b1-8621-b7da101b1e28")
+	suite.NoError(err)
+	suite.Empty(orders)
+}
+
+func TestSchedulerSuite(t *testing.T) {
+	suite.Run(t, new(SchedulerSuite))
+}
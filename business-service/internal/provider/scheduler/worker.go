@@ -0,0 +1,87 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/domain/order"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+)
+
+// Worker polls the repository and fires the due orders.
+type Worker struct {
+	repo *Repository
+	cfg  *config.Config
+	fire func(ctx context.Context, scheduled *order.ScheduledOrder) error
+}
+
+func NewWorker(repo *Repository, cfg *config.Config,
+	fire func(ctx context.Context, scheduled *order.ScheduledOrder) error) *Worker {
+	return &Worker{
+		repo: repo,
+		cfg:  cfg,
+		fire: fire,
+	}
+}
+
+func (w *Worker) Start(ctx context.Context) {
+	log := logger.FromContext(ctx)
+
+	log.Infof("Scheduler has been started.... poll-interval: %s", w.cfg.Scheduler.PollInterval)
+
+	ticker := time.NewTicker(w.cfg.Scheduler.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("context cancelled, stopping Scheduler...")
+			return
+		case <-ticker.C:
+			w.FireDue(ctx)
+		}
+	}
+}
+
+// FireDue fires the orders due now. A failed order is fired again when its
+// lease expires, up to MaxAttempts, then it is kept as FAILED for the user.
+func (w *Worker) FireDue(ctx context.Context) {
+	log := logger.FromContext(ctx)
+
+	orders, err := w.repo.Claim(ctx, time.Now(), w.cfg.Scheduler.Lease, w.cfg.Scheduler.BatchSize)
+	if err != nil {
+		log.Errorf("error claiming scheduled orders: %v", err)
+	}
+
+	// the claimed orders are leased, finish them even when shutting down.
+	ctx = context.WithoutCancel(ctx)
+
+	for _, scheduled := range orders {
+		err := w.fire(ctx, scheduled)
+		if err == nil {
+			log.Infof("scheduled order id: %s fired", scheduled.ID)
+			if err := w.repo.Complete(ctx, scheduled); err != nil {
+				log.Errorf("error completing scheduled order id: %s, err: %v", scheduled.ID, err)
+			}
+			continue
+		}
+
+		scheduled.Attempts++
+		if scheduled.Attempts >= w.cfg.Scheduler.MaxAttempts {
+			log.Errorf("scheduled order id: %s failed after %d attempts, err: %v", scheduled.ID, scheduled.Attempts, err)
+			scheduled.Status = order.ScheduledOrderFailed
+			scheduled.FailedAt = time.Now().UTC()
+			scheduled.FailureReason = err.Error()
+			if err := w.repo.Fail(ctx, scheduled); err != nil {
+				log.Errorf("error failing scheduled order id: %s, err: %v", scheduled.ID, err)
+			}
+			continue
+		}
+
+		log.Errorf("error firing scheduled order id: %s attempt %d, err: %v", scheduled.ID, scheduled.Attempts, err)
+		if err := w.repo.Update(ctx, scheduled); err != nil {
+			log.Errorf("error updating scheduled order id: %s, err: %v", scheduled.ID, err)
+		}
+	}
+}
@@ -3,14 +3,53 @@ package subscribe
 import (
 	"context"
 
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/broker"
 	"github.com/lucasd-coder/fast-feet/business-service/internal/shared/queueoptions"
-	// revive
-	_ "gocloud.dev/pubsub/rabbitpubsub"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared/utils"
 
 	"gocloud.dev/pubsub"
 )
 
 func NewClient(ctx context.Context, opt *queueoptions.Options) (*pubsub.Subscription, error) {
-	client, err := pubsub.OpenSubscription(ctx, opt.QueueURL)
+	client, err := broker.OpenSubscription(ctx, opt.QueueURL)
 	return client, err
 }
+
+func NewDeadLetterClient(ctx context.Context, opt *queueoptions.Options) (*pubsub.Topic, error) {
+	topic, err := broker.OpenTopic(ctx, opt.DeadLetterURL)
+	return topic, err
+}
+
+func NewRetryClient(ctx context.Context, opt *queueoptions.Options) (*pubsub.Topic, error) {
+	topic, err := broker.OpenTopic(ctx, opt.RetryURL)
+	return topic, err
+}
+
+// DeclareQueue declares the priority queue of opt and the exchanges and
+// queues its retry and dead-letter topics publish to. It only applies to
+// RabbitMQ, the other brokers create topics on demand.
+func DeclareQueue(opt *queueoptions.Options) error {
+	if broker.Scheme(opt.QueueURL) != broker.SchemeRabbit {
+		return nil
+	}
+	queue := utils.ExtractQueueName(opt.QueueURL)
+
+	if opt.MaxPriority > 0 {
+		if err := broker.DeclarePriorityQueue(queue, opt.Exchange, opt.MaxPriority); err != nil {
+			return err
+		}
+	}
+
+	if broker.Scheme(opt.RetryURL) == broker.SchemeRabbit {
+		if err := broker.DeclareRetryQueues(queue, utils.ExtractQueueName(opt.RetryURL),
+			HeaderRetryAttempt, retryDelays(opt)); err != nil {
+			return err
+		}
+	}
+
+	if broker.Scheme(opt.DeadLetterURL) == broker.SchemeRabbit {
+		return broker.DeclareDeadLetterQueue(utils.ExtractQueueName(opt.DeadLetterURL))
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package subscribe
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/broker"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"go.opentelemetry.io/otel/trace"
+	"gocloud.dev/pubsub"
+)
+
+const (
+	HeaderDeadLetterError       = "x-dead-letter-error"
+	HeaderDeadLetterAttempts    = "x-dead-letter-attempts"
+	HeaderDeadLetterFirstSeen   = "x-dead-letter-first-seen"
+	HeaderDeadLetterTraceID     = "x-dead-letter-trace-id"
+	HeaderDeadLetterSourceQueue = "x-dead-letter-source-queue"
+	HeaderDeadLetterSourceTopic = "x-dead-letter-source-topic"
+	HeaderDeadLetterReason      = "x-dead-letter-reason"
+)
+
+const (
+	ReasonMaxRetriesExceeded = "max-retries-exceeded"
+	ReasonUnprocessable      = "unprocessable"
+	ReasonPanic              = "panic"
+	ReasonRetryFailed        = "retry-failed"
+)
+
+type failure struct {
+	reason    string
+	cause     error
+	attempts  int
+	firstSeen time.Time
+}
+
+// deadLetter publishes the original message with the failure metadata to the
+// dead-letter topic. Without a configured topic the message is only logged,
+// as it was before dead-letter queues were introduced.
+func (s *Subscription) deadLetter(ctx context.Context, msg *pubsub.Message, f *failure) error {
+	log := logger.FromContext(ctx)
+
+	s.metr.IncDeadLetters(f.reason, s.opt.QueueURL)
+
+	if s.deadLetterTopic == nil {
+		log.Errorf("no dead-letter topic configured for queueURL: %s, discarding message reason: %s, err: %v",
+			s.opt.QueueURL, f.reason, f.cause)
+		return nil
+	}
+
+	metadata := make(map[string]string, len(msg.Metadata)+7)
+	for k, v := range msg.Metadata {
+		metadata[k] = v
+	}
+
+	if _, ok := metadata[HeaderDeadLetterFirstSeen]; !ok {
+		metadata[HeaderDeadLetterFirstSeen] = f.firstSeen.UTC().Format(time.RFC3339Nano)
+	}
+	metadata[HeaderDeadLetterError] = f.cause.Error()
+	metadata[HeaderDeadLetterAttempts] = strconv.Itoa(f.attempts)
+	metadata[HeaderDeadLetterTraceID] = trace.SpanContextFromContext(ctx).TraceID().String()
+	metadata[HeaderDeadLetterSourceQueue] = s.opt.QueueURL
+	metadata[HeaderDeadLetterSourceTopic] = broker.TopicURL(s.opt.QueueURL, s.opt.Exchange)
+	metadata[HeaderDeadLetterReason] = f.reason
+
+	if err := s.deadLetterTopic.Send(ctx, &pubsub.Message{
+		Body:     msg.Body,
+		Metadata: metadata,
+	}); err != nil {
+		return fmt.Errorf("fail send dead-letter message for queueURL: %s err: %w", s.opt.DeadLetterURL, err)
+	}
+
+	log.Infof("message sent to dead-letter topic: %s, reason: %s", s.opt.DeadLetterURL, f.reason)
+
+	return nil
+}
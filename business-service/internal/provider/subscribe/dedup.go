@@ -0,0 +1,98 @@
+package subscribe
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared/queueoptions"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared/utils"
+	"github.com/lucasd-coder/fast-feet/pkg/envelope"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"github.com/lucasd-coder/fast-feet/pkg/monitor"
+)
+
+type Handler func(ctx context.Context, m []byte) error
+
+type Middleware func(next Handler) Handler
+
+type DedupStore interface {
+	SaveIfAbsent(ctx context.Context, key string, value string, ttl time.Duration) (bool, error)
+	Save(ctx context.Context, key string, value string, ttl time.Duration) error
+	Exists(ctx context.Context, key string) (bool, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// ErrMessageInProgress is returned for a message another delivery is still
+// processing, it is retried instead of dropped in case that delivery dies.
+var ErrMessageInProgress = errors.New("message is being processed by another delivery")
+
+// Deduplicate drops messages whose ID was already processed within DedupTTL.
+// While the handler runs the ID only holds a processing marker that expires
+// after DedupProcessingTTL, the processed marker is saved once the handler
+// succeeds. A delivery that dies mid-handler is therefore processed again when
+// redelivered. When the store is unavailable messages are processed anyway.
+func Deduplicate(store DedupStore, opt *queueoptions.Options, metr monitor.Metrics) Middleware {
+	queueName := utils.ExtractQueueName(opt.QueueURL)
+
+	return func(next Handler) Handler {
+		return func(ctx context.Context, m []byte) error {
+			log := logger.FromContext(ctx)
+			key := fmt.Sprintf("dedup:%s:%s", queueName, messageID(ctx, m))
+			processingKey := key + ":processing"
+			now := time.Now().UTC().Format(time.RFC3339Nano)
+
+			claimed, err := store.SaveIfAbsent(ctx, processingKey, now, opt.DedupProcessingTTL)
+			if err != nil {
+				log.Errorf("error checking duplicate for key: %s, processing anyway: %v", key, err)
+				return next(ctx, m)
+			}
+
+			if !claimed {
+				log.Infof("message in progress for key: %s, retrying later", key)
+				return ErrMessageInProgress
+			}
+
+			defer func() {
+				if err := store.Delete(context.WithoutCancel(ctx), processingKey); err != nil {
+					log.Errorf("error releasing processing key: %s, err: %v", processingKey, err)
+				}
+			}()
+
+			processed, err := store.Exists(ctx, key)
+			if err != nil {
+				log.Errorf("error checking duplicate for key: %s, processing anyway: %v", key, err)
+			}
+
+			if processed {
+				metr.IncDuplicates(opt.QueueURL)
+				log.Infof("duplicate message dropped for key: %s", key)
+				return nil
+			}
+
+			if err := next(ctx, m); err != nil {
+				return err
+			}
+
+			if err := store.Save(context.WithoutCancel(ctx), key, now, opt.DedupTTL); err != nil {
+				log.Errorf("error saving processed key: %s, err: %v", key, err)
+			}
+
+			return nil
+		}
+	}
+}
+
+// messageID is the envelope message ID, messages published before the
+// envelope existed fall back to the hash of their body.
+func messageID(ctx context.Context, m []byte) string {
+	if id := envelope.FromContext(ctx).ID; id != "" {
+		return id
+	}
+
+	sum := sha256.Sum256(m)
+	return hex.EncodeToString(sum[:])
+}
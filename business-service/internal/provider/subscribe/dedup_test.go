@@ -0,0 +1,162 @@
+package subscribe_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/cache"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/subscribe"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared/queueoptions"
+	"github.com/lucasd-coder/fast-feet/pkg/envelope"
+	"github.com/lucasd-coder/fast-feet/pkg/monitor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeduplicateSuite struct {
+	suite.Suite
+	ctx         context.Context
+	redisServer *miniredis.Miniredis
+	reg         *prometheus.Registry
+	dedup       subscribe.Middleware
+	calls       int
+}
+
+func (suite *DeduplicateSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.calls = 0
+
+	var err error
+	suite.redisServer, err = miniredis.Run()
+	suite.Require().NoError(err)
+
+	opt := &queueoptions.Options{
+		QueueURL:           "rabbit://business-service-order-events",
+		DedupTTL:           time.Hour,
+		DedupProcessingTTL: time.Minute,
+	}
+
+	suite.reg = prometheus.NewRegistry()
+	metr, err := monitor.CreateMetrics("business_service_order_events", suite.reg)
+	suite.Require().NoError(err)
+
+	client := redis.NewClient(&redis.Options{Addr: suite.redisServer.Addr()})
+	suite.dedup = subscribe.Deduplicate(cache.NewCacheRepository[string](client), opt, metr)
+}
+
+func (suite *DeduplicateSuite) TearDownTest() {
+	suite.redisServer.Close()
+}
+
+func (suite *DeduplicateSuite) handler(err error) subscribe.Handler {
+	return suite.dedup(func(_ context.Context, _ []byte) error {
+		suite.calls++
+		return err
+	})
+}
+
+func (suite *DeduplicateSuite) withMessageID(id string) context.Context {
+	return envelope.NewContext(suite.ctx, &envelope.Envelope{ID: id})
+}
+
+func (suite *DeduplicateSuite) duplicates() float64 {
+	families, err := suite.reg.Gather()
+	suite.Require().NoError(err)
+
+	for _, family := range families {
+		if family.GetName() != "business_service_order_events_duplicates" {
+			continue
+		}
+		var total float64
+		for _, metric := range family.GetMetric() {
+			total += metric.GetCounter().GetValue()
+		}
+		return total
+	}
+	return 0
+}
+
+func (suite *DeduplicateSuite) TestDropsRedeliveredMessage() {
+	handler := suite.handler(nil)
+	ctx := suite.withMessageID("6b1f0c7e")
+
+	suite.NoError(handler(ctx, []byte("order")))
+	suite.NoError(handler(ctx, []byte("order")))
+
+	suite.Equal(1, suite.calls)
+	suite.Equal(float64(1), suite.duplicates())
+	suite.True(suite.redisServer.Exists("dedup:business-service-order-events:6b1f0c7e"))
+	suite.False(suite.redisServer.Exists("dedup:business-service-order-events:6b1f0c7e:processing"))
+}
+
+func (suite *DeduplicateSuite) TestDistinctMessagesAreProcessed() {
+	handler := suite.handler(nil)
+
+	suite.NoError(handler(suite.withMessageID("6b1f0c7e"), []byte("order")))
+	suite.NoError(handler(suite.withMessageID("a41d93b2"), []byte("order")))
+
+	suite.Equal(2, suite.calls)
+	suite.Zero(suite.duplicates())
+}
+
+func (suite *DeduplicateSuite) TestFailureReleasesMessageID() {
+	ctx := suite.withMessageID("6b1f0c7e")
+
+	suite.Error(suite.handler(errors.New("order-data-service unavailable"))(ctx, []byte("order")))
+	suite.NoError(suite.handler(nil)(ctx, []byte("order")))
+
+	suite.Equal(2, suite.calls)
+	suite.Zero(suite.duplicates())
+}
+
+func (suite *DeduplicateSuite) TestInProgressMessageIsRetried() {
+	ctx := suite.withMessageID("6b1f0c7e")
+	suite.Require().NoError(suite.redisServer.Set("dedup:business-service-order-events:6b1f0c7e:processing", "claimed"))
+	suite.redisServer.SetTTL("dedup:business-service-order-events:6b1f0c7e:processing", time.Minute)
+
+	err := suite.handler(nil)(ctx, []byte("order"))
+	suite.ErrorIs(err, subscribe.ErrMessageInProgress)
+	suite.Zero(suite.calls)
+	suite.Zero(suite.duplicates())
+}
+
+func (suite *DeduplicateSuite) TestDiedDeliveryIsProcessedAgain() {
+	ctx := suite.withMessageID("6b1f0c7e")
+	suite.Require().NoError(suite.redisServer.Set("dedup:business-service-order-events:6b1f0c7e:processing", "claimed"))
+	suite.redisServer.SetTTL("dedup:business-service-order-events:6b1f0c7e:processing", time.Minute)
+
+	suite.redisServer.FastForward(2 * time.Minute)
+
+	suite.NoError(suite.handler(nil)(ctx, []byte("order")))
+	suite.Equal(1, suite.calls)
+	suite.True(suite.redisServer.Exists("dedup:business-service-order-events:6b1f0c7e"))
+}
+
+func (suite *DeduplicateSuite) TestFallsBackToBodyHash() {
+	handler := suite.handler(nil)
+
+	suite.NoError(handler(suite.ctx, []byte("order")))
+	suite.NoError(handler(suite.ctx, []byte("order")))
+	suite.NoError(handler(suite.ctx, []byte("another order")))
+
+	suite.Equal(2, suite.calls)
+	suite.Equal(float64(1), suite.duplicates())
+}
+
+func (suite *DeduplicateSuite) TestProcessesWhenStoreIsUnavailable() {
+	handler := suite.handler(nil)
+	suite.redisServer.Close()
+
+	suite.NoError(handler(suite.withMessageID("6b1f0c7e"), []byte("order")))
+	suite.NoError(handler(suite.withMessageID("6b1f0c7e"), []byte("order")))
+
+	suite.Equal(2, suite.calls)
+}
+
+func TestDeduplicateSuite(t *testing.T) {
+	suite.Run(t, new(DeduplicateSuite))
+}
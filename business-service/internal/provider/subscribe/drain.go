@@ -0,0 +1,70 @@
+package subscribe
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"gocloud.dev/pubsub"
+)
+
+// delivery settles a message exactly once, either by the handler or by the
+// drain when the deadline is exceeded.
+type delivery struct {
+	msg  *pubsub.Message
+	once sync.Once
+}
+
+func (d *delivery) ack() {
+	d.once.Do(d.msg.Ack)
+}
+
+func (d *delivery) nack() {
+	d.once.Do(func() { nack(d.msg) })
+}
+
+func nack(msg *pubsub.Message) {
+	if msg != nil && msg.Nackable() {
+		msg.Nack()
+	}
+}
+
+// drain waits for the in-flight messages up to DrainTimeout. Messages still
+// being processed after that are nacked so the broker redelivers them, and
+// their handlers are cancelled.
+func (s *Subscription) drain(ctx context.Context, wg *sync.WaitGroup, cancelProcess context.CancelFunc) {
+	log := logger.FromContext(ctx)
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	timer := time.NewTimer(s.opt.DrainTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		log.Infof("in-flight messages drained for queueURL: %s", s.opt.QueueURL)
+	case <-timer.C:
+		left := s.nackInFlight()
+		cancelProcess()
+		log.Warnf("drain timeout exceeded, %d in-flight messages nacked for queueURL: %s", left, s.opt.QueueURL)
+	}
+}
+
+func (s *Subscription) nackInFlight() int {
+	var left int
+	s.inFlight.Range(func(key, _ any) bool {
+		key.(*delivery).nack()
+		left++
+		return true
+	})
+	return left
+}
+
+func (s *Subscription) shutdownContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), s.opt.DrainTimeout)
+}
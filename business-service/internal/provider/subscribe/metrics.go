@@ -0,0 +1,80 @@
+package subscribe
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/broker"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared/utils"
+	"github.com/lucasd-coder/fast-feet/pkg/envelope"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+)
+
+// track registers d as in flight until it is settled, see untrack.
+func (s *Subscription) track(d *delivery, wg *sync.WaitGroup) {
+	s.inFlight.Store(d, struct{}{})
+	wg.Add(1)
+	s.metr.SetInFlight(s.opt.QueueURL, int(s.inFlightCount.Add(1)))
+}
+
+func (s *Subscription) untrack(d *delivery, wg *sync.WaitGroup) {
+	s.inFlight.Delete(d)
+	s.metr.SetInFlight(s.opt.QueueURL, int(s.inFlightCount.Add(-1)))
+	wg.Done()
+}
+
+func (s *Subscription) setSaturation(busy int32) {
+	if s.opt.MaxConcurrentMessages <= 0 {
+		return
+	}
+	s.metr.SetSaturation(s.opt.QueueURL, float64(busy)/float64(s.opt.MaxConcurrentMessages))
+}
+
+// observeEndToEnd records the time since the message was published, retries
+// included, as the envelope keeps the original timestamp.
+func (s *Subscription) observeEndToEnd(env *envelope.Envelope) {
+	if env.Timestamp.IsZero() {
+		return
+	}
+	s.metr.ObserveEndToEnd(s.opt.QueueURL, time.Since(env.Timestamp).Seconds())
+}
+
+// watchQueueDepth polls the broker management API for the messages waiting
+// in the queue. Only RabbitMQ with a ManagementURL is supported.
+func (s *Subscription) watchQueueDepth(ctx context.Context) {
+	if s.opt.ManagementURL == "" || broker.Scheme(s.opt.QueueURL) != broker.SchemeRabbit {
+		return
+	}
+	log := logger.FromContext(ctx)
+
+	mgmt, err := broker.NewManagement(s.opt.ManagementURL, os.Getenv("RABBIT_SERVER_URL"))
+	if err != nil {
+		log.Errorf("error creating management client for queueURL: %s, err: %v", s.opt.QueueURL, err)
+		return
+	}
+
+	interval := s.opt.QueueDepthInterval
+	if interval <= 0 {
+		interval = 15 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	queue := utils.ExtractQueueName(s.opt.QueueURL)
+	for {
+		depth, err := mgmt.QueueDepth(ctx, queue)
+		if err != nil && ctx.Err() == nil {
+			log.Warnf("error reading queue depth for queueURL: %s, err: %v", s.opt.QueueURL, err)
+		} else if err == nil {
+			s.metr.SetQueueDepth(s.opt.QueueURL, depth)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package subscribe
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+)
+
+// orderedLaneBuffer bounds how many messages may wait on a single lane before
+// the dispatcher blocks.
+const orderedLaneBuffer = 16
+
+// orderedLanes routes messages with the same ordering key to the same serial
+// worker, so they are processed one at a time in the order they were received
+// while distinct keys still run concurrently.
+type orderedLanes struct {
+	lanes []chan *delivery
+	key   string
+	next  atomic.Uint32
+	wg    sync.WaitGroup
+}
+
+func (s *Subscription) startOrderedLanes(ctx context.Context, wg *sync.WaitGroup) *orderedLanes {
+	size := s.opt.MaxConcurrentMessages
+	if size < 1 {
+		size = 1
+	}
+
+	l := &orderedLanes{
+		lanes: make([]chan *delivery, size),
+		key:   s.opt.OrderingKey,
+	}
+
+	for i := range l.lanes {
+		lane := make(chan *delivery, orderedLaneBuffer)
+		l.lanes[i] = lane
+
+		l.wg.Add(1)
+		go func() {
+			defer l.wg.Done()
+			for d := range lane {
+				// the drain already nacked what was left when it timed out.
+				if ctx.Err() != nil {
+					s.untrack(d, wg)
+					continue
+				}
+				s.handle(ctx, d, wg)
+			}
+		}()
+	}
+
+	return l
+}
+
+// laneOf returns the lane of the ordering key of d. Messages without the key
+// are spread round-robin.
+func (l *orderedLanes) laneOf(d *delivery) chan *delivery {
+	return l.lanes[l.index(d.msg.Metadata[l.key])]
+}
+
+func (l *orderedLanes) index(key string) int {
+	if key == "" {
+		return int(l.next.Add(1) % uint32(len(l.lanes)))
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return int(h.Sum32() % uint32(len(l.lanes)))
+}
+
+// close stops accepting messages, workers finish what is already queued.
+func (l *orderedLanes) close() {
+	for _, lane := range l.lanes {
+		close(lane)
+	}
+}
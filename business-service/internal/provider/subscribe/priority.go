@@ -0,0 +1,145 @@
+package subscribe
+
+import "github.com/lucasd-coder/fast-feet/pkg/envelope"
+
+// priorityClasses are served by smooth weighted round-robin, high importance
+// work gets most of the workers without starving bulk work.
+var priorityClasses = []struct {
+	importance string
+	weight     int
+}{
+	{importance: envelope.ImportanceHigh, weight: 6},
+	{importance: envelope.ImportanceNormal, weight: 3},
+	{importance: envelope.ImportanceLow, weight: 1},
+}
+
+// scheduler buffers received messages per importance and decides which one
+// is dispatched next. With an ordering key a message never overtakes an
+// earlier one with the same key, whatever their importance, so the ordered
+// lanes receive each key in the order it was received. It is only used by
+// the dispatch loop, so it is not safe for concurrent use.
+type scheduler struct {
+	queues   [][]*delivery
+	current  []int
+	capacity int
+	size     int
+	key      string
+	// waiting holds the buffered messages of each ordering key, oldest first.
+	waiting map[string][]*delivery
+}
+
+func newScheduler(capacity int, key string) *scheduler {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &scheduler{
+		queues:   make([][]*delivery, len(priorityClasses)),
+		current:  make([]int, len(priorityClasses)),
+		capacity: capacity,
+		key:      key,
+		waiting:  map[string][]*delivery{},
+	}
+}
+
+func (sc *scheduler) full() bool {
+	return sc.size >= sc.capacity
+}
+
+func (sc *scheduler) push(d *delivery) {
+	class := classOf(d.msg.Metadata[envelope.HeaderImportance])
+	sc.queues[class] = append(sc.queues[class], d)
+	sc.size++
+
+	if key := sc.keyOf(d); key != "" {
+		sc.waiting[key] = append(sc.waiting[key], d)
+	}
+}
+
+// peek returns the message pop would return, without consuming its turn.
+func (sc *scheduler) peek() *delivery {
+	class, idx := sc.next()
+	if class < 0 {
+		return nil
+	}
+	return sc.queues[class][idx]
+}
+
+func (sc *scheduler) pop() *delivery {
+	class, idx := sc.next()
+	if class < 0 {
+		return nil
+	}
+
+	total := 0
+	for i, c := range priorityClasses {
+		if len(sc.queues[i]) > 0 {
+			sc.current[i] += c.weight
+			total += c.weight
+		}
+	}
+	sc.current[class] -= total
+
+	queue := sc.queues[class]
+	d := queue[idx]
+	copy(queue[idx:], queue[idx+1:])
+	queue[len(queue)-1] = nil
+	sc.queues[class] = queue[:len(queue)-1]
+	sc.size--
+
+	if key := sc.keyOf(d); key != "" {
+		if rest := sc.waiting[key][1:]; len(rest) > 0 {
+			sc.waiting[key] = rest
+		} else {
+			delete(sc.waiting, key)
+		}
+	}
+
+	return d
+}
+
+// next picks the class with the highest current weight among the ones with
+// a message that may be dispatched, and the first such message in it. The
+// oldest buffered message may always be dispatched, so one is found whenever
+// the scheduler is not empty.
+func (sc *scheduler) next() (int, int) {
+	best, bestIdx := -1, -1
+	for i, c := range priorityClasses {
+		idx := sc.firstReady(i)
+		if idx < 0 {
+			continue
+		}
+		if best < 0 || sc.current[i]+c.weight > sc.current[best]+priorityClasses[best].weight {
+			best, bestIdx = i, idx
+		}
+	}
+	return best, bestIdx
+}
+
+// firstReady returns the index of the first message of class that is the
+// oldest buffered one of its ordering key, or -1.
+func (sc *scheduler) firstReady(class int) int {
+	for idx, d := range sc.queues[class] {
+		key := sc.keyOf(d)
+		if key == "" || sc.waiting[key][0] == d {
+			return idx
+		}
+	}
+	return -1
+}
+
+func (sc *scheduler) keyOf(d *delivery) string {
+	if sc.key == "" {
+		return ""
+	}
+	return d.msg.Metadata[sc.key]
+}
+
+func classOf(importance string) int {
+	for i, class := range priorityClasses {
+		if class.importance == importance {
+			return i
+		}
+	}
+	// messages published before importance existed are normal.
+	return 1
+}
@@ -0,0 +1,102 @@
+package subscribe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared/queueoptions"
+	"github.com/lucasd-coder/fast-feet/pkg/envelope"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"gocloud.dev/pubsub"
+)
+
+const (
+	HeaderRetryAttempt   = "x-retry-attempt"
+	HeaderRetryFirstSeen = "x-retry-first-seen"
+)
+
+var errNoRetryTopic = errors.New("no retry topic configured")
+
+// retry republishes a failed message to the retry topic, so the worker is
+// released right away instead of sleeping while it holds a concurrency slot.
+// On RabbitMQ the topic routes the attempt to a TTL queue that dead-letters
+// it back to the source queue, see broker.DeclareRetryQueues. The queue TTL
+// alone sets the delay: RabbitMQ only expires messages at the head of a
+// queue, so a shorter per-message expiration would wait behind longer ones.
+func (s *Subscription) retry(ctx context.Context, msg *pubsub.Message, attempt int, firstSeen time.Time) error {
+	log := logger.FromContext(ctx)
+
+	if s.retryTopic == nil {
+		return fmt.Errorf("%w for queueURL: %s, attempt: %d", errNoRetryTopic, s.opt.QueueURL, attempt)
+	}
+
+	delay := retryDelay(s.opt, attempt)
+
+	metadata := make(map[string]string, len(msg.Metadata)+2)
+	for k, v := range msg.Metadata {
+		metadata[k] = v
+	}
+	metadata[HeaderRetryAttempt] = strconv.Itoa(attempt)
+	metadata[HeaderRetryFirstSeen] = firstSeen.UTC().Format(time.RFC3339Nano)
+
+	if err := s.retryTopic.Send(ctx, &pubsub.Message{
+		Body:     msg.Body,
+		Metadata: metadata,
+		BeforeSend: func(asFunc func(interface{}) bool) error {
+			var publishing *amqp.Publishing
+			if asFunc(&publishing) {
+				publishing.Priority = envelope.Priority(metadata[envelope.HeaderImportance])
+			}
+			return nil
+		},
+	}); err != nil {
+		return fmt.Errorf("fail send retry message for queueURL: %s err: %w", s.opt.RetryURL, err)
+	}
+
+	s.metr.IncRetries(s.opt.QueueURL)
+	log.Infof("message scheduled for retry %d in %v for queueURL: %s", attempt, delay, s.opt.QueueURL)
+
+	return nil
+}
+
+// retryDelay returns the exponential delay of attempt, capped at MaxBackoff.
+func retryDelay(opt *queueoptions.Options, attempt int) time.Duration {
+	ceil := opt.WaitingTime
+	for i := 1; i < attempt && ceil < opt.MaxBackoff; i++ {
+		ceil *= 2
+	}
+	if opt.MaxBackoff > 0 && ceil > opt.MaxBackoff {
+		ceil = opt.MaxBackoff
+	}
+	return ceil
+}
+
+// retryDelays returns the delay of every attempt that is retried, the last
+// attempt is dead-lettered instead.
+func retryDelays(opt *queueoptions.Options) []time.Duration {
+	delays := make([]time.Duration, 0, opt.MaxRetries)
+	for attempt := 1; attempt < opt.MaxRetries; attempt++ {
+		delays = append(delays, retryDelay(opt, attempt))
+	}
+	return delays
+}
+
+func attemptOf(msg *pubsub.Message) int {
+	attempt, err := strconv.Atoi(msg.Metadata[HeaderRetryAttempt])
+	if err != nil {
+		return 0
+	}
+	return attempt
+}
+
+func firstSeenOf(msg *pubsub.Message, fallback time.Time) time.Time {
+	firstSeen, err := time.Parse(time.RFC3339Nano, msg.Metadata[HeaderRetryFirstSeen])
+	if err != nil {
+		return fallback
+	}
+	return firstSeen
+}
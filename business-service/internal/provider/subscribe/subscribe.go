@@ -2,14 +2,16 @@ package subscribe
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
-	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
 	"github.com/lucasd-coder/fast-feet/business-service/internal/shared/queueoptions"
 	"github.com/lucasd-coder/fast-feet/business-service/internal/shared/utils"
+	"github.com/lucasd-coder/fast-feet/pkg/envelope"
 	"github.com/lucasd-coder/fast-feet/pkg/logger"
 	"github.com/lucasd-coder/fast-feet/pkg/monitor"
 
@@ -24,10 +26,31 @@ var (
 	mutex sync.Mutex
 )
 
+const (
+	stateStarting int32 = iota
+	stateReceiving
+	stateDraining
+	stateStopped
+)
+
+// Gate pauses receiving while the dependencies of the handler are
+// unavailable, see circuitbreaker.Gate.
+type Gate interface {
+	// Wait blocks until messages may be received or ctx is done.
+	Wait(ctx context.Context) error
+}
+
 type Subscription struct {
-	handler func(ctx context.Context, m []byte) error
-	opt     *queueoptions.Options
-	metr    monitor.Metrics
+	handler         func(ctx context.Context, m []byte) error
+	opt             *queueoptions.Options
+	metr            monitor.Metrics
+	deadLetterTopic *pubsub.Topic
+	retryTopic      *pubsub.Topic
+	gate            Gate
+	state           atomic.Int32
+	inFlight        sync.Map
+	inFlightCount   atomic.Int32
+	busy            atomic.Int32
 }
 
 func New(
@@ -35,12 +58,25 @@ func New(
 	opt *queueoptions.Options,
 	metr monitor.Metrics) *Subscription {
 	return &Subscription{
-		handler,
-		opt,
-		metr,
+		handler: handler,
+		opt:     opt,
+		metr:    metr,
 	}
 }
 
+// WithGate pauses receiving while gate is closed. Messages already received
+// that fail with shared.ErrCircuitOpen are nacked without spending a retry.
+func (s *Subscription) WithGate(gate Gate) *Subscription {
+	s.gate = gate
+	return s
+}
+
+// Ready reports whether the subscription is receiving messages, it turns
+// false as soon as the subscription starts draining.
+func (s *Subscription) Ready() bool {
+	return s.state.Load() == stateReceiving
+}
+
 func (s *Subscription) Start(ctx context.Context) {
 	mutex.Lock()
 	tracer := s.initializeTracer()
@@ -59,6 +95,11 @@ func (s *Subscription) Start(ctx context.Context) {
 	)
 	defer span.End()
 
+	if err := DeclareQueue(s.opt); err != nil {
+		span.RecordError(err)
+		logDefault.Errorf("error declaring queue for queueURL: %s, err: %v", s.opt.QueueURL, err)
+	}
+
 	client, err := NewClient(ctx, s.opt)
 	if err != nil {
 		span.RecordError(err)
@@ -66,56 +107,155 @@ func (s *Subscription) Start(ctx context.Context) {
 	}
 
 	defer func() {
-		if err := client.Shutdown(ctx); err != nil {
+		if client == nil {
+			return
+		}
+		shutdownCtx, cancel := s.shutdownContext(ctx)
+		defer cancel()
+		if err := client.Shutdown(shutdownCtx); err != nil {
 			span.RecordError(err)
-			log.Fatalf("error client for queueURL: %s, shutdown: %v", s.opt.QueueURL, err)
+			logDefault.Errorf("error client for queueURL: %s, shutdown: %v", s.opt.QueueURL, err)
 		}
 	}()
 
+	if s.opt.DeadLetterURL != "" {
+		topic, err := NewDeadLetterClient(ctx, s.opt)
+		if err != nil {
+			span.RecordError(err)
+			logDefault.Errorf("error creating dead-letter client: %v, for queueURL: %s", err, s.opt.DeadLetterURL)
+		} else {
+			s.deadLetterTopic = topic
+			defer func() {
+				shutdownCtx, cancel := s.shutdownContext(ctx)
+				defer cancel()
+				if err := topic.Shutdown(shutdownCtx); err != nil {
+					logDefault.Errorf("error dead-letter client for queueURL: %s, shutdown: %v", s.opt.DeadLetterURL, err)
+				}
+			}()
+		}
+	}
+
+	if s.opt.RetryURL != "" {
+		topic, err := NewRetryClient(ctx, s.opt)
+		if err != nil {
+			span.RecordError(err)
+			logDefault.Errorf("error creating retry client: %v, for queueURL: %s", err, s.opt.RetryURL)
+		} else {
+			s.retryTopic = topic
+			defer func() {
+				shutdownCtx, cancel := s.shutdownContext(ctx)
+				defer cancel()
+				if err := topic.Shutdown(shutdownCtx); err != nil {
+					logDefault.Errorf("error retry client for queueURL: %s, shutdown: %v", s.opt.RetryURL, err)
+				}
+			}()
+		}
+	}
+
 	var wg sync.WaitGroup
 	sem := make(chan struct{}, s.opt.MaxConcurrentMessages)
 
-	s.start(ctx, client, &wg, sem)
-	wg.Wait()
-	close(sem)
+	// handlers outlive the receive context so in-flight messages can drain.
+	processCtx, cancelProcess := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelProcess()
+
+	go s.watchQueueDepth(ctx)
+
+	s.state.Store(stateReceiving)
+	s.start(ctx, processCtx, client, &wg, sem)
+
+	s.state.Store(stateDraining)
+	s.drain(ctx, &wg, cancelProcess)
+	s.state.Store(stateStopped)
 }
 
-func (s *Subscription) start(ctx context.Context, client *pubsub.Subscription, wg *sync.WaitGroup, sem chan struct{}) {
+// start dispatches received messages by importance, see scheduler, either
+// to a goroutine bounded by sem or to the ordered lanes.
+func (s *Subscription) start(ctx, processCtx context.Context, client *pubsub.Subscription, wg *sync.WaitGroup, sem chan struct{}) {
 	log := logger.FromContext(ctx)
 
 	msgChan := make(chan *pubsub.Message)
 
 	s.startReceivers(ctx, client, msgChan)
 
+	var lanes *orderedLanes
+	if s.opt.OrderingKey != "" {
+		lanes = s.startOrderedLanes(processCtx, wg)
+		defer lanes.close()
+	}
+
+	// buffer more messages than workers so the scheduler has a choice.
+	pending := newScheduler(2*s.opt.MaxConcurrentMessages, s.opt.OrderingKey)
+
 	for {
+		var (
+			receive = msgChan
+			slot    chan struct{}
+			lane    chan *delivery
+		)
+		next := pending.peek()
+		if next != nil {
+			if lanes != nil {
+				lane = lanes.laneOf(next)
+			} else {
+				slot = sem
+			}
+		}
+		if pending.full() {
+			receive = nil
+		}
+
 		select {
 		case <-ctx.Done():
+			for d := pending.pop(); d != nil; d = pending.pop() {
+				d.nack()
+				s.untrack(d, wg)
+			}
 			log.Infof("context cancelled, stopping Subscription... for queueURL: %s", s.opt.QueueURL)
 			return
-		case msg := <-msgChan:
-			sem <- struct{}{}
-			wg.Add(1)
-			go func(ctx context.Context, currentMsg *pubsub.Message) {
+		case msg := <-receive:
+			d := &delivery{msg: msg}
+			s.track(d, wg)
+			pending.push(d)
+		case lane <- next:
+			pending.pop()
+		case slot <- struct{}{}:
+			pending.pop()
+			go func(ctx context.Context, d *delivery) {
 				defer func() {
 					<-sem
-					wg.Done()
-					currentMsg.Ack()
 				}()
+				s.handle(ctx, d, wg)
+			}(processCtx, next)
+		}
+	}
+}
 
-				if err := s.processMessage(ctx, currentMsg.Body); err != nil {
-					log.Errorf("error processing message for queueURL: %s, err: %v", s.opt.QueueURL, err)
-					if currentMsg.Nackable() {
-						defer currentMsg.Nack()
-					}
-					return
-				}
-				runtime.Goexit()
-			}(ctx, msg)
+// handle processes a tracked delivery and settles it.
+func (s *Subscription) handle(ctx context.Context, d *delivery, wg *sync.WaitGroup) {
+	log := logger.FromContext(ctx)
+	s.setSaturation(s.busy.Add(1))
+	defer func() {
+		s.setSaturation(s.busy.Add(-1))
+		s.untrack(d, wg)
+	}()
+
+	if err := s.processMessage(ctx, d.msg); err != nil {
+		log.Errorf("error processing message for queueURL: %s, err: %v", s.opt.QueueURL, err)
+		if d.msg.Nackable() {
+			d.nack()
+			return
 		}
 	}
+	d.ack()
 }
 
-func (s *Subscription) processMessage(ctx context.Context, messages []byte) error {
+// processMessage handles a single delivery. Failures are scheduled on the
+// retry topic until MaxRetries is reached and then dead-lettered, as is a
+// failure that cannot be rescheduled. It only returns an error when the
+// message could not be dead-lettered or a downstream circuit is open, so the
+// caller nacks it and the broker redelivers it.
+func (s *Subscription) processMessage(ctx context.Context, msg *pubsub.Message) (err error) {
 	log := logger.FromContext(ctx)
 	start := time.Now()
 	name := fmt.Sprintf("%s_consumed", utils.ExtractQueueName(s.opt.QueueURL))
@@ -127,8 +267,23 @@ func (s *Subscription) processMessage(ctx context.Context, messages []byte) erro
 
 	tracer := otel.GetTracerProvider().Tracer(traceName)
 
+	attempt := attemptOf(msg) + 1
+	firstSeen := firstSeenOf(msg, start)
+
+	env := envelope.FromMetadata(msg.Metadata)
+	ctx = envelope.Extract(ctx, msg.Metadata)
+	if env.CorrelationID != "" {
+		ctx = envelope.WithCorrelationID(ctx, env.CorrelationID)
+	}
+	ctx = envelope.NewContext(ctx, env)
+
 	commonAttrs := []attribute.KeyValue{
 		attribute.String("queueURL", s.opt.QueueURL),
+		attribute.Int("attempt", attempt),
+		attribute.String("messageID", env.ID),
+		attribute.String("messageType", env.Type),
+		attribute.String("schemaVersion", env.SchemaVersion),
+		attribute.String("correlationID", env.CorrelationID),
 	}
 
 	ctx, span := tracer.Start(ctx, spanName,
@@ -141,42 +296,81 @@ func (s *Subscription) processMessage(ctx context.Context, messages []byte) erro
 		if r := recover(); r != nil {
 			span.SetStatus(codes.Error, "recovered from panic")
 			s.createMetrics(monitor.ERROR, name, start)
+			s.metr.IncPanics(s.opt.QueueURL)
 			log.Errorf("recovered from panic: %v", r)
+			err = s.deadLetter(ctx, msg, &failure{
+				reason:    ReasonPanic,
+				cause:     fmt.Errorf("panic: %v", r),
+				attempts:  attempt,
+				firstSeen: firstSeen,
+			})
 		}
 	}()
 
-	var err error
-	for i := 0; i < s.opt.MaxRetries; i++ {
-		ctx, iSpan := tracer.Start(ctx, fmt.Sprintf(" ProcessingMessage MaxRetries-%d", i))
-		err = s.handler(ctx, messages)
-		if err == nil {
-			iSpan.SetStatus(codes.Ok, "Successfully Processing Message")
-			s.createMetrics(monitor.OK, name, start)
-			break
-		}
-		log.Errorf("error while handling message: %v", err)
-		iSpan.SetStatus(codes.Error, "Error Processing Message")
-		iSpan.RecordError(err)
+	err = s.handler(ctx, msg.Body)
+	if err == nil {
+		span.SetStatus(codes.Ok, "Successfully Processing Message")
+		s.createMetrics(monitor.OK, name, start)
+		s.observeEndToEnd(env)
+		return nil
+	}
 
-		if i == s.opt.MaxRetries-1 {
-			log.Errorf("max retries exceeded, not processing message anymore: %v", err)
-			s.createMetrics(monitor.ERROR, name, start)
-			err = nil
-			break
-		}
-		s.createMetrics(monitor.ERROR, name, start)
-		backOffTime := time.Duration(1+i) * s.opt.WaitingTime
-		log.Infof("waiting %v before retrying", backOffTime)
-		time.Sleep(backOffTime)
-		iSpan.End()
+	log.Errorf("error while handling message attempt %d: %v", attempt, err)
+	span.SetStatus(codes.Error, "Error Processing Message")
+	span.RecordError(err)
+	s.createMetrics(monitor.ERROR, name, start)
+
+	if ctx.Err() != nil {
+		return fmt.Errorf("message interrupted by shutdown: %w", err)
 	}
-	return err
+
+	if errors.Is(err, shared.ErrCircuitOpen) {
+		return fmt.Errorf("downstream unavailable, message nacked: %w", err)
+	}
+
+	if errors.Is(err, shared.ErrUnprocessableMessage) {
+		log.Errorf("unprocessable message, not retrying: %v", err)
+		return s.deadLetter(ctx, msg, &failure{
+			reason:    ReasonUnprocessable,
+			cause:     err,
+			attempts:  attempt,
+			firstSeen: firstSeen,
+		})
+	}
+
+	if attempt >= s.opt.MaxRetries {
+		log.Errorf("max retries exceeded, not processing message anymore: %v", err)
+		return s.deadLetter(ctx, msg, &failure{
+			reason:    ReasonMaxRetriesExceeded,
+			cause:     err,
+			attempts:  attempt,
+			firstSeen: firstSeen,
+		})
+	}
+
+	if retryErr := s.retry(ctx, msg, attempt, firstSeen); retryErr != nil {
+		// a nack would redeliver it right away with the same attempt.
+		log.Errorf("error scheduling retry, dead-lettering message: %v", retryErr)
+		return s.deadLetter(ctx, msg, &failure{
+			reason:    ReasonRetryFailed,
+			cause:     fmt.Errorf("%w: %w", retryErr, err),
+			attempts:  attempt,
+			firstSeen: firstSeen,
+		})
+	}
+
+	return nil
 }
 
 func (s *Subscription) startReceivers(ctx context.Context, client *pubsub.Subscription, m chan *pubsub.Message) {
 	mutex.Lock()
 	defer mutex.Unlock()
-	for i := 0; i < s.opt.NumberOfMessageReceivers; i++ {
+	receivers := s.opt.NumberOfMessageReceivers
+	// concurrent receivers could swap messages of the same ordering key.
+	if s.opt.OrderingKey != "" {
+		receivers = 1
+	}
+	for i := 0; i < receivers; i++ {
 		go s.receiveMessage(ctx, client, m)
 	}
 }
@@ -197,10 +391,22 @@ func (s *Subscription) receiveMessage(ctx context.Context, client *pubsub.Subscr
 				log.Infof("context cancelled, stopping receive... for queueURL %s", s.opt.QueueURL)
 				return
 			default:
+				if s.gate != nil {
+					if err := s.gate.Wait(ctx); err != nil {
+						log.Infof("context cancelled, stopping receive... for queueURL %s", s.opt.QueueURL)
+						return
+					}
+				}
 				childCtx, cancel := context.WithCancel(ctx)
-				defer cancel()
-
 				msg, err := client.Receive(childCtx)
+				cancel()
+				if ctx.Err() != nil {
+					if err == nil {
+						nack(msg)
+					}
+					log.Infof("context cancelled, stopping receive... for queueURL %s", s.opt.QueueURL)
+					return
+				}
 				if err != nil {
 					span.RecordError(err)
 					s.createMetrics(monitor.ERROR, name, start)
@@ -216,7 +422,13 @@ func (s *Subscription) receiveMessage(ctx context.Context, client *pubsub.Subscr
 
 				if msg != nil && len(msg.Body) > 0 {
 					s.createMetrics(monitor.OK, name, start)
-					m <- msg
+					select {
+					case m <- msg:
+					case <-ctx.Done():
+						nack(msg)
+						log.Infof("context cancelled, stopping receive... for queueURL %s", s.opt.QueueURL)
+						return
+					}
 				}
 				s.applyBackPressure()
 				span.End()
@@ -230,7 +442,7 @@ func (s *Subscription) applyBackPressure() {
 }
 func (s *Subscription) createMetrics(status string, queueName string, observeTime time.Time) {
 	s.metr.ObserveResponseTime(status, queueName, time.Since(observeTime).Seconds())
-	s.metr.IncHits(monitor.OK, queueName)
+	s.metr.IncHits(status, queueName)
 }
 
 func (s *Subscription) initializeTracer() trace.Tracer {
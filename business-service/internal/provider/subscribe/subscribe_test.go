@@ -0,0 +1,650 @@
+package subscribe_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/provider/subscribe"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared/queueoptions"
+	"github.com/lucasd-coder/fast-feet/pkg/envelope"
+	"github.com/lucasd-coder/fast-feet/pkg/monitor"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/mempubsub"
+)
+
+type SubscriptionSuite struct {
+	suite.Suite
+	ctx    context.Context
+	cancel context.CancelFunc
+	opt    *queueoptions.Options
+	metr   monitor.Metrics
+	reg    *prometheus.Registry
+	topic  *pubsub.Topic
+	dlq    *pubsub.Subscription
+}
+
+func (suite *SubscriptionSuite) SetupTest() {
+	suite.ctx, suite.cancel = context.WithCancel(context.Background())
+	id := time.Now().UnixNano()
+
+	suite.opt = &queueoptions.Options{
+		QueueURL:      fmt.Sprintf("mem://business-service-events-%d", id),
+		DeadLetterURL: fmt.Sprintf("mem://business-service-events-dlq-%d", id),
+		// mempubsub has no retry TTL queues, retries go straight back to the queue.
+		RetryURL:                 fmt.Sprintf("mem://business-service-events-%d", id),
+		MaxConcurrentMessages:    1,
+		MaxRetries:               3,
+		WaitingTime:              time.Millisecond,
+		NumberOfMessageReceivers: 1,
+		MaxBackoff:               10 * time.Millisecond,
+		MaxReceiveMessage:        time.Millisecond,
+		PollDelay:                time.Millisecond,
+		DrainTimeout:             time.Second,
+	}
+
+	var err error
+	suite.topic, err = pubsub.OpenTopic(suite.ctx, suite.opt.QueueURL)
+	suite.Require().NoError(err)
+
+	dlqTopic, err := pubsub.OpenTopic(suite.ctx, suite.opt.DeadLetterURL)
+	suite.Require().NoError(err)
+	suite.T().Cleanup(func() { _ = dlqTopic.Shutdown(context.Background()) })
+
+	suite.dlq = mempubsub.NewSubscription(dlqTopic, time.Minute)
+
+	suite.reg = prometheus.NewRegistry()
+	suite.metr, err = monitor.CreateMetrics("business_service_events", suite.reg)
+	suite.Require().NoError(err)
+}
+
+func (suite *SubscriptionSuite) TearDownTest() {
+	suite.cancel()
+	_ = suite.topic.Shutdown(context.Background())
+	_ = suite.dlq.Shutdown(context.Background())
+}
+
+func (suite *SubscriptionSuite) start(handler func(ctx context.Context, m []byte) error) {
+	go subscribe.New(handler, suite.opt, suite.metr).Start(suite.ctx)
+
+	// mempubsub drops messages sent before the subscription is opened.
+	time.Sleep(100 * time.Millisecond)
+
+	suite.Require().NoError(suite.topic.Send(suite.ctx, &pubsub.Message{
+		Body:     []byte("payload"),
+		Metadata: map[string]string{"importance": "high"},
+	}))
+}
+
+func (suite *SubscriptionSuite) receiveDeadLetter() *pubsub.Message {
+	ctx, cancel := context.WithTimeout(suite.ctx, 2*time.Second)
+	defer cancel()
+
+	msg, err := suite.dlq.Receive(ctx)
+	suite.Require().NoError(err)
+	msg.Ack()
+
+	return msg
+}
+
+func (suite *SubscriptionSuite) deadLetters(reason string) float64 {
+	families, err := suite.reg.Gather()
+	suite.Require().NoError(err)
+
+	var total float64
+	for _, family := range families {
+		if family.GetName() != "business_service_events_dead_letters" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			if hasLabel(metric, "reason", reason) {
+				total += metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return total
+}
+
+// value sums the counters, gauges and histogram sample counts of family.
+func (suite *SubscriptionSuite) value(family string) float64 {
+	families, err := suite.reg.Gather()
+	suite.Require().NoError(err)
+
+	var total float64
+	for _, f := range families {
+		if f.GetName() != family {
+			continue
+		}
+		for _, metric := range f.GetMetric() {
+			total += metric.GetCounter().GetValue() +
+				metric.GetGauge().GetValue() +
+				float64(metric.GetHistogram().GetSampleCount())
+		}
+	}
+	return total
+}
+
+func hasLabel(metric *dto.Metric, name, value string) bool {
+	for _, label := range metric.GetLabel() {
+		if label.GetName() == name && label.GetValue() == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (suite *SubscriptionSuite) TestDeadLetterAfterMaxRetries() {
+	calls := make(chan struct{}, suite.opt.MaxRetries)
+	suite.start(func(_ context.Context, _ []byte) error {
+		calls <- struct{}{}
+		return errors.New("order-data-service unavailable")
+	})
+
+	msg := suite.receiveDeadLetter()
+
+	suite.Equal([]byte("payload"), msg.Body)
+	suite.Equal("high", msg.Metadata["importance"])
+	suite.Equal("order-data-service unavailable", msg.Metadata[subscribe.HeaderDeadLetterError])
+	suite.Equal("3", msg.Metadata[subscribe.HeaderDeadLetterAttempts])
+	suite.Equal(subscribe.ReasonMaxRetriesExceeded, msg.Metadata[subscribe.HeaderDeadLetterReason])
+	suite.Equal(suite.opt.QueueURL, msg.Metadata[subscribe.HeaderDeadLetterSourceQueue])
+	suite.Equal(suite.opt.QueueURL, msg.Metadata[subscribe.HeaderDeadLetterSourceTopic])
+	suite.NotEmpty(msg.Metadata[subscribe.HeaderDeadLetterFirstSeen])
+	suite.NotEmpty(msg.Metadata[subscribe.HeaderDeadLetterTraceID])
+	suite.Equal("2", msg.Metadata[subscribe.HeaderRetryAttempt])
+	suite.Len(calls, suite.opt.MaxRetries)
+	suite.Equal(float64(1), suite.deadLetters(subscribe.ReasonMaxRetriesExceeded))
+	suite.Equal(float64(2), suite.value("business_service_events_retries"))
+}
+
+func (suite *SubscriptionSuite) TestRetrySucceedsOnNextDelivery() {
+	attempts := make(chan string, suite.opt.MaxRetries)
+	done := make(chan struct{})
+	suite.start(func(ctx context.Context, _ []byte) error {
+		attempts <- trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+		if len(attempts) == 1 {
+			return errors.New("timeout")
+		}
+		close(done)
+		return nil
+	})
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		suite.Fail("message was not redelivered")
+	}
+	suite.Len(attempts, 2)
+}
+
+func (suite *SubscriptionSuite) TestTraceContextIsExtracted() {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	traceIDs := make(chan string, 1)
+	go subscribe.New(func(ctx context.Context, _ []byte) error {
+		traceIDs <- trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+		return nil
+	}, suite.opt, suite.metr).Start(suite.ctx)
+
+	time.Sleep(100 * time.Millisecond)
+
+	suite.Require().NoError(suite.topic.Send(suite.ctx, &pubsub.Message{
+		Body: []byte("payload"),
+		Metadata: map[string]string{
+			"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		},
+	}))
+
+	select {
+	case traceID := <-traceIDs:
+		suite.Equal("4bf92f3577b34da6a3ce929d0e0e4736", traceID)
+	case <-time.After(2 * time.Second):
+		suite.Fail("message was not processed")
+	}
+}
+
+func (suite *SubscriptionSuite) TestDeadLetterUnprocessableWithoutRetry() {
+	calls := make(chan struct{}, suite.opt.MaxRetries)
+	suite.start(func(_ context.Context, _ []byte) error {
+		calls <- struct{}{}
+		return fmt.Errorf("%w: err Decode: unexpected EOF", shared.ErrUnprocessableMessage)
+	})
+
+	msg := suite.receiveDeadLetter()
+
+	suite.Equal(subscribe.ReasonUnprocessable, msg.Metadata[subscribe.HeaderDeadLetterReason])
+	suite.Equal("1", msg.Metadata[subscribe.HeaderDeadLetterAttempts])
+	suite.Len(calls, 1)
+	suite.Equal(float64(1), suite.deadLetters(subscribe.ReasonUnprocessable))
+}
+
+func (suite *SubscriptionSuite) TestDeadLetterWhenRetryCannotBeScheduled() {
+	suite.opt.RetryURL = ""
+
+	calls := make(chan struct{}, suite.opt.MaxRetries)
+	suite.start(func(_ context.Context, _ []byte) error {
+		calls <- struct{}{}
+		return errors.New("order-data-service unavailable")
+	})
+
+	msg := suite.receiveDeadLetter()
+
+	suite.Equal(subscribe.ReasonRetryFailed, msg.Metadata[subscribe.HeaderDeadLetterReason])
+	suite.Equal("1", msg.Metadata[subscribe.HeaderDeadLetterAttempts])
+	suite.Contains(msg.Metadata[subscribe.HeaderDeadLetterError], "order-data-service unavailable")
+	suite.Len(calls, 1)
+	suite.Equal(float64(1), suite.deadLetters(subscribe.ReasonRetryFailed))
+}
+
+func (suite *SubscriptionSuite) TestDeadLetterOnPanic() {
+	suite.start(func(_ context.Context, _ []byte) error {
+		panic("nil pointer dereference")
+	})
+
+	msg := suite.receiveDeadLetter()
+
+	suite.Equal(subscribe.ReasonPanic, msg.Metadata[subscribe.HeaderDeadLetterReason])
+	suite.Equal("panic: nil pointer dereference", msg.Metadata[subscribe.HeaderDeadLetterError])
+	suite.Equal(float64(1), suite.value("business_service_events_panics"))
+}
+
+func (suite *SubscriptionSuite) TestInFlightAndLatencyMetrics() {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	go subscribe.New(func(_ context.Context, _ []byte) error {
+		close(started)
+		<-release
+		return nil
+	}, suite.opt, suite.metr).Start(suite.ctx)
+
+	time.Sleep(100 * time.Millisecond)
+
+	env := envelope.New(suite.ctx, "user.created", "v1", "application/json")
+	env.Timestamp = time.Now().Add(-time.Second)
+	suite.Require().NoError(suite.topic.Send(suite.ctx, &pubsub.Message{
+		Body:     []byte("payload"),
+		Metadata: env.Metadata(nil),
+	}))
+
+	select {
+	case <-started:
+	case <-time.After(2 * time.Second):
+		suite.FailNow("message was not processed")
+	}
+
+	suite.Equal(float64(1), suite.value("business_service_events_in_flight"))
+	suite.Equal(float64(1), suite.value("business_service_events_saturation"))
+
+	close(release)
+
+	suite.Eventually(func() bool {
+		return suite.value("business_service_events_in_flight") == 0 &&
+			suite.value("business_service_events_saturation") == 0
+	}, 2*time.Second, 10*time.Millisecond)
+	suite.Equal(float64(1), suite.value("business_service_events_end_to_end_seconds"))
+}
+
+func (suite *SubscriptionSuite) TestShutdownDrainsInFlightMessages() {
+	ctx, cancel := context.WithCancel(suite.ctx)
+	defer cancel()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var handlerErr error
+
+	sub := subscribe.New(func(ctx context.Context, _ []byte) error {
+		close(started)
+		<-release
+		handlerErr = ctx.Err()
+		return nil
+	}, suite.opt, suite.metr)
+
+	stopped := make(chan struct{})
+	go func() {
+		sub.Start(ctx)
+		close(stopped)
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	suite.True(sub.Ready())
+
+	suite.Require().NoError(suite.topic.Send(suite.ctx, &pubsub.Message{Body: []byte("payload")}))
+	<-started
+
+	cancel()
+	time.Sleep(50 * time.Millisecond)
+	suite.False(sub.Ready())
+
+	select {
+	case <-stopped:
+		suite.Fail("subscription stopped before in-flight message finished")
+	default:
+	}
+
+	close(release)
+
+	select {
+	case <-stopped:
+	case <-time.After(2 * time.Second):
+		suite.Fail("subscription did not stop after draining")
+	}
+	suite.NoError(handlerErr)
+}
+
+func (suite *SubscriptionSuite) TestShutdownCancelsHandlersAfterDrainTimeout() {
+	suite.opt.DrainTimeout = 50 * time.Millisecond
+	ctx, cancel := context.WithCancel(suite.ctx)
+	defer cancel()
+
+	started := make(chan struct{})
+	interrupted := make(chan error, 1)
+
+	sub := subscribe.New(func(ctx context.Context, _ []byte) error {
+		close(started)
+		<-ctx.Done()
+		interrupted <- ctx.Err()
+		return ctx.Err()
+	}, suite.opt, suite.metr)
+
+	stopped := make(chan struct{})
+	go func() {
+		sub.Start(ctx)
+		close(stopped)
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	suite.Require().NoError(suite.topic.Send(suite.ctx, &pubsub.Message{Body: []byte("payload")}))
+	<-started
+
+	cancel()
+
+	select {
+	case <-stopped:
+	case <-time.After(2 * time.Second):
+		suite.Fail("subscription did not stop after drain timeout")
+	}
+
+	select {
+	case err := <-interrupted:
+		suite.ErrorIs(err, context.Canceled)
+	case <-time.After(time.Second):
+		suite.Fail("handler was not cancelled")
+	}
+	suite.False(sub.Ready())
+	suite.Zero(suite.deadLetters(subscribe.ReasonMaxRetriesExceeded))
+}
+
+func (suite *SubscriptionSuite) TestOrderingKeyProcessesSameKeySerially() {
+	suite.opt.OrderingKey = "deliverymanId"
+	suite.opt.MaxConcurrentMessages = 4
+
+	var mu sync.Mutex
+	active := map[string]int{}
+	overlapped := false
+	done := make(chan struct{}, 20)
+
+	go subscribe.New(func(ctx context.Context, m []byte) error {
+		key, _, _ := strings.Cut(string(m), ":")
+
+		mu.Lock()
+		active[key]++
+		if active[key] > 1 {
+			overlapped = true
+		}
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		active[key]--
+		mu.Unlock()
+
+		done <- struct{}{}
+		return nil
+	}, suite.opt, suite.metr).Start(suite.ctx)
+
+	time.Sleep(100 * time.Millisecond)
+
+	for i := 0; i < 10; i++ {
+		for _, key := range []string{"5f0c4a1e", "9d2b7c3f"} {
+			suite.Require().NoError(suite.topic.Send(suite.ctx, &pubsub.Message{
+				Body:     []byte(fmt.Sprintf("%s:%d", key, i)),
+				Metadata: map[string]string{"deliverymanId": key},
+			}))
+		}
+	}
+
+	for i := 0; i < 20; i++ {
+		select {
+		case <-done:
+		case <-time.After(3 * time.Second):
+			suite.FailNow("messages were not processed")
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	suite.False(overlapped, "messages with the same ordering key were processed concurrently")
+}
+
+func (suite *SubscriptionSuite) TestOrderingKeyProcessesKeysConcurrently() {
+	suite.opt.OrderingKey = "deliverymanId"
+	suite.opt.MaxConcurrentMessages = 4
+
+	blocked := make(chan struct{})
+	released := make(chan struct{})
+
+	go subscribe.New(func(ctx context.Context, m []byte) error {
+		if string(m) == "first" {
+			close(blocked)
+			<-released
+			return nil
+		}
+		close(released)
+		return nil
+	}, suite.opt, suite.metr).Start(suite.ctx)
+
+	time.Sleep(100 * time.Millisecond)
+
+	// keys chosen to land on different lanes.
+	suite.Require().NoError(suite.topic.Send(suite.ctx, &pubsub.Message{
+		Body:     []byte("first"),
+		Metadata: map[string]string{"deliverymanId": "a"},
+	}))
+	<-blocked
+	suite.Require().NoError(suite.topic.Send(suite.ctx, &pubsub.Message{
+		Body:     []byte("second"),
+		Metadata: map[string]string{"deliverymanId": "b"},
+	}))
+
+	select {
+	case <-released:
+	case <-time.After(2 * time.Second):
+		suite.Fail("a blocked key held back another key")
+	}
+}
+
+func (suite *SubscriptionSuite) TestHighImportanceIsDispatchedFirst() {
+	suite.opt.MaxConcurrentMessages = 1
+
+	started := make(chan string, 3)
+	release := make(chan struct{})
+
+	go subscribe.New(func(ctx context.Context, m []byte) error {
+		started <- string(m)
+		<-release
+		return nil
+	}, suite.opt, suite.metr).Start(suite.ctx)
+
+	time.Sleep(100 * time.Millisecond)
+
+	send := func(body, importance string) {
+		suite.Require().NoError(suite.topic.Send(suite.ctx, &pubsub.Message{
+			Body:     []byte(body),
+			Metadata: map[string]string{envelope.HeaderImportance: importance},
+		}))
+	}
+
+	send("blocker", envelope.ImportanceNormal)
+	select {
+	case <-started:
+	case <-time.After(2 * time.Second):
+		suite.FailNow("worker was not busy")
+	}
+
+	send("bulk", envelope.ImportanceLow)
+	send("express", envelope.ImportanceHigh)
+
+	// let the subscription buffer the backlog while the worker is busy.
+	time.Sleep(300 * time.Millisecond)
+	close(release)
+
+	var order []string
+	for i := 0; i < 2; i++ {
+		select {
+		case body := <-started:
+			order = append(order, body)
+		case <-time.After(2 * time.Second):
+			suite.FailNow("backlog was not processed")
+		}
+	}
+
+	suite.Equal([]string{"express", "bulk"}, order)
+}
+
+func (suite *SubscriptionSuite) TestOrderingKeyKeepsOrderAcrossImportance() {
+	suite.opt.OrderingKey = "deliverymanId"
+	suite.opt.MaxConcurrentMessages = 1
+
+	started := make(chan string, 32)
+	release := make(chan struct{})
+
+	go subscribe.New(func(ctx context.Context, m []byte) error {
+		started <- string(m)
+		<-release
+		return nil
+	}, suite.opt, suite.metr).Start(suite.ctx)
+
+	time.Sleep(100 * time.Millisecond)
+
+	send := func(body, key, importance string) {
+		suite.Require().NoError(suite.topic.Send(suite.ctx, &pubsub.Message{
+			Body: []byte(body),
+			Metadata: map[string]string{
+				"deliverymanId":           key,
+				envelope.HeaderImportance: importance,
+			},
+		}))
+	}
+
+	send("blocker", "c7e1", envelope.ImportanceNormal)
+	select {
+	case <-started:
+	case <-time.After(2 * time.Second):
+		suite.FailNow("worker was not busy")
+	}
+
+	// fill the lane so the next messages wait in the scheduler.
+	for i := 0; i < 16; i++ {
+		send(fmt.Sprintf("filler-%d", i), "c7e1", envelope.ImportanceNormal)
+	}
+	// mempubsub does not keep the send order, each message is received
+	// before the next one is sent.
+	time.Sleep(500 * time.Millisecond)
+	send("assigned", "5f0c", envelope.ImportanceLow)
+	time.Sleep(500 * time.Millisecond)
+	send("delivered", "5f0c", envelope.ImportanceHigh)
+
+	time.Sleep(time.Second)
+	close(release)
+
+	var order []string
+	for i := 0; i < 18; i++ {
+		select {
+		case body := <-started:
+			if !strings.HasPrefix(body, "filler-") {
+				order = append(order, body)
+			}
+		case <-time.After(2 * time.Second):
+			suite.FailNow("backlog was not processed")
+		}
+	}
+
+	suite.Equal([]string{"assigned", "delivered"}, order)
+}
+
+type gate struct {
+	open chan struct{}
+}
+
+func (g *gate) Wait(ctx context.Context) error {
+	select {
+	case <-g.open:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (suite *SubscriptionSuite) TestGatePausesReceiving() {
+	g := &gate{open: make(chan struct{})}
+	calls := make(chan struct{}, 1)
+	go subscribe.New(func(_ context.Context, _ []byte) error {
+		calls <- struct{}{}
+		return nil
+	}, suite.opt, suite.metr).WithGate(g).Start(suite.ctx)
+
+	time.Sleep(100 * time.Millisecond)
+	suite.Require().NoError(suite.topic.Send(suite.ctx, &pubsub.Message{Body: []byte("payload")}))
+
+	select {
+	case <-calls:
+		suite.FailNow("message received while the gate was closed")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	close(g.open)
+
+	select {
+	case <-calls:
+	case <-time.After(2 * time.Second):
+		suite.Fail("message was not received after the gate opened")
+	}
+}
+
+func (suite *SubscriptionSuite) TestCircuitOpenNacksWithoutRetry() {
+	attempts := make(chan struct{}, suite.opt.MaxRetries)
+	done := make(chan struct{})
+	suite.start(func(_ context.Context, _ []byte) error {
+		attempts <- struct{}{}
+		if len(attempts) == 1 {
+			return fmt.Errorf("err while integration save: %w", shared.ErrCircuitOpen)
+		}
+		close(done)
+		return nil
+	})
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		suite.FailNow("message was not redelivered")
+	}
+
+	suite.Equal(float64(0), suite.value("business_service_events_retries"))
+	suite.Equal(float64(0), suite.value("business_service_events_dead_letters"))
+}
+
+func TestSubscriptionSuite(t *testing.T) {
+	suite.Run(t, new(SubscriptionSuite))
+}
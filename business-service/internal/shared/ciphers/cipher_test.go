@@ -1,9 +1,14 @@
 package ciphers_test
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
 	"reflect"
 	"testing"
 
+	"golang.org/x/crypto/pbkdf2"
+
 	"github.com/lucasd-coder/fast-feet/business-service/internal/shared/ciphers"
 )
 
@@ -52,3 +57,92 @@ func TestDecrypt(t *testing.T) {
 		})
 	}
 }
+
+func TestOpen(t *testing.T) {
+	sealed, _ := ciphers.Seal("v2", []byte("key2"), []byte("value"))
+	tests := []struct {
+		name    string
+		secrets map[string]string
+		sealed  []byte
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "test open with rotated keys",
+			secrets: map[string]string{"v1": "key1", "v2": "key2"},
+			sealed:  sealed,
+			want:    []byte("value"),
+			wantErr: false,
+		},
+		{
+			name:    "test fail open unknown key id",
+			secrets: map[string]string{"v1": "key1"},
+			sealed:  sealed,
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "test fail open wrong secret",
+			secrets: map[string]string{"v2": "key1"},
+			sealed:  sealed,
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ciphers.Open(tt.secrets, tt.sealed)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Open() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Open() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSealUsesRandomSalt(t *testing.T) {
+	first, _ := ciphers.Seal("v1", []byte("key"), []byte("value"))
+	second, _ := ciphers.Seal("v1", []byte("key"), []byte("value"))
+
+	if reflect.DeepEqual(first, second) {
+		t.Errorf("Seal() produced the same output twice")
+	}
+	if !ciphers.IsSealed(first) {
+		t.Errorf("IsSealed() = false, want true")
+	}
+}
+
+// sealPBKDF2 builds a message of version 1, sealed before the key derivation
+// moved to HKDF.
+func sealPBKDF2(t *testing.T, keyID string, secret, plaintext []byte) []byte {
+	salt := make([]byte, 16)
+	nonce := make([]byte, 12)
+
+	header := append([]byte{0xFF, 0xFE, 1, byte(len(keyID))}, keyID...)
+	block, err := aes.NewCipher(pbkdf2.Key(secret, salt, 10000, 32, sha256.New))
+	if err != nil {
+		t.Fatal(err)
+	}
+	aesgcm, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := append(append(append([]byte{}, header...), salt...), nonce...)
+	return aesgcm.Seal(out, nonce, plaintext, header)
+}
+
+func TestOpenPBKDF2Sealed(t *testing.T) {
+	sealed := sealPBKDF2(t, "v1", []byte("key1"), []byte("value"))
+
+	got, err := ciphers.Open(map[string]string{"v1": "key1"}, sealed)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, []byte("value")) {
+		t.Errorf("Open() = %v, want %v", got, []byte("value"))
+	}
+}
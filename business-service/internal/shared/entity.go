@@ -20,6 +20,15 @@ type GetUserResponse struct {
 	Enabled  bool   `json:"enabled,omitempty"`
 }
 
+type GetAccountResponse struct {
+	ID        string   `json:"id,omitempty"`
+	Email     string   `json:"email,omitempty"`
+	Username  string   `json:"username,omitempty"`
+	Enabled   bool     `json:"enabled,omitempty"`
+	Roles     []string `json:"roles,omitempty"`
+	CreatedAt string   `json:"createdAt,omitempty"`
+}
+
 type GetRolesResponse struct {
 	Roles []string `json:"roles,omitempty"`
 }
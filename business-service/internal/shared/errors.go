@@ -14,7 +14,17 @@ var ErrExtractResponse = errors.New("error while extracting response from API")
 var ErrUserNotFound = errors.New("user not found")
 var ErrUserAlreadyExist = errors.New("user already exist")
 var ErrCipherText = errors.New("cipher text too short")
+var ErrUnknownKeyID = errors.New("unknown encryption key id")
 var ErrUserUnauthorized = errors.New("error mission not permission")
+var ErrUnprocessableMessage = errors.New("unprocessable message")
+var ErrCircuitOpen = errors.New("circuit breaker open")
+var ErrScheduledOrderNotFound = errors.New("scheduled order not found")
+var ErrScheduledOrderFired = errors.New("scheduled order already fired")
+var ErrErasureNotFound = errors.New("erasure not found")
+var ErrRegistrationNotFound = errors.New("registration not found")
+var ErrAccountWithoutProfile = errors.New("account already registered without a profile")
+var ErrRegistrationInProgress = errors.New("registration in progress")
+var ErrDeliverymanUnavailable = errors.New("deliveryman unavailable")
 
 type HTTPError struct {
 	StatusCode int
@@ -46,6 +56,10 @@ func NotFoundError(err error) error {
 	return status.Errorf(codes.NotFound, "not found: %s", err)
 }
 
+func FailedPreconditionError(err error) error {
+	return status.Errorf(codes.FailedPrecondition, "failed precondition: %s", err)
+}
+
 func ValidationErrors(err error) error {
 	var valErrs validator.ValidationErrors
 
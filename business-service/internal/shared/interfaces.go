@@ -12,6 +12,8 @@ type Validator interface {
 type CacheRepository[T any] interface {
 	Save(ctx context.Context, key string, value T, ttl time.Duration) error
 	Get(ctx context.Context, key string) (string, error)
+	SaveIfAbsent(ctx context.Context, key string, value T, ttl time.Duration) (bool, error)
+	Delete(ctx context.Context, key string) error
 }
 
 type AuthRepository interface {
@@ -19,4 +21,10 @@ type AuthRepository interface {
 	FindByEmail(ctx context.Context, email string) (*GetUserResponse, error)
 	FindRolesByID(ctx context.Context, id string) (*GetRolesResponse, error)
 	IsActiveUser(ctx context.Context, id string) (*IsActiveUser, error)
+	UpdateEmail(ctx context.Context, id, email string) (*GetUserResponse, error)
+	DeleteUser(ctx context.Context, id string) error
+	DisableUser(ctx context.Context, id string) (*GetUserResponse, error)
+	EnableUser(ctx context.Context, id string) (*GetUserResponse, error)
+	GetAccount(ctx context.Context, id string) (*GetAccountResponse, error)
+	GetAccounts(ctx context.Context, ids []string) ([]*GetAccountResponse, error)
 }
@@ -14,6 +14,17 @@ type Options struct {
 	MaxReceiveMessage        time.Duration
 	PollDelay                time.Duration
 	QueueURL                 string
+	DeadLetterURL            string
+	RetryURL                 string
+	MaxBackoff               time.Duration
+	DrainTimeout             time.Duration
+	DedupTTL                 time.Duration
+	DedupProcessingTTL       time.Duration
+	OrderingKey              string
+	Exchange                 string
+	MaxPriority              int
+	ManagementURL            string
+	QueueDepthInterval       time.Duration
 }
 
 func NewOptionQueueUserEvents(cfg *config.Config) *Options {
@@ -25,6 +36,17 @@ func NewOptionQueueUserEvents(cfg *config.Config) *Options {
 		MaxReceiveMessage:        cfg.QueueUserEvents.MaxReceiveMessage,
 		PollDelay:                cfg.QueueUserEvents.PollDelay,
 		QueueURL:                 cfg.QueueUserEvents.QueueURL,
+		DeadLetterURL:            cfg.QueueUserEvents.DeadLetterURL,
+		RetryURL:                 cfg.QueueUserEvents.RetryURL,
+		MaxBackoff:               cfg.QueueUserEvents.MaxBackoff,
+		DrainTimeout:             cfg.QueueUserEvents.DrainTimeout,
+		DedupTTL:                 cfg.QueueUserEvents.DedupTTL,
+		DedupProcessingTTL:       cfg.QueueUserEvents.DedupProcessingTTL,
+		OrderingKey:              cfg.QueueUserEvents.OrderingKey,
+		Exchange:                 cfg.QueueUserEvents.Exchange,
+		MaxPriority:              cfg.QueueUserEvents.MaxPriority,
+		ManagementURL:            cfg.ManagementURL,
+		QueueDepthInterval:       cfg.QueueDepthInterval,
 	}
 }
 
@@ -37,5 +59,39 @@ func NewOptionOrderEvents(cfg *config.Config) *Options {
 		MaxReceiveMessage:        cfg.QueueOrderEvents.MaxReceiveMessage,
 		PollDelay:                cfg.QueueOrderEvents.PollDelay,
 		QueueURL:                 cfg.QueueOrderEvents.QueueURL,
+		DeadLetterURL:            cfg.QueueOrderEvents.DeadLetterURL,
+		RetryURL:                 cfg.QueueOrderEvents.RetryURL,
+		MaxBackoff:               cfg.QueueOrderEvents.MaxBackoff,
+		DrainTimeout:             cfg.QueueOrderEvents.DrainTimeout,
+		DedupTTL:                 cfg.QueueOrderEvents.DedupTTL,
+		DedupProcessingTTL:       cfg.QueueOrderEvents.DedupProcessingTTL,
+		OrderingKey:              cfg.QueueOrderEvents.OrderingKey,
+		Exchange:                 cfg.QueueOrderEvents.Exchange,
+		MaxPriority:              cfg.QueueOrderEvents.MaxPriority,
+		ManagementURL:            cfg.ManagementURL,
+		QueueDepthInterval:       cfg.QueueDepthInterval,
+	}
+}
+
+func NewOptionQueueUserStatusEvents(cfg *config.Config) *Options {
+	return &Options{
+		MaxConcurrentMessages:    cfg.QueueUserStatusEvents.MaxConcurrentMessages,
+		MaxRetries:               cfg.QueueUserStatusEvents.MaxRetries,
+		WaitingTime:              cfg.QueueUserStatusEvents.WaitingTime,
+		NumberOfMessageReceivers: cfg.QueueUserStatusEvents.NumberOfMessageReceivers,
+		MaxReceiveMessage:        cfg.QueueUserStatusEvents.MaxReceiveMessage,
+		PollDelay:                cfg.QueueUserStatusEvents.PollDelay,
+		QueueURL:                 cfg.QueueUserStatusEvents.QueueURL,
+		DeadLetterURL:            cfg.QueueUserStatusEvents.DeadLetterURL,
+		RetryURL:                 cfg.QueueUserStatusEvents.RetryURL,
+		MaxBackoff:               cfg.QueueUserStatusEvents.MaxBackoff,
+		DrainTimeout:             cfg.QueueUserStatusEvents.DrainTimeout,
+		DedupTTL:                 cfg.QueueUserStatusEvents.DedupTTL,
+		DedupProcessingTTL:       cfg.QueueUserStatusEvents.DedupProcessingTTL,
+		OrderingKey:              cfg.QueueUserStatusEvents.OrderingKey,
+		Exchange:                 cfg.QueueUserStatusEvents.Exchange,
+		MaxPriority:              cfg.QueueUserStatusEvents.MaxPriority,
+		ManagementURL:            cfg.ManagementURL,
+		QueueDepthInterval:       cfg.QueueDepthInterval,
 	}
 }
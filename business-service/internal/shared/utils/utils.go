@@ -1,12 +1,21 @@
 package utils
 
-import "strings"
+import (
+	"net/url"
+	"strings"
+)
 
+// ExtractQueueName returns the queue name of a broker URL regardless of its
+// scheme, kafka subscriptions carry the topic as a query parameter.
 func ExtractQueueName(queueURL string) string {
-	index := 2
-	parts := strings.SplitN(queueURL, "rabbit://", index)
+	u, err := url.Parse(queueURL)
+	if err != nil || u.Scheme == "" {
+		return queueURL
+	}
 
-	queueName := parts[1]
+	if topic := u.Query().Get("topic"); topic != "" {
+		return topic
+	}
 
-	return queueName
+	return strings.TrimPrefix(u.Host+u.Path, "/")
 }
@@ -0,0 +1,28 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/lucasd-coder/fast-feet/business-service/internal/shared/utils"
+)
+
+func TestExtractQueueName(t *testing.T) {
+	tests := []struct {
+		name     string
+		queueURL string
+		want     string
+	}{
+		{name: "rabbit", queueURL: "rabbit://business-service-user-events", want: "business-service-user-events"},
+		{name: "mem", queueURL: "mem://router-service-user-events", want: "router-service-user-events"},
+		{name: "nats", queueURL: "nats://business-service.user-events", want: "business-service.user-events"},
+		{name: "kafka topic", queueURL: "kafka://business-service?topic=user-events", want: "user-events"},
+		{name: "without scheme", queueURL: "business-service-user-events", want: "business-service-user-events"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := utils.ExtractQueueName(tt.queueURL); got != tt.want {
+				t.Errorf("ExtractQueueName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.21.12
+// source: request/attribute_schema_request.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AttributeField describes one key of the user attributes, type is STRING,
+// NUMBER, BOOLEAN or DATE (yyyy-mm-dd) and a maxLength of 0 means no limit.
+type AttributeField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key       string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Pattern   string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Required  bool   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	MaxLength int32  `protobuf:"varint,5,opt,name=maxLength,proto3" json:"maxLength,omitempty"`
+}
+
+func (x *AttributeField) Reset() {
+	*x = AttributeField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_request_attribute_schema_request_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeField) ProtoMessage() {}
+
+func (x *AttributeField) ProtoReflect() protoreflect.Message {
+	mi := &file_request_attribute_schema_request_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeField.ProtoReflect.Descriptor instead.
+func (*AttributeField) Descriptor() ([]byte, []int) {
+	return file_request_attribute_schema_request_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AttributeField) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AttributeField) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AttributeField) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *AttributeField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *AttributeField) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+// AttributeSchemaRequest replaces the whole schema, an empty schema accepts
+// any attribute.
+type AttributeSchemaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []*AttributeField `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *AttributeSchemaRequest) Reset() {
+	*x = AttributeSchemaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_request_attribute_schema_request_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeSchemaRequest) ProtoMessage() {}
+
+func (x *AttributeSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_request_attribute_schema_request_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeSchemaRequest.ProtoReflect.Descriptor instead.
+func (*AttributeSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_request_attribute_schema_request_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttributeSchemaRequest) GetFields() []*AttributeField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type GetAttributeSchemaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetAttributeSchemaRequest) Reset() {
+	*x = GetAttributeSchemaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_request_attribute_schema_request_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAttributeSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttributeSchemaRequest) ProtoMessage() {}
+
+func (x *GetAttributeSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_request_attribute_schema_request_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttributeSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetAttributeSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_request_attribute_schema_request_proto_rawDescGZIP(), []int{2}
+}
+
+var File_request_attribute_schema_request_proto protoreflect.FileDescriptor
+
+var file_request_attribute_schema_request_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x8a, 0x01, 0x0a,
+	0x0e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x44, 0x0a, 0x16, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22,
+	0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x0a, 0x5a, 0x08,
+	0x2e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_request_attribute_schema_request_proto_rawDescOnce sync.Once
+	file_request_attribute_schema_request_proto_rawDescData = file_request_attribute_schema_request_proto_rawDesc
+)
+
+func file_request_attribute_schema_request_proto_rawDescGZIP() []byte {
+	file_request_attribute_schema_request_proto_rawDescOnce.Do(func() {
+		file_request_attribute_schema_request_proto_rawDescData = protoimpl.X.CompressGZIP(file_request_attribute_schema_request_proto_rawDescData)
+	})
+	return file_request_attribute_schema_request_proto_rawDescData
+}
+
+var file_request_attribute_schema_request_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_request_attribute_schema_request_proto_goTypes = []interface{}{
+	(*AttributeField)(nil),            // 0: pb.AttributeField
+	(*AttributeSchemaRequest)(nil),    // 1: pb.AttributeSchemaRequest
+	(*GetAttributeSchemaRequest)(nil), // 2: pb.GetAttributeSchemaRequest
+}
+var file_request_attribute_schema_request_proto_depIdxs = []int32{
+	0, // 0: pb.AttributeSchemaRequest.fields:type_name -> pb.AttributeField
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_request_attribute_schema_request_proto_init() }
+func file_request_attribute_schema_request_proto_init() {
+	if File_request_attribute_schema_request_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_request_attribute_schema_request_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_request_attribute_schema_request_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeSchemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_request_attribute_schema_request_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAttributeSchemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_request_attribute_schema_request_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_request_attribute_schema_request_proto_goTypes,
+		DependencyIndexes: file_request_attribute_schema_request_proto_depIdxs,
+		MessageInfos:      file_request_attribute_schema_request_proto_msgTypes,
+	}.Build()
+	File_request_attribute_schema_request_proto = out.File
+	file_request_attribute_schema_request_proto_rawDesc = nil
+	file_request_attribute_schema_request_proto_goTypes = nil
+	file_request_attribute_schema_request_proto_depIdxs = nil
+}
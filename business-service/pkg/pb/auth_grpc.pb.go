@@ -22,6 +22,12 @@ const (
 	AuthHandler_FindUserByEmail_FullMethodName = "/pb.AuthHandler/FindUserByEmail"
 	AuthHandler_GetRoles_FullMethodName        = "/pb.AuthHandler/GetRoles"
 	AuthHandler_IsActiveUser_FullMethodName    = "/pb.AuthHandler/IsActiveUser"
+	AuthHandler_UpdateEmail_FullMethodName     = "/pb.AuthHandler/UpdateEmail"
+	AuthHandler_DeleteUser_FullMethodName      = "/pb.AuthHandler/DeleteUser"
+	AuthHandler_GetAccount_FullMethodName      = "/pb.AuthHandler/GetAccount"
+	AuthHandler_GetAccounts_FullMethodName     = "/pb.AuthHandler/GetAccounts"
+	AuthHandler_DisableUser_FullMethodName     = "/pb.AuthHandler/DisableUser"
+	AuthHandler_EnableUser_FullMethodName      = "/pb.AuthHandler/EnableUser"
 )
 
 // AuthHandlerClient is the client API for AuthHandler service.
@@ -31,6 +37,12 @@ type AuthHandlerClient interface {
 	FindUserByEmail(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	GetRoles(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetRolesResponse, error)
 	IsActiveUser(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*IsActiveUserResponse, error)
+	UpdateEmail(ctx context.Context, in *UpdateEmailRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	DeleteUser(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	GetAccount(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+	GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error)
+	DisableUser(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	EnableUser(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 }
 
 type authHandlerClient struct {
@@ -68,6 +80,60 @@ func (c *authHandlerClient) IsActiveUser(ctx context.Context, in *EmptyRequest,
 	return out, nil
 }
 
+func (c *authHandlerClient) UpdateEmail(ctx context.Context, in *UpdateEmailRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, AuthHandler_UpdateEmail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authHandlerClient) DeleteUser(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, AuthHandler_DeleteUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authHandlerClient) GetAccount(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetAccountResponse, error) {
+	out := new(GetAccountResponse)
+	err := c.cc.Invoke(ctx, AuthHandler_GetAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authHandlerClient) GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error) {
+	out := new(GetAccountsResponse)
+	err := c.cc.Invoke(ctx, AuthHandler_GetAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authHandlerClient) DisableUser(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, AuthHandler_DisableUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authHandlerClient) EnableUser(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, AuthHandler_EnableUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthHandlerServer is the server API for AuthHandler service.
 // All implementations must embed UnimplementedAuthHandlerServer
 // for forward compatibility
@@ -75,6 +141,12 @@ type AuthHandlerServer interface {
 	FindUserByEmail(context.Context, *EmptyRequest) (*GetUserResponse, error)
 	GetRoles(context.Context, *EmptyRequest) (*GetRolesResponse, error)
 	IsActiveUser(context.Context, *EmptyRequest) (*IsActiveUserResponse, error)
+	UpdateEmail(context.Context, *UpdateEmailRequest) (*GetUserResponse, error)
+	DeleteUser(context.Context, *EmptyRequest) (*EmptyResponse, error)
+	GetAccount(context.Context, *EmptyRequest) (*GetAccountResponse, error)
+	GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error)
+	DisableUser(context.Context, *EmptyRequest) (*GetUserResponse, error)
+	EnableUser(context.Context, *EmptyRequest) (*GetUserResponse, error)
 	mustEmbedUnimplementedAuthHandlerServer()
 }
 
@@ -91,6 +163,24 @@ func (UnimplementedAuthHandlerServer) GetRoles(context.Context, *EmptyRequest) (
 func (UnimplementedAuthHandlerServer) IsActiveUser(context.Context, *EmptyRequest) (*IsActiveUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsActiveUser not implemented")
 }
+func (UnimplementedAuthHandlerServer) UpdateEmail(context.Context, *UpdateEmailRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEmail not implemented")
+}
+func (UnimplementedAuthHandlerServer) DeleteUser(context.Context, *EmptyRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedAuthHandlerServer) GetAccount(context.Context, *EmptyRequest) (*GetAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedAuthHandlerServer) GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccounts not implemented")
+}
+func (UnimplementedAuthHandlerServer) DisableUser(context.Context, *EmptyRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableUser not implemented")
+}
+func (UnimplementedAuthHandlerServer) EnableUser(context.Context, *EmptyRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableUser not implemented")
+}
 func (UnimplementedAuthHandlerServer) mustEmbedUnimplementedAuthHandlerServer() {}
 
 // UnsafeAuthHandlerServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +248,114 @@ func _AuthHandler_IsActiveUser_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthHandler_UpdateEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthHandlerServer).UpdateEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthHandler_UpdateEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthHandlerServer).UpdateEmail(ctx, req.(*UpdateEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthHandler_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthHandlerServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthHandler_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthHandlerServer).DeleteUser(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthHandler_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthHandlerServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthHandler_GetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthHandlerServer).GetAccount(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthHandler_GetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthHandlerServer).GetAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthHandler_GetAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthHandlerServer).GetAccounts(ctx, req.(*GetAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthHandler_DisableUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthHandlerServer).DisableUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthHandler_DisableUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthHandlerServer).DisableUser(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthHandler_EnableUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthHandlerServer).EnableUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthHandler_EnableUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthHandlerServer).EnableUser(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthHandler_ServiceDesc is the grpc.ServiceDesc for AuthHandler service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +375,30 @@ var AuthHandler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsActiveUser",
 			Handler:    _AuthHandler_IsActiveUser_Handler,
 		},
+		{
+			MethodName: "UpdateEmail",
+			Handler:    _AuthHandler_UpdateEmail_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _AuthHandler_DeleteUser_Handler,
+		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _AuthHandler_GetAccount_Handler,
+		},
+		{
+			MethodName: "GetAccounts",
+			Handler:    _AuthHandler_GetAccounts_Handler,
+		},
+		{
+			MethodName: "DisableUser",
+			Handler:    _AuthHandler_DisableUser_Handler,
+		},
+		{
+			MethodName: "EnableUser",
+			Handler:    _AuthHandler_EnableUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "client/auth-service/auth.proto",
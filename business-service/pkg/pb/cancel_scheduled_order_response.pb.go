@@ -0,0 +1,154 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.21.12
+// source: response/cancel_scheduled_order_response.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CancelScheduledOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanceledAt string `protobuf:"bytes,2,opt,name=canceledAt,proto3" json:"canceledAt,omitempty"`
+}
+
+func (x *CancelScheduledOrderResponse) Reset() {
+	*x = CancelScheduledOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_cancel_scheduled_order_response_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledOrderResponse) ProtoMessage() {}
+
+func (x *CancelScheduledOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_cancel_scheduled_order_response_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledOrderResponse) Descriptor() ([]byte, []int) {
+	return file_response_cancel_scheduled_order_response_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CancelScheduledOrderResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelScheduledOrderResponse) GetCanceledAt() string {
+	if x != nil {
+		return x.CanceledAt
+	}
+	return ""
+}
+
+var File_response_cancel_scheduled_order_response_proto protoreflect.FileDescriptor
+
+var file_response_cancel_scheduled_order_response_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2f, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x70, 0x62, 0x22, 0x4e, 0x0a, 0x1c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x65, 0x64, 0x41, 0x74, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_response_cancel_scheduled_order_response_proto_rawDescOnce sync.Once
+	file_response_cancel_scheduled_order_response_proto_rawDescData = file_response_cancel_scheduled_order_response_proto_rawDesc
+)
+
+func file_response_cancel_scheduled_order_response_proto_rawDescGZIP() []byte {
+	file_response_cancel_scheduled_order_response_proto_rawDescOnce.Do(func() {
+		file_response_cancel_scheduled_order_response_proto_rawDescData = protoimpl.X.CompressGZIP(file_response_cancel_scheduled_order_response_proto_rawDescData)
+	})
+	return file_response_cancel_scheduled_order_response_proto_rawDescData
+}
+
+var file_response_cancel_scheduled_order_response_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_response_cancel_scheduled_order_response_proto_goTypes = []interface{}{
+	(*CancelScheduledOrderResponse)(nil), // 0: pb.CancelScheduledOrderResponse
+}
+var file_response_cancel_scheduled_order_response_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_response_cancel_scheduled_order_response_proto_init() }
+func file_response_cancel_scheduled_order_response_proto_init() {
+	if File_response_cancel_scheduled_order_response_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_response_cancel_scheduled_order_response_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_response_cancel_scheduled_order_response_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_response_cancel_scheduled_order_response_proto_goTypes,
+		DependencyIndexes: file_response_cancel_scheduled_order_response_proto_depIdxs,
+		MessageInfos:      file_response_cancel_scheduled_order_response_proto_msgTypes,
+	}.Build()
+	File_response_cancel_scheduled_order_response_proto = out.File
+	file_response_cancel_scheduled_order_response_proto_rawDesc = nil
+	file_response_cancel_scheduled_order_response_proto_goTypes = nil
+	file_response_cancel_scheduled_order_response_proto_depIdxs = nil
+}
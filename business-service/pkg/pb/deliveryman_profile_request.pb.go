@@ -0,0 +1,187 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.21.12
+// source: request/deliveryman_profile_request.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DeliverymanProfileRequest creates or replaces the vehicle and licence of
+// the deliveryman, the avatar and availability are kept.
+type DeliverymanProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	VehicleType   string `protobuf:"bytes,2,opt,name=vehicleType,proto3" json:"vehicleType,omitempty"`
+	VehiclePlate  string `protobuf:"bytes,3,opt,name=vehiclePlate,proto3" json:"vehiclePlate,omitempty"`
+	LicenseNumber string `protobuf:"bytes,4,opt,name=licenseNumber,proto3" json:"licenseNumber,omitempty"`
+	LicenseExpiry string `protobuf:"bytes,5,opt,name=licenseExpiry,proto3" json:"licenseExpiry,omitempty"`
+}
+
+func (x *DeliverymanProfileRequest) Reset() {
+	*x = DeliverymanProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_request_deliveryman_profile_request_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliverymanProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverymanProfileRequest) ProtoMessage() {}
+
+func (x *DeliverymanProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_request_deliveryman_profile_request_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverymanProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeliverymanProfileRequest) Descriptor() ([]byte, []int) {
+	return file_request_deliveryman_profile_request_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeliverymanProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeliverymanProfileRequest) GetVehicleType() string {
+	if x != nil {
+		return x.VehicleType
+	}
+	return ""
+}
+
+func (x *DeliverymanProfileRequest) GetVehiclePlate() string {
+	if x != nil {
+		return x.VehiclePlate
+	}
+	return ""
+}
+
+func (x *DeliverymanProfileRequest) GetLicenseNumber() string {
+	if x != nil {
+		return x.LicenseNumber
+	}
+	return ""
+}
+
+func (x *DeliverymanProfileRequest) GetLicenseExpiry() string {
+	if x != nil {
+		return x.LicenseExpiry
+	}
+	return ""
+}
+
+var File_request_deliveryman_profile_request_proto protoreflect.FileDescriptor
+
+var file_request_deliveryman_profile_request_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x6d, 0x61, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22,
+	0xc5, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x6d, 0x61, 0x6e, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x76, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6c,
+	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73,
+	0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_request_deliveryman_profile_request_proto_rawDescOnce sync.Once
+	file_request_deliveryman_profile_request_proto_rawDescData = file_request_deliveryman_profile_request_proto_rawDesc
+)
+
+func file_request_deliveryman_profile_request_proto_rawDescGZIP() []byte {
+	file_request_deliveryman_profile_request_proto_rawDescOnce.Do(func() {
+		file_request_deliveryman_profile_request_proto_rawDescData = protoimpl.X.CompressGZIP(file_request_deliveryman_profile_request_proto_rawDescData)
+	})
+	return file_request_deliveryman_profile_request_proto_rawDescData
+}
+
+var file_request_deliveryman_profile_request_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_request_deliveryman_profile_request_proto_goTypes = []interface{}{
+	(*DeliverymanProfileRequest)(nil), // 0: pb.DeliverymanProfileRequest
+}
+var file_request_deliveryman_profile_request_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_request_deliveryman_profile_request_proto_init() }
+func file_request_deliveryman_profile_request_proto_init() {
+	if File_request_deliveryman_profile_request_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_request_deliveryman_profile_request_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeliverymanProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_request_deliveryman_profile_request_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_request_deliveryman_profile_request_proto_goTypes,
+		DependencyIndexes: file_request_deliveryman_profile_request_proto_depIdxs,
+		MessageInfos:      file_request_deliveryman_profile_request_proto_msgTypes,
+	}.Build()
+	File_request_deliveryman_profile_request_proto = out.File
+	file_request_deliveryman_profile_request_proto_rawDesc = nil
+	file_request_deliveryman_profile_request_proto_goTypes = nil
+	file_request_deliveryman_profile_request_proto_depIdxs = nil
+}
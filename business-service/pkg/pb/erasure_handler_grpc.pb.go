@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: handler/erasure_handler.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ErasureHandler_EraseUser_FullMethodName  = "/pb.ErasureHandler/EraseUser"
+	ErasureHandler_GetErasure_FullMethodName = "/pb.ErasureHandler/GetErasure"
+)
+
+// ErasureHandlerClient is the client API for ErasureHandler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ErasureHandlerClient interface {
+	EraseUser(ctx context.Context, in *EraseUserRequest, opts ...grpc.CallOption) (*ErasureResponse, error)
+	GetErasure(ctx context.Context, in *EraseUserRequest, opts ...grpc.CallOption) (*ErasureResponse, error)
+}
+
+type erasureHandlerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewErasureHandlerClient(cc grpc.ClientConnInterface) ErasureHandlerClient {
+	return &erasureHandlerClient{cc}
+}
+
+func (c *erasureHandlerClient) EraseUser(ctx context.Context, in *EraseUserRequest, opts ...grpc.CallOption) (*ErasureResponse, error) {
+	out := new(ErasureResponse)
+	err := c.cc.Invoke(ctx, ErasureHandler_EraseUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *erasureHandlerClient) GetErasure(ctx context.Context, in *EraseUserRequest, opts ...grpc.CallOption) (*ErasureResponse, error) {
+	out := new(ErasureResponse)
+	err := c.cc.Invoke(ctx, ErasureHandler_GetErasure_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ErasureHandlerServer is the server API for ErasureHandler service.
+// All implementations must embed UnimplementedErasureHandlerServer
+// for forward compatibility
+type ErasureHandlerServer interface {
+	EraseUser(context.Context, *EraseUserRequest) (*ErasureResponse, error)
+	GetErasure(context.Context, *EraseUserRequest) (*ErasureResponse, error)
+	mustEmbedUnimplementedErasureHandlerServer()
+}
+
+// UnimplementedErasureHandlerServer must be embedded to have forward compatible implementations.
+type UnimplementedErasureHandlerServer struct {
+}
+
+func (UnimplementedErasureHandlerServer) EraseUser(context.Context, *EraseUserRequest) (*ErasureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseUser not implemented")
+}
+func (UnimplementedErasureHandlerServer) GetErasure(context.Context, *EraseUserRequest) (*ErasureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetErasure not implemented")
+}
+func (UnimplementedErasureHandlerServer) mustEmbedUnimplementedErasureHandlerServer() {}
+
+// UnsafeErasureHandlerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ErasureHandlerServer will
+// result in compilation errors.
+type UnsafeErasureHandlerServer interface {
+	mustEmbedUnimplementedErasureHandlerServer()
+}
+
+func RegisterErasureHandlerServer(s grpc.ServiceRegistrar, srv ErasureHandlerServer) {
+	s.RegisterService(&ErasureHandler_ServiceDesc, srv)
+}
+
+func _ErasureHandler_EraseUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ErasureHandlerServer).EraseUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ErasureHandler_EraseUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ErasureHandlerServer).EraseUser(ctx, req.(*EraseUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ErasureHandler_GetErasure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ErasureHandlerServer).GetErasure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ErasureHandler_GetErasure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ErasureHandlerServer).GetErasure(ctx, req.(*EraseUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ErasureHandler_ServiceDesc is the grpc.ServiceDesc for ErasureHandler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ErasureHandler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.ErasureHandler",
+	HandlerType: (*ErasureHandlerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EraseUser",
+			Handler:    _ErasureHandler_EraseUser_Handler,
+		},
+		{
+			MethodName: "GetErasure",
+			Handler:    _ErasureHandler_GetErasure_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "handler/erasure_handler.proto",
+}
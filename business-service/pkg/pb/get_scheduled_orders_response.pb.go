@@ -0,0 +1,306 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.21.12
+// source: response/get_scheduled_orders_response.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ScheduledOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string   `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	DeliverymanId string   `protobuf:"bytes,3,opt,name=deliverymanId,proto3" json:"deliverymanId,omitempty"`
+	Product       *Product `protobuf:"bytes,4,opt,name=product,proto3" json:"product,omitempty"`
+	Addresses     *Address `protobuf:"bytes,5,opt,name=addresses,proto3" json:"addresses,omitempty"`
+	ScheduledFor  string   `protobuf:"bytes,6,opt,name=scheduledFor,proto3" json:"scheduledFor,omitempty"`
+	CreatedAt     string   `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Status        string   `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	FailedAt      string   `protobuf:"bytes,9,opt,name=failedAt,proto3" json:"failedAt,omitempty"`
+	FailureReason string   `protobuf:"bytes,10,opt,name=failureReason,proto3" json:"failureReason,omitempty"`
+}
+
+func (x *ScheduledOrder) Reset() {
+	*x = ScheduledOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_get_scheduled_orders_response_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledOrder) ProtoMessage() {}
+
+func (x *ScheduledOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_response_get_scheduled_orders_response_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledOrder.ProtoReflect.Descriptor instead.
+func (*ScheduledOrder) Descriptor() ([]byte, []int) {
+	return file_response_get_scheduled_orders_response_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ScheduledOrder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduledOrder) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ScheduledOrder) GetDeliverymanId() string {
+	if x != nil {
+		return x.DeliverymanId
+	}
+	return ""
+}
+
+func (x *ScheduledOrder) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *ScheduledOrder) GetAddresses() *Address {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *ScheduledOrder) GetScheduledFor() string {
+	if x != nil {
+		return x.ScheduledFor
+	}
+	return ""
+}
+
+func (x *ScheduledOrder) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ScheduledOrder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScheduledOrder) GetFailedAt() string {
+	if x != nil {
+		return x.FailedAt
+	}
+	return ""
+}
+
+func (x *ScheduledOrder) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+type GetScheduledOrdersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orders []*ScheduledOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+}
+
+func (x *GetScheduledOrdersResponse) Reset() {
+	*x = GetScheduledOrdersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_get_scheduled_orders_response_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledOrdersResponse) ProtoMessage() {}
+
+func (x *GetScheduledOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_get_scheduled_orders_response_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledOrdersResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduledOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_response_get_scheduled_orders_response_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetScheduledOrdersResponse) GetOrders() []*ScheduledOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+var File_response_get_scheduled_orders_response_proto protoreflect.FileDescriptor
+
+var file_response_get_scheduled_orders_response_proto_rawDesc = []byte{
+	0x0a, 0x2c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2f, 0x67, 0x65, 0x74, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
+	0x70, 0x62, 0x1a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcc, 0x02, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x6d, 0x61, 0x6e, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x6d, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x29, 0x0a,
+	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x09, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x42, 0x0a,
+	0x5a, 0x08, 0x2e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_response_get_scheduled_orders_response_proto_rawDescOnce sync.Once
+	file_response_get_scheduled_orders_response_proto_rawDescData = file_response_get_scheduled_orders_response_proto_rawDesc
+)
+
+func file_response_get_scheduled_orders_response_proto_rawDescGZIP() []byte {
+	file_response_get_scheduled_orders_response_proto_rawDescOnce.Do(func() {
+		file_response_get_scheduled_orders_response_proto_rawDescData = protoimpl.X.CompressGZIP(file_response_get_scheduled_orders_response_proto_rawDescData)
+	})
+	return file_response_get_scheduled_orders_response_proto_rawDescData
+}
+
+var file_response_get_scheduled_orders_response_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_response_get_scheduled_orders_response_proto_goTypes = []interface{}{
+	(*ScheduledOrder)(nil),             // 0: pb.ScheduledOrder
+	(*GetScheduledOrdersResponse)(nil), // 1: pb.GetScheduledOrdersResponse
+	(*Product)(nil),                    // 2: pb.Product
+	(*Address)(nil),                    // 3: pb.Address
+}
+var file_response_get_scheduled_orders_response_proto_depIdxs = []int32{
+	2, // 0: pb.ScheduledOrder.product:type_name -> pb.Product
+	3, // 1: pb.ScheduledOrder.addresses:type_name -> pb.Address
+	0, // 2: pb.GetScheduledOrdersResponse.orders:type_name -> pb.ScheduledOrder
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_response_get_scheduled_orders_response_proto_init() }
+func file_response_get_scheduled_orders_response_proto_init() {
+	if File_response_get_scheduled_orders_response_proto != nil {
+		return
+	}
+	file_model_order_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_response_get_scheduled_orders_response_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_get_scheduled_orders_response_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledOrdersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_response_get_scheduled_orders_response_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_response_get_scheduled_orders_response_proto_goTypes,
+		DependencyIndexes: file_response_get_scheduled_orders_response_proto_depIdxs,
+		MessageInfos:      file_response_get_scheduled_orders_response_proto_msgTypes,
+	}.Build()
+	File_response_get_scheduled_orders_response_proto = out.File
+	file_response_get_scheduled_orders_response_proto_rawDesc = nil
+	file_response_get_scheduled_orders_response_proto_goTypes = nil
+	file_response_get_scheduled_orders_response_proto_depIdxs = nil
+}
@@ -0,0 +1,144 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.21.12
+// source: response/pseudonymize_orders_response.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PseudonymizeOrdersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Modified int64 `protobuf:"varint,1,opt,name=modified,proto3" json:"modified,omitempty"`
+}
+
+func (x *PseudonymizeOrdersResponse) Reset() {
+	*x = PseudonymizeOrdersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_pseudonymize_orders_response_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PseudonymizeOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PseudonymizeOrdersResponse) ProtoMessage() {}
+
+func (x *PseudonymizeOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_pseudonymize_orders_response_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PseudonymizeOrdersResponse.ProtoReflect.Descriptor instead.
+func (*PseudonymizeOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_response_pseudonymize_orders_response_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PseudonymizeOrdersResponse) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+var File_response_pseudonymize_orders_response_proto protoreflect.FileDescriptor
+
+var file_response_pseudonymize_orders_response_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2f, 0x70, 0x73, 0x65, 0x75, 0x64,
+	0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
+	0x62, 0x22, 0x38, 0x0a, 0x1a, 0x50, 0x73, 0x65, 0x75, 0x64, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x0a, 0x5a, 0x08, 0x2e,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_response_pseudonymize_orders_response_proto_rawDescOnce sync.Once
+	file_response_pseudonymize_orders_response_proto_rawDescData = file_response_pseudonymize_orders_response_proto_rawDesc
+)
+
+func file_response_pseudonymize_orders_response_proto_rawDescGZIP() []byte {
+	file_response_pseudonymize_orders_response_proto_rawDescOnce.Do(func() {
+		file_response_pseudonymize_orders_response_proto_rawDescData = protoimpl.X.CompressGZIP(file_response_pseudonymize_orders_response_proto_rawDescData)
+	})
+	return file_response_pseudonymize_orders_response_proto_rawDescData
+}
+
+var file_response_pseudonymize_orders_response_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_response_pseudonymize_orders_response_proto_goTypes = []interface{}{
+	(*PseudonymizeOrdersResponse)(nil), // 0: pb.PseudonymizeOrdersResponse
+}
+var file_response_pseudonymize_orders_response_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_response_pseudonymize_orders_response_proto_init() }
+func file_response_pseudonymize_orders_response_proto_init() {
+	if File_response_pseudonymize_orders_response_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_response_pseudonymize_orders_response_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PseudonymizeOrdersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_response_pseudonymize_orders_response_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_response_pseudonymize_orders_response_proto_goTypes,
+		DependencyIndexes: file_response_pseudonymize_orders_response_proto_depIdxs,
+		MessageInfos:      file_response_pseudonymize_orders_response_proto_msgTypes,
+	}.Build()
+	File_response_pseudonymize_orders_response_proto = out.File
+	file_response_pseudonymize_orders_response_proto_rawDesc = nil
+	file_response_pseudonymize_orders_response_proto_goTypes = nil
+	file_response_pseudonymize_orders_response_proto_depIdxs = nil
+}
@@ -19,7 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	UserHandler_FindByEmail_FullMethodName = "/pb.UserHandler/FindByEmail"
+	UserHandler_FindByEmail_FullMethodName         = "/pb.UserHandler/FindByEmail"
+	UserHandler_UpdateUser_FullMethodName          = "/pb.UserHandler/UpdateUser"
+	UserHandler_ListUsers_FullMethodName           = "/pb.UserHandler/ListUsers"
+	UserHandler_SaveAttributeSchema_FullMethodName = "/pb.UserHandler/SaveAttributeSchema"
+	UserHandler_GetAttributeSchema_FullMethodName  = "/pb.UserHandler/GetAttributeSchema"
+	UserHandler_DisableUser_FullMethodName         = "/pb.UserHandler/DisableUser"
+	UserHandler_EnableUser_FullMethodName          = "/pb.UserHandler/EnableUser"
 )
 
 // UserHandlerClient is the client API for UserHandler service.
@@ -27,6 +33,12 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UserHandlerClient interface {
 	FindByEmail(ctx context.Context, in *UserByEmailRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	ListUsers(ctx context.Context, in *UserListRequest, opts ...grpc.CallOption) (*UserListResponse, error)
+	SaveAttributeSchema(ctx context.Context, in *AttributeSchemaRequest, opts ...grpc.CallOption) (*AttributeSchemaResponse, error)
+	GetAttributeSchema(ctx context.Context, in *GetAttributeSchemaRequest, opts ...grpc.CallOption) (*AttributeSchemaResponse, error)
+	DisableUser(ctx context.Context, in *UserStatusRequest, opts ...grpc.CallOption) (*UserStatusResponse, error)
+	EnableUser(ctx context.Context, in *UserStatusRequest, opts ...grpc.CallOption) (*UserStatusResponse, error)
 }
 
 type userHandlerClient struct {
@@ -46,11 +58,71 @@ func (c *userHandlerClient) FindByEmail(ctx context.Context, in *UserByEmailRequ
 	return out, nil
 }
 
+func (c *userHandlerClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, UserHandler_UpdateUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userHandlerClient) ListUsers(ctx context.Context, in *UserListRequest, opts ...grpc.CallOption) (*UserListResponse, error) {
+	out := new(UserListResponse)
+	err := c.cc.Invoke(ctx, UserHandler_ListUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userHandlerClient) SaveAttributeSchema(ctx context.Context, in *AttributeSchemaRequest, opts ...grpc.CallOption) (*AttributeSchemaResponse, error) {
+	out := new(AttributeSchemaResponse)
+	err := c.cc.Invoke(ctx, UserHandler_SaveAttributeSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userHandlerClient) GetAttributeSchema(ctx context.Context, in *GetAttributeSchemaRequest, opts ...grpc.CallOption) (*AttributeSchemaResponse, error) {
+	out := new(AttributeSchemaResponse)
+	err := c.cc.Invoke(ctx, UserHandler_GetAttributeSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userHandlerClient) DisableUser(ctx context.Context, in *UserStatusRequest, opts ...grpc.CallOption) (*UserStatusResponse, error) {
+	out := new(UserStatusResponse)
+	err := c.cc.Invoke(ctx, UserHandler_DisableUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userHandlerClient) EnableUser(ctx context.Context, in *UserStatusRequest, opts ...grpc.CallOption) (*UserStatusResponse, error) {
+	out := new(UserStatusResponse)
+	err := c.cc.Invoke(ctx, UserHandler_EnableUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserHandlerServer is the server API for UserHandler service.
 // All implementations must embed UnimplementedUserHandlerServer
 // for forward compatibility
 type UserHandlerServer interface {
 	FindByEmail(context.Context, *UserByEmailRequest) (*UserResponse, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error)
+	ListUsers(context.Context, *UserListRequest) (*UserListResponse, error)
+	SaveAttributeSchema(context.Context, *AttributeSchemaRequest) (*AttributeSchemaResponse, error)
+	GetAttributeSchema(context.Context, *GetAttributeSchemaRequest) (*AttributeSchemaResponse, error)
+	DisableUser(context.Context, *UserStatusRequest) (*UserStatusResponse, error)
+	EnableUser(context.Context, *UserStatusRequest) (*UserStatusResponse, error)
 	mustEmbedUnimplementedUserHandlerServer()
 }
 
@@ -61,6 +133,24 @@ type UnimplementedUserHandlerServer struct {
 func (UnimplementedUserHandlerServer) FindByEmail(context.Context, *UserByEmailRequest) (*UserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindByEmail not implemented")
 }
+func (UnimplementedUserHandlerServer) UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedUserHandlerServer) ListUsers(context.Context, *UserListRequest) (*UserListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedUserHandlerServer) SaveAttributeSchema(context.Context, *AttributeSchemaRequest) (*AttributeSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveAttributeSchema not implemented")
+}
+func (UnimplementedUserHandlerServer) GetAttributeSchema(context.Context, *GetAttributeSchemaRequest) (*AttributeSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttributeSchema not implemented")
+}
+func (UnimplementedUserHandlerServer) DisableUser(context.Context, *UserStatusRequest) (*UserStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableUser not implemented")
+}
+func (UnimplementedUserHandlerServer) EnableUser(context.Context, *UserStatusRequest) (*UserStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableUser not implemented")
+}
 func (UnimplementedUserHandlerServer) mustEmbedUnimplementedUserHandlerServer() {}
 
 // UnsafeUserHandlerServer may be embedded to opt out of forward compatibility for this service.
@@ -92,6 +182,114 @@ func _UserHandler_FindByEmail_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserHandler_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserHandlerServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserHandler_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserHandlerServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserHandler_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserHandlerServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserHandler_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserHandlerServer).ListUsers(ctx, req.(*UserListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserHandler_SaveAttributeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttributeSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserHandlerServer).SaveAttributeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserHandler_SaveAttributeSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserHandlerServer).SaveAttributeSchema(ctx, req.(*AttributeSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserHandler_GetAttributeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttributeSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserHandlerServer).GetAttributeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserHandler_GetAttributeSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserHandlerServer).GetAttributeSchema(ctx, req.(*GetAttributeSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserHandler_DisableUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserHandlerServer).DisableUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserHandler_DisableUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserHandlerServer).DisableUser(ctx, req.(*UserStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserHandler_EnableUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserHandlerServer).EnableUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserHandler_EnableUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserHandlerServer).EnableUser(ctx, req.(*UserStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserHandler_ServiceDesc is the grpc.ServiceDesc for UserHandler service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -103,6 +301,30 @@ var UserHandler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindByEmail",
 			Handler:    _UserHandler_FindByEmail_Handler,
 		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _UserHandler_UpdateUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _UserHandler_ListUsers_Handler,
+		},
+		{
+			MethodName: "SaveAttributeSchema",
+			Handler:    _UserHandler_SaveAttributeSchema_Handler,
+		},
+		{
+			MethodName: "GetAttributeSchema",
+			Handler:    _UserHandler_GetAttributeSchema_Handler,
+		},
+		{
+			MethodName: "DisableUser",
+			Handler:    _UserHandler_DisableUser_Handler,
+		},
+		{
+			MethodName: "EnableUser",
+			Handler:    _UserHandler_EnableUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "handler/user_handler.proto",
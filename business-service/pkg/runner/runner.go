@@ -0,0 +1,13 @@
+package runner
+
+import (
+	"github.com/lucasd-coder/fast-feet/business-service/config"
+	"github.com/lucasd-coder/fast-feet/business-service/internal/app"
+)
+
+// Run starts business-service with an already loaded config, it lets other
+// modules embed the service without reaching into its internal packages.
+func Run(cfg *config.Config) {
+	config.ExportConfig(cfg)
+	app.Run(cfg)
+}
@@ -0,0 +1,88 @@
+// devmode runs router-service and business-service in a single process and
+// wires them through in-memory topics, so local development needs no RabbitMQ.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/ilyakaznacheev/cleanenv"
+	businessConfig "github.com/lucasd-coder/fast-feet/business-service/config"
+	businessRunner "github.com/lucasd-coder/fast-feet/business-service/pkg/runner"
+	routerConfig "github.com/lucasd-coder/fast-feet/router-service/config"
+	routerRunner "github.com/lucasd-coder/fast-feet/router-service/pkg/runner"
+	"gocloud.dev/pubsub"
+	// revive
+	_ "gocloud.dev/pubsub/mempubsub"
+)
+
+const (
+	userEventsTopic       = "router-service-user-events"
+	orderEventsTopic      = "router-service-order-events"
+	userStatusEventsTopic = "auth-service-user-status-events"
+)
+
+func main() {
+	routerPath := flag.String("router-config", "./router-service/config/config-dev.yml", "router-service config file")
+	businessPath := flag.String("business-config", "./business-service/config/config-dev.yml", "business-service config file")
+	flag.Parse()
+
+	// business-service requires the rabbit server url even when no queue uses it.
+	if os.Getenv("RABBIT_SERVER_URL") == "" {
+		os.Setenv("RABBIT_SERVER_URL", "amqp://localhost:5672")
+	}
+
+	var routerCfg routerConfig.Config
+	if err := cleanenv.ReadConfig(*routerPath, &routerCfg); err != nil {
+		log.Fatalf("Router config error: %v", err)
+	}
+
+	var businessCfg businessConfig.Config
+	if err := cleanenv.ReadConfig(*businessPath, &businessCfg); err != nil {
+		log.Fatalf("Business config error: %v", err)
+	}
+
+	useMemoryBroker(&routerCfg, &businessCfg)
+
+	ctx := context.Background()
+
+	// mempubsub subscriptions can only be opened on existing topics.
+	for _, url := range []string{routerCfg.TopicUserEvents.URL, routerCfg.TopicOrderEvents.URL,
+		businessCfg.QueueUserStatusEvents.QueueURL} {
+		topic, err := pubsub.OpenTopic(ctx, url)
+		if err != nil {
+			log.Fatalf("Failed to open topic %s: %v", url, err)
+		}
+		defer func() { _ = topic.Shutdown(ctx) }()
+	}
+
+	go routerRunner.Run(&routerCfg)
+
+	businessRunner.Run(&businessCfg)
+}
+
+func useMemoryBroker(routerCfg *routerConfig.Config, businessCfg *businessConfig.Config) {
+	routerCfg.TopicUserEvents.URL = memURL(userEventsTopic)
+	routerCfg.TopicOrderEvents.URL = memURL(orderEventsTopic)
+
+	// there are no retry TTL queues in memory, retries go straight back to the topic.
+	businessCfg.QueueUserEvents.QueueURL = memURL(userEventsTopic)
+	businessCfg.QueueUserEvents.RetryURL = memURL(userEventsTopic)
+	businessCfg.QueueUserEvents.DeadLetterURL = memURL("business-service-user-events-dlq")
+
+	businessCfg.QueueOrderEvents.QueueURL = memURL(orderEventsTopic)
+	businessCfg.QueueOrderEvents.RetryURL = memURL(orderEventsTopic)
+	businessCfg.QueueOrderEvents.DeadLetterURL = memURL("business-service-order-events-dlq")
+
+	// auth-service runs on its own, so no user status event reaches devmode.
+	businessCfg.QueueUserStatusEvents.QueueURL = memURL(userStatusEventsTopic)
+	businessCfg.QueueUserStatusEvents.RetryURL = memURL(userStatusEventsTopic)
+	businessCfg.QueueUserStatusEvents.DeadLetterURL = memURL("business-service-user-status-events-dlq")
+}
+
+func memURL(name string) string {
+	return fmt.Sprintf("mem://%s", name)
+}
@@ -3,13 +3,13 @@ rs.status();
 db.getSiblingDB('fast-feet').createUser({user: 'admin', pwd: 'admin123', roles: [ { role: 'root', db: 'admin' } ]});
 
 db.getSiblingDB('fast-feet').getCollection("users").createIndex(
-	{ email: 1},
-	{unique: true}
+	{ emailHash: 1},
+	{unique: true, partialFilterExpression: { emailHash: { $type: "string" } }}
 )
 
 db.getSiblingDB('fast-feet').getCollection("users").createIndex(
-	{ cpf: 1},
-	{unique: true}
+	{ cpfHash: 1},
+	{unique: true, partialFilterExpression: { cpfHash: { $type: "string" } }}
 )
 
 db.getSiblingDB('fast-feet').getCollection("users").createIndex(
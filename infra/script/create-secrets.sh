@@ -36,6 +36,12 @@ auth_service_keycloak_username=${auth_service_keycloak_username:-"auth-service"}
 read -r -p "Enter auth-service-keycloak-password: " auth_service_keycloak_password
 auth_service_keycloak_password=${auth_service_keycloak_password:-"&&u1PG9OE*c8"}
 
+read -r -p "Enter user-manger-service-encryption-key: " encryption_key
+encryption_key=${encryption_key:-"Wq7!zR2p#Lm9vT4e"}
+
+read -r -p "Enter user-manger-service-encryption-index-key: " encryption_index_key
+encryption_index_key=${encryption_index_key:-"b8K@x3Nf6&hQ1sYd"}
+
 PASSWORD=$(kubectl get secret --namespace default rabbitmq -o jsonpath="{.data.rabbitmq-password}" | base64 -d)
 
 echo "Creating mongo secrets"
@@ -117,6 +123,18 @@ else
   kubectl -n default create secret generic auth-service-keycloak-password --from-literal=keycloak-password="$auth_service_keycloak_password"
 fi
 
+if kubectl get secret auth-service-rabbitmq-username -n "$namespace" &> /dev/null; then
+  echo "The secret auth-service-rabbitmq-username exists, so you can delete it"
+else
+  kubectl -n default create secret generic auth-service-rabbitmq-username --from-literal=rabbitmq-username="$rabbitmq_username"
+fi
+
+if kubectl get secret auth-service-rabbitmq-password -n "$namespace" &> /dev/null; then
+  echo "The secret auth-service-rabbitmq-password exists, so you can delete it"
+else
+  kubectl -n default create secret generic auth-service-rabbitmq-password --from-literal=rabbitmq-password="${PASSWORD}"
+fi
+
 echo "Creating redis secrets"
 
 if kubectl get secret redis -n "$namespace" &> /dev/null; then
@@ -151,3 +169,16 @@ else
   kubectl -n default create secret generic business-service-aes-key --from-literal=aes-key-config="$aes_key_config"
 fi
 
+echo "Creating user-manger-service secrets"
+
+if kubectl get secret user-manger-service-encryption-key -n "$namespace" &> /dev/null; then
+  echo "The secret user-manger-service-encryption-key exists, so you can delete it"
+else
+  kubectl -n default create secret generic user-manger-service-encryption-key --from-literal=encryption-key="$encryption_key"
+fi
+
+if kubectl get secret user-manger-service-encryption-index-key -n "$namespace" &> /dev/null; then
+  echo "The secret user-manger-service-encryption-index-key exists, so you can delete it"
+else
+  kubectl -n default create secret generic user-manger-service-encryption-index-key --from-literal=encryption-index-key="$encryption_index_key"
+fi
@@ -5,7 +5,12 @@ import "context"
 type (
 	OrderRepository interface {
 		Save(ctx context.Context, order *Order) (*Order, error)
+		SaveBatch(ctx context.Context, orders []*Order) ([]error, error)
 		FindByID(ctx context.Context, id string) (*Order, error)
 		FindAll(ctx context.Context, pld *GetAllOrderRequest) ([]Order, error)
+		Pseudonymize(ctx context.Context, deliverymanID, pseudonym string) (int64, error)
+		FindByDeliverymanID(ctx context.Context, deliverymanID string, fn func(*Order) error) error
+		Flag(ctx context.Context, deliverymanID, reason string) (int64, error)
+		Unflag(ctx context.Context, deliverymanID, reason string) (int64, error)
 	}
 )
@@ -19,8 +19,14 @@ type Order struct {
 	EndDate       time.Time          `bson:"endDate,omitempty" validate:"required"`
 	CreatedAt     time.Time          `bson:"createdAt,omitempty"`
 	UpdatedAt     time.Time          `bson:"updatedAt,omitempty"`
+	Flag          string             `bson:"flag,omitempty"`
+	FlaggedAt     time.Time          `bson:"flaggedAt,omitempty"`
 }
 
+// FlagDeliverymanDisabled marks the open orders of a disabled deliveryman,
+// they have to be reassigned.
+const FlagDeliverymanDisabled = "DELIVERYMAN_DISABLED"
+
 type Product struct {
 	Name string `json:"name,omitempty" validate:"required,pattern"`
 }
@@ -54,6 +60,21 @@ type GetAllOrderRequest struct {
 	Address       GetAddress `bson:"addresses,omitempty" validate:"required"`
 }
 
+type PseudonymizeOrders struct {
+	DeliverymanID string `validate:"required,uuid4"`
+	Pseudonym     string `validate:"required,uuid4,nefield=DeliverymanID"`
+}
+
+type FlagOrders struct {
+	DeliverymanID string `validate:"required,uuid4"`
+	Reason        string `validate:"required,oneof=DELIVERYMAN_DISABLED"`
+	Flagged       bool
+}
+
+type ExportOrders struct {
+	DeliverymanID string `validate:"required,uuid4"`
+}
+
 type GetProduct struct {
 	Name string `json:"name,omitempty" validate:"pattern"`
 }
@@ -79,6 +100,18 @@ func (g *GetAllOrderRequest) Validate(val shared.Validator) error {
 	return val.ValidateStruct(g)
 }
 
+func (p *PseudonymizeOrders) Validate(val shared.Validator) error {
+	return val.ValidateStruct(p)
+}
+
+func (f *FlagOrders) Validate(val shared.Validator) error {
+	return val.ValidateStruct(f)
+}
+
+func (e *ExportOrders) Validate(val shared.Validator) error {
+	return val.ValidateStruct(e)
+}
+
 func (o *Order) GetCanceledAt() string {
 	return o.CanceledAt.Format(time.RFC3339)
 }
@@ -91,6 +124,13 @@ func (o *Order) GetEndDate() string {
 	return o.EndDate.Format(time.RFC3339)
 }
 
+func (o *Order) GetFlaggedAt() string {
+	if o.FlaggedAt.IsZero() {
+		return ""
+	}
+	return o.FlaggedAt.Format(time.RFC3339)
+}
+
 func (o *Order) GetCreatedAt() string {
 	return o.CreatedAt.Format(time.RFC3339)
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -41,6 +42,40 @@ func (repo *OrderRepository) Save(ctx context.Context, order *model.Order) (*mod
 	}, nil
 }
 
+// SaveBatch inserts the orders unordered, so a failing order does not stop
+// the others. It returns the error of each order by index, the orders
+// inserted have their ID set.
+func (repo *OrderRepository) SaveBatch(ctx context.Context, orders []*model.Order) ([]error, error) {
+	database := repo.connection.Database(repo.config.MongoDatabase)
+
+	collection := repo.config.MongoCollections.Order.Collection
+
+	docs := make([]interface{}, 0, len(orders))
+	for _, order := range orders {
+		order.ID = primitive.NewObjectID()
+		docs = append(docs, order)
+	}
+
+	errs := make([]error, len(orders))
+
+	_, err := database.Collection(collection).
+		InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+
+	var bulkErr mongo.BulkWriteException
+	if errors.As(err, &bulkErr) && bulkErr.WriteConcernError == nil {
+		for _, writeErr := range bulkErr.WriteErrors {
+			errs[writeErr.Index] = writeErr
+			orders[writeErr.Index].ID = primitive.NilObjectID
+		}
+		return errs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return errs, nil
+}
+
 func (repo *OrderRepository) FindByID(ctx context.Context, id string) (*model.Order, error) {
 	database := repo.connection.Database(repo.config.MongoDatabase)
 
@@ -98,6 +133,123 @@ func (repo *OrderRepository) FindAll(ctx context.Context, pld *model.GetAllOrder
 	return orders, nil
 }
 
+// Pseudonymize replaces the deliveryman of its orders by pseudonym and
+// returns how many orders changed.
+func (repo *OrderRepository) Pseudonymize(ctx context.Context, deliverymanID, pseudonym string) (int64, error) {
+	database := repo.connection.Database(repo.config.MongoDatabase)
+
+	collection := repo.config.MongoCollections.Order.Collection
+
+	filter := bson.M{
+		"deliverymanId": deliverymanID,
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"deliverymanId": pseudonym,
+			"updatedAt":     time.Now(),
+		},
+	}
+
+	result, err := database.Collection(collection).UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.ModifiedCount, nil
+}
+
+// Flag flags the open orders of the deliveryman, the ones neither canceled
+// nor signed, with reason and returns how many orders changed.
+func (repo *OrderRepository) Flag(ctx context.Context, deliverymanID, reason string) (int64, error) {
+	database := repo.connection.Database(repo.config.MongoDatabase)
+
+	collection := repo.config.MongoCollections.Order.Collection
+
+	filter := bson.M{
+		"deliverymanId": deliverymanID,
+		"canceledAt":    nil,
+		"signatureId":   bson.M{"$in": bson.A{nil, ""}},
+		"flag":          bson.M{"$ne": reason},
+	}
+
+	now := time.Now()
+	update := bson.M{
+		"$set": bson.M{
+			"flag":      reason,
+			"flaggedAt": now,
+			"updatedAt": now,
+		},
+	}
+
+	result, err := database.Collection(collection).UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.ModifiedCount, nil
+}
+
+// Unflag clears the reason flag from the orders of the deliveryman and
+// returns how many orders changed.
+func (repo *OrderRepository) Unflag(ctx context.Context, deliverymanID, reason string) (int64, error) {
+	database := repo.connection.Database(repo.config.MongoDatabase)
+
+	collection := repo.config.MongoCollections.Order.Collection
+
+	filter := bson.M{
+		"deliverymanId": deliverymanID,
+		"flag":          reason,
+	}
+
+	update := bson.M{
+		"$set":   bson.M{"updatedAt": time.Now()},
+		"$unset": bson.M{"flag": "", "flaggedAt": ""},
+	}
+
+	result, err := database.Collection(collection).UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.ModifiedCount, nil
+}
+
+// FindByDeliverymanID calls fn for every order of the deliveryman while the
+// cursor is read, so the orders are never held in memory at once.
+func (repo *OrderRepository) FindByDeliverymanID(ctx context.Context, deliverymanID string,
+	fn func(*model.Order) error) error {
+	database := repo.connection.Database(repo.config.MongoDatabase)
+
+	collection := repo.config.MongoCollections.Order.Collection
+
+	filter := bson.M{
+		"deliverymanId": deliverymanID,
+	}
+
+	opt := options.Find()
+	opt.SetSort(bson.D{{Key: "createdAt", Value: 1}})
+
+	result, err := database.Collection(collection).Find(ctx, filter, opt)
+	if err != nil {
+		return err
+	}
+
+	defer result.Close(ctx)
+
+	for result.Next(ctx) {
+		order := new(model.Order)
+		if err := result.Decode(order); err != nil {
+			return fmt.Errorf("fail mongo cursor decode: %w", err)
+		}
+		if err := fn(order); err != nil {
+			return err
+		}
+	}
+
+	return result.Err()
+}
+
 func decode(r *mongo.SingleResult) (*model.Order, error) {
 	order := new(model.Order)
 	if err := r.Decode(order); err != nil {
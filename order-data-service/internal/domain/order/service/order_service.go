@@ -11,6 +11,8 @@ import (
 	"github.com/lucasd-coder/fast-feet/order-data-service/internal/shared"
 	"github.com/lucasd-coder/fast-feet/order-data-service/pkg/pb"
 	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type OrderService struct {
@@ -55,6 +57,148 @@ func (s *OrderService) Save(ctx context.Context, req *pb.OrderRequest) (*pb.Orde
 	}, nil
 }
 
+// SaveBatch saves the orders in a single write. Each order has its own
+// result, an invalid or failing order does not fail the others.
+func (s *OrderService) SaveBatch(ctx context.Context, req *pb.SaveBatchRequest) (*pb.SaveBatchResponse, error) {
+	log := logger.FromContext(ctx)
+
+	slog.With("size", len(req.GetOrders())).Info("received batch request")
+
+	results := make([]*pb.SaveBatchResult, len(req.GetOrders()))
+	orders := make([]*order.Order, 0, len(req.GetOrders()))
+	indexes := make([]int, 0, len(req.GetOrders()))
+
+	for i, orderReq := range req.GetOrders() {
+		pld := order.CreateOrder{
+			DeliverymanID: orderReq.GetDeliverymanId(),
+			Product:       order.NewProduct(orderReq.GetProduct().GetName()),
+			Address:       s.newAddress(orderReq),
+		}
+
+		if err := pld.Validate(s.validate); err != nil {
+			results[i] = newSaveBatchError(pkgErrors.ValidationErrors(err))
+			continue
+		}
+
+		orders = append(orders, order.NewOrder(pld))
+		indexes = append(indexes, i)
+	}
+
+	if len(orders) > 0 {
+		errs, err := s.orderRepository.SaveBatch(ctx, orders)
+		if err != nil {
+			return nil, fmt.Errorf("error when save batch: %w", err)
+		}
+
+		for i, order := range orders {
+			if errs[i] != nil {
+				log.Errorf("error when save order of batch index %d: %v", indexes[i], errs[i])
+				results[indexes[i]] = newSaveBatchError(status.Error(codes.Internal, errs[i].Error()))
+				continue
+			}
+			results[indexes[i]] = &pb.SaveBatchResult{
+				Id:        order.ID.Hex(),
+				CreatedAt: order.GetCreatedAt(),
+			}
+		}
+	}
+
+	log.Infof("successfully processed batch of %d orders", len(results))
+
+	return &pb.SaveBatchResponse{Results: results}, nil
+}
+
+// PseudonymizeOrders keeps the orders of an erased deliveryman, only the
+// deliverymanId is replaced by the pseudonym.
+func (s *OrderService) PseudonymizeOrders(ctx context.Context,
+	req *pb.PseudonymizeOrdersRequest) (*pb.PseudonymizeOrdersResponse, error) {
+	log := logger.FromContext(ctx)
+
+	pld := order.PseudonymizeOrders{
+		DeliverymanID: req.GetDeliverymanId(),
+		Pseudonym:     req.GetPseudonym(),
+	}
+
+	if err := pld.Validate(s.validate); err != nil {
+		return nil, pkgErrors.ValidationErrors(err)
+	}
+
+	modified, err := s.orderRepository.Pseudonymize(ctx, pld.DeliverymanID, pld.Pseudonym)
+	if err != nil {
+		return nil, fmt.Errorf("error when pseudonymize: %w", err)
+	}
+
+	log.Infof("pseudonymized %d orders of deliveryman", modified)
+
+	return &pb.PseudonymizeOrdersResponse{Modified: modified}, nil
+}
+
+// FlagOrders flags the open orders of the deliveryman, e.g. when the
+// deliveryman is disabled, or clears the flag once enabled again.
+func (s *OrderService) FlagOrders(ctx context.Context, req *pb.FlagOrdersRequest) (*pb.FlagOrdersResponse, error) {
+	log := logger.FromContext(ctx)
+
+	pld := order.FlagOrders{
+		DeliverymanID: req.GetDeliverymanId(),
+		Reason:        req.GetReason(),
+		Flagged:       req.GetFlagged(),
+	}
+
+	if err := pld.Validate(s.validate); err != nil {
+		return nil, pkgErrors.ValidationErrors(err)
+	}
+
+	flag := s.orderRepository.Unflag
+	if pld.Flagged {
+		flag = s.orderRepository.Flag
+	}
+
+	modified, err := flag(ctx, pld.DeliverymanID, pld.Reason)
+	if err != nil {
+		return nil, fmt.Errorf("error when flag orders: %w", err)
+	}
+
+	log.Infof("%s flag set to %t on %d orders of deliveryman", pld.Reason, pld.Flagged, modified)
+
+	return &pb.FlagOrdersResponse{Modified: modified}, nil
+}
+
+// ExportOrders streams the orders of the deliveryman for the personal data
+// export.
+func (s *OrderService) ExportOrders(req *pb.ExportOrdersRequest, stream pb.OrderService_ExportOrdersServer) error {
+	ctx := stream.Context()
+	log := logger.FromContext(ctx)
+
+	pld := order.ExportOrders{
+		DeliverymanID: req.GetDeliverymanId(),
+	}
+
+	if err := pld.Validate(s.validate); err != nil {
+		return pkgErrors.ValidationErrors(err)
+	}
+
+	var total int
+	err := s.orderRepository.FindByDeliverymanID(ctx, pld.DeliverymanID, func(o *order.Order) error {
+		total++
+		return stream.Send(s.extractPbOrder(*o))
+	})
+	if err != nil {
+		return fmt.Errorf("error when export orders: %w", err)
+	}
+
+	log.Infof("exported %d orders of deliveryman", total)
+
+	return nil
+}
+
+func newSaveBatchError(err error) *pb.SaveBatchResult {
+	st := status.Convert(err)
+	return &pb.SaveBatchResult{
+		Code:    uint32(st.Code()),
+		Message: st.Message(),
+	}
+}
+
 func (s *OrderService) newAddress(req *pb.OrderRequest) order.Address {
 	return order.Address{
 		Address:      req.GetAddresses().GetAddress(),
@@ -147,5 +291,7 @@ func (s *OrderService) extractPbOrder(order order.Order) *pb.Order {
 		CreatedAt:  order.GetCreatedAt(),
 		UpdatedAt:  order.GetUpdatedAt(),
 		CanceledAt: order.GetCanceledAt(),
+		Flag:       order.Flag,
+		FlaggedAt:  order.GetFlaggedAt(),
 	}
 }
@@ -2,17 +2,22 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	noProviderVal "github.com/go-playground/validator/v10"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/lucasd-coder/fast-feet/order-data-service/internal/domain/order"
 	"github.com/lucasd-coder/fast-feet/order-data-service/internal/domain/order/service"
 	"github.com/lucasd-coder/fast-feet/order-data-service/internal/mocks"
 	"github.com/lucasd-coder/fast-feet/order-data-service/internal/provider/validator"
 	"github.com/lucasd-coder/fast-feet/order-data-service/pkg/pb"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type OrderServiceSuite struct {
@@ -72,6 +77,149 @@ func (suite *OrderServiceSuite) TestSaveValidation() {
 	}
 }
 
+func (suite *OrderServiceSuite) TestSaveBatch() {
+	valid := &pb.OrderRequest{
+		DeliverymanId: "075f0eef-0891-45ad-a3de-d6684c7f390d",
+		Product: &pb.Product{
+			Name: "bola",
+		},
+		Addresses: &pb.Address{
+			Address:      "rua das marias",
+			Number:       10,
+			PostalCode:   "2423323252",
+			Neighborhood: "casa 3",
+			City:         "Rio grande sul",
+			State:        "Rio grande sul",
+		},
+	}
+	invalid := &pb.OrderRequest{DeliverymanId: "1234567"}
+
+	suite.repo.On("SaveBatch", suite.ctx, mock.AnythingOfType("[]*order.Order")).
+		Run(func(args mock.Arguments) {
+			orders := args.Get(1).([]*order.Order)
+			suite.Len(orders, 2)
+			orders[0].ID = primitive.NewObjectID()
+		}).
+		Return([]error{nil, errors.New("duplicate key")}, nil)
+
+	resp, err := suite.svc.SaveBatch(suite.ctx, &pb.SaveBatchRequest{
+		Orders: []*pb.OrderRequest{valid, invalid, valid},
+	})
+	suite.NoError(err)
+	suite.Len(resp.GetResults(), 3)
+
+	suite.Equal(uint32(codes.OK), resp.GetResults()[0].GetCode())
+	suite.NotEmpty(resp.GetResults()[0].GetId())
+	suite.Equal(uint32(codes.InvalidArgument), resp.GetResults()[1].GetCode())
+	suite.Empty(resp.GetResults()[1].GetId())
+	suite.Equal(uint32(codes.Internal), resp.GetResults()[2].GetCode())
+	suite.Equal("duplicate key", resp.GetResults()[2].GetMessage())
+}
+
+func (suite *OrderServiceSuite) TestPseudonymizeOrders() {
+	req := &pb.PseudonymizeOrdersRequest{
+		DeliverymanId: "075f0eef-0891-45ad-a3de-d6684c7f390d",
+		Pseudonym:     "5d1f6c2b-6f61-4d8c-9d43-3c5b8ad5c0e4",
+	}
+
+	suite.repo.On("Pseudonymize", suite.ctx, req.GetDeliverymanId(), req.GetPseudonym()).
+		Return(int64(3), nil)
+
+	resp, err := suite.svc.PseudonymizeOrders(suite.ctx, req)
+	suite.NoError(err)
+	suite.Equal(int64(3), resp.GetModified())
+}
+
+func (suite *OrderServiceSuite) TestPseudonymizeOrdersValidation() {
+	req := &pb.PseudonymizeOrdersRequest{
+		DeliverymanId: "075f0eef-0891-45ad-a3de-d6684c7f390d",
+		Pseudonym:     "075f0eef-0891-45ad-a3de-d6684c7f390d",
+	}
+
+	_, err := suite.svc.PseudonymizeOrders(suite.ctx, req)
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "Pseudonymize", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceSuite) TestFlagOrders() {
+	deliverymanID := "075f0eef-0891-45ad-a3de-d6684c7f390d"
+
+	suite.repo.On("Flag", suite.ctx, deliverymanID, order.FlagDeliverymanDisabled).Return(int64(2), nil)
+	suite.repo.On("Unflag", suite.ctx, deliverymanID, order.FlagDeliverymanDisabled).Return(int64(1), nil)
+
+	resp, err := suite.svc.FlagOrders(suite.ctx, &pb.FlagOrdersRequest{
+		DeliverymanId: deliverymanID,
+		Reason:        order.FlagDeliverymanDisabled,
+		Flagged:       true,
+	})
+	suite.NoError(err)
+	suite.Equal(int64(2), resp.GetModified())
+
+	resp, err = suite.svc.FlagOrders(suite.ctx, &pb.FlagOrdersRequest{
+		DeliverymanId: deliverymanID,
+		Reason:        order.FlagDeliverymanDisabled,
+	})
+	suite.NoError(err)
+	suite.Equal(int64(1), resp.GetModified())
+}
+
+func (suite *OrderServiceSuite) TestFlagOrdersValidation() {
+	_, err := suite.svc.FlagOrders(suite.ctx, &pb.FlagOrdersRequest{
+		DeliverymanId: "075f0eef-0891-45ad-a3de-d6684c7f390d",
+		Reason:        "LATE",
+		Flagged:       true,
+	})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "Flag", mock.Anything, mock.Anything, mock.Anything)
+}
+
+type exportOrdersStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	orders []*pb.Order
+}
+
+func (s *exportOrdersStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportOrdersStream) Send(order *pb.Order) error {
+	s.orders = append(s.orders, order)
+	return nil
+}
+
+func (suite *OrderServiceSuite) TestExportOrders() {
+	deliverymanID := "075f0eef-0891-45ad-a3de-d6684c7f390d"
+	stream := &exportOrdersStream{ctx: suite.ctx}
+
+	suite.repo.On("FindByDeliverymanID", suite.ctx, deliverymanID, mock.AnythingOfType("func(*order.Order) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(2).(func(*order.Order) error)
+			for _, name := range []string{"mesa", "bola"} {
+				suite.Require().NoError(fn(&order.Order{
+					ID:            primitive.NewObjectID(),
+					DeliverymanID: deliverymanID,
+					Product:       order.Product{Name: name},
+				}))
+			}
+		}).
+		Return(nil)
+
+	err := suite.svc.ExportOrders(&pb.ExportOrdersRequest{DeliverymanId: deliverymanID}, stream)
+	suite.NoError(err)
+	suite.Len(stream.orders, 2)
+	suite.Equal("mesa", stream.orders[0].GetProduct().GetName())
+	suite.Equal(deliverymanID, stream.orders[1].GetDeliverymanId())
+}
+
+func (suite *OrderServiceSuite) TestExportOrdersValidation() {
+	stream := &exportOrdersStream{ctx: suite.ctx}
+
+	err := suite.svc.ExportOrders(&pb.ExportOrdersRequest{DeliverymanId: "1234567"}, stream)
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "FindByDeliverymanID", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestOrderServiceSuite(t *testing.T) {
 	suite.Run(t, new(OrderServiceSuite))
 }
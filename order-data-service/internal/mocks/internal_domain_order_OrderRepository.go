@@ -40,6 +40,20 @@ func (_m *OrderRepository_internal_domain_order) FindAll(ctx context.Context, pl
 	return r0, r1
 }
 
+// FindByDeliverymanID provides a mock function with given fields: ctx, deliverymanID, fn
+func (_m *OrderRepository_internal_domain_order) FindByDeliverymanID(ctx context.Context, deliverymanID string, fn func(*order.Order) error) error {
+	ret := _m.Called(ctx, deliverymanID, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, func(*order.Order) error) error); ok {
+		r0 = rf(ctx, deliverymanID, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // FindByID provides a mock function with given fields: ctx, id
 func (_m *OrderRepository_internal_domain_order) FindByID(ctx context.Context, id string) (*order.Order, error) {
 	ret := _m.Called(ctx, id)
@@ -66,6 +80,54 @@ func (_m *OrderRepository_internal_domain_order) FindByID(ctx context.Context, i
 	return r0, r1
 }
 
+// Flag provides a mock function with given fields: ctx, deliverymanID, reason
+func (_m *OrderRepository_internal_domain_order) Flag(ctx context.Context, deliverymanID string, reason string) (int64, error) {
+	ret := _m.Called(ctx, deliverymanID, reason)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int64, error)); ok {
+		return rf(ctx, deliverymanID, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = rf(ctx, deliverymanID, reason)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, deliverymanID, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Pseudonymize provides a mock function with given fields: ctx, deliverymanID, pseudonym
+func (_m *OrderRepository_internal_domain_order) Pseudonymize(ctx context.Context, deliverymanID string, pseudonym string) (int64, error) {
+	ret := _m.Called(ctx, deliverymanID, pseudonym)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int64, error)); ok {
+		return rf(ctx, deliverymanID, pseudonym)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = rf(ctx, deliverymanID, pseudonym)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, deliverymanID, pseudonym)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: ctx, _a1
 func (_m *OrderRepository_internal_domain_order) Save(ctx context.Context, _a1 *order.Order) (*order.Order, error) {
 	ret := _m.Called(ctx, _a1)
@@ -92,6 +154,56 @@ func (_m *OrderRepository_internal_domain_order) Save(ctx context.Context, _a1 *
 	return r0, r1
 }
 
+// SaveBatch provides a mock function with given fields: ctx, orders
+func (_m *OrderRepository_internal_domain_order) SaveBatch(ctx context.Context, orders []*order.Order) ([]error, error) {
+	ret := _m.Called(ctx, orders)
+
+	var r0 []error
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*order.Order) ([]error, error)); ok {
+		return rf(ctx, orders)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*order.Order) []error); ok {
+		r0 = rf(ctx, orders)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]error)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*order.Order) error); ok {
+		r1 = rf(ctx, orders)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unflag provides a mock function with given fields: ctx, deliverymanID, reason
+func (_m *OrderRepository_internal_domain_order) Unflag(ctx context.Context, deliverymanID string, reason string) (int64, error) {
+	ret := _m.Called(ctx, deliverymanID, reason)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int64, error)); ok {
+		return rf(ctx, deliverymanID, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = rf(ctx, deliverymanID, reason)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, deliverymanID, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewOrderRepository_internal_domain_order creates a new instance of OrderRepository_internal_domain_order. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOrderRepository_internal_domain_order(t interface {
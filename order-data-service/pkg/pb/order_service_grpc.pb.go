@@ -19,8 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	OrderService_Save_FullMethodName        = "/pb.OrderService/Save"
-	OrderService_GetAllOrder_FullMethodName = "/pb.OrderService/GetAllOrder"
+	OrderService_Save_FullMethodName               = "/pb.OrderService/Save"
+	OrderService_SaveBatch_FullMethodName          = "/pb.OrderService/SaveBatch"
+	OrderService_GetAllOrder_FullMethodName        = "/pb.OrderService/GetAllOrder"
+	OrderService_PseudonymizeOrders_FullMethodName = "/pb.OrderService/PseudonymizeOrders"
+	OrderService_ExportOrders_FullMethodName       = "/pb.OrderService/ExportOrders"
+	OrderService_FlagOrders_FullMethodName         = "/pb.OrderService/FlagOrders"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -28,7 +32,11 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OrderServiceClient interface {
 	Save(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
+	SaveBatch(ctx context.Context, in *SaveBatchRequest, opts ...grpc.CallOption) (*SaveBatchResponse, error)
 	GetAllOrder(ctx context.Context, in *GetAllOrderRequest, opts ...grpc.CallOption) (*GetAllOrderResponse, error)
+	PseudonymizeOrders(ctx context.Context, in *PseudonymizeOrdersRequest, opts ...grpc.CallOption) (*PseudonymizeOrdersResponse, error)
+	ExportOrders(ctx context.Context, in *ExportOrdersRequest, opts ...grpc.CallOption) (OrderService_ExportOrdersClient, error)
+	FlagOrders(ctx context.Context, in *FlagOrdersRequest, opts ...grpc.CallOption) (*FlagOrdersResponse, error)
 }
 
 type orderServiceClient struct {
@@ -48,6 +56,15 @@ func (c *orderServiceClient) Save(ctx context.Context, in *OrderRequest, opts ..
 	return out, nil
 }
 
+func (c *orderServiceClient) SaveBatch(ctx context.Context, in *SaveBatchRequest, opts ...grpc.CallOption) (*SaveBatchResponse, error) {
+	out := new(SaveBatchResponse)
+	err := c.cc.Invoke(ctx, OrderService_SaveBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) GetAllOrder(ctx context.Context, in *GetAllOrderRequest, opts ...grpc.CallOption) (*GetAllOrderResponse, error) {
 	out := new(GetAllOrderResponse)
 	err := c.cc.Invoke(ctx, OrderService_GetAllOrder_FullMethodName, in, out, opts...)
@@ -57,12 +74,66 @@ func (c *orderServiceClient) GetAllOrder(ctx context.Context, in *GetAllOrderReq
 	return out, nil
 }
 
+func (c *orderServiceClient) PseudonymizeOrders(ctx context.Context, in *PseudonymizeOrdersRequest, opts ...grpc.CallOption) (*PseudonymizeOrdersResponse, error) {
+	out := new(PseudonymizeOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderService_PseudonymizeOrders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ExportOrders(ctx context.Context, in *ExportOrdersRequest, opts ...grpc.CallOption) (OrderService_ExportOrdersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OrderService_ServiceDesc.Streams[0], OrderService_ExportOrders_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orderServiceExportOrdersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OrderService_ExportOrdersClient interface {
+	Recv() (*Order, error)
+	grpc.ClientStream
+}
+
+type orderServiceExportOrdersClient struct {
+	grpc.ClientStream
+}
+
+func (x *orderServiceExportOrdersClient) Recv() (*Order, error) {
+	m := new(Order)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *orderServiceClient) FlagOrders(ctx context.Context, in *FlagOrdersRequest, opts ...grpc.CallOption) (*FlagOrdersResponse, error) {
+	out := new(FlagOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderService_FlagOrders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility
 type OrderServiceServer interface {
 	Save(context.Context, *OrderRequest) (*OrderResponse, error)
+	SaveBatch(context.Context, *SaveBatchRequest) (*SaveBatchResponse, error)
 	GetAllOrder(context.Context, *GetAllOrderRequest) (*GetAllOrderResponse, error)
+	PseudonymizeOrders(context.Context, *PseudonymizeOrdersRequest) (*PseudonymizeOrdersResponse, error)
+	ExportOrders(*ExportOrdersRequest, OrderService_ExportOrdersServer) error
+	FlagOrders(context.Context, *FlagOrdersRequest) (*FlagOrdersResponse, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -73,9 +144,21 @@ type UnimplementedOrderServiceServer struct {
 func (UnimplementedOrderServiceServer) Save(context.Context, *OrderRequest) (*OrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Save not implemented")
 }
+func (UnimplementedOrderServiceServer) SaveBatch(context.Context, *SaveBatchRequest) (*SaveBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveBatch not implemented")
+}
 func (UnimplementedOrderServiceServer) GetAllOrder(context.Context, *GetAllOrderRequest) (*GetAllOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) PseudonymizeOrders(context.Context, *PseudonymizeOrdersRequest) (*PseudonymizeOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PseudonymizeOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) ExportOrders(*ExportOrdersRequest, OrderService_ExportOrdersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) FlagOrders(context.Context, *FlagOrdersRequest) (*FlagOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlagOrders not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 
 // UnsafeOrderServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -107,6 +190,24 @@ func _OrderService_Save_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_SaveBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SaveBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SaveBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SaveBatch(ctx, req.(*SaveBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_GetAllOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAllOrderRequest)
 	if err := dec(in); err != nil {
@@ -125,6 +226,63 @@ func _OrderService_GetAllOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_PseudonymizeOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PseudonymizeOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).PseudonymizeOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_PseudonymizeOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).PseudonymizeOrders(ctx, req.(*PseudonymizeOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ExportOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportOrdersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderServiceServer).ExportOrders(m, &orderServiceExportOrdersServer{stream})
+}
+
+type OrderService_ExportOrdersServer interface {
+	Send(*Order) error
+	grpc.ServerStream
+}
+
+type orderServiceExportOrdersServer struct {
+	grpc.ServerStream
+}
+
+func (x *orderServiceExportOrdersServer) Send(m *Order) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OrderService_FlagOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlagOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).FlagOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_FlagOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).FlagOrders(ctx, req.(*FlagOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -136,11 +294,29 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Save",
 			Handler:    _OrderService_Save_Handler,
 		},
+		{
+			MethodName: "SaveBatch",
+			Handler:    _OrderService_SaveBatch_Handler,
+		},
 		{
 			MethodName: "GetAllOrder",
 			Handler:    _OrderService_GetAllOrder_Handler,
 		},
+		{
+			MethodName: "PseudonymizeOrders",
+			Handler:    _OrderService_PseudonymizeOrders_Handler,
+		},
+		{
+			MethodName: "FlagOrders",
+			Handler:    _OrderService_FlagOrders_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportOrders",
+			Handler:       _OrderService_ExportOrders_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "service/order_service.proto",
 }
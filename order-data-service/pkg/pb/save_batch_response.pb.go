@@ -0,0 +1,238 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.21.12
+// source: response/save_batch_response.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SaveBatchResult is the outcome of the order at the same index of the
+// request, code is a google.golang.org/grpc/codes value.
+type SaveBatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt string `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Code      uint32 `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Message   string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SaveBatchResult) Reset() {
+	*x = SaveBatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_save_batch_response_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveBatchResult) ProtoMessage() {}
+
+func (x *SaveBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_response_save_batch_response_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveBatchResult.ProtoReflect.Descriptor instead.
+func (*SaveBatchResult) Descriptor() ([]byte, []int) {
+	return file_response_save_batch_response_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SaveBatchResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SaveBatchResult) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SaveBatchResult) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SaveBatchResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SaveBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*SaveBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SaveBatchResponse) Reset() {
+	*x = SaveBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_save_batch_response_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveBatchResponse) ProtoMessage() {}
+
+func (x *SaveBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_save_batch_response_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveBatchResponse.ProtoReflect.Descriptor instead.
+func (*SaveBatchResponse) Descriptor() ([]byte, []int) {
+	return file_response_save_batch_response_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SaveBatchResponse) GetResults() []*SaveBatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_response_save_batch_response_proto protoreflect.FileDescriptor
+
+var file_response_save_batch_response_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2f, 0x73, 0x61, 0x76, 0x65, 0x5f,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x6d, 0x0a, 0x0f, 0x53, 0x61, 0x76, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x42, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x42, 0x0a, 0x5a, 0x08, 0x2e,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_response_save_batch_response_proto_rawDescOnce sync.Once
+	file_response_save_batch_response_proto_rawDescData = file_response_save_batch_response_proto_rawDesc
+)
+
+func file_response_save_batch_response_proto_rawDescGZIP() []byte {
+	file_response_save_batch_response_proto_rawDescOnce.Do(func() {
+		file_response_save_batch_response_proto_rawDescData = protoimpl.X.CompressGZIP(file_response_save_batch_response_proto_rawDescData)
+	})
+	return file_response_save_batch_response_proto_rawDescData
+}
+
+var file_response_save_batch_response_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_response_save_batch_response_proto_goTypes = []interface{}{
+	(*SaveBatchResult)(nil),   // 0: pb.SaveBatchResult
+	(*SaveBatchResponse)(nil), // 1: pb.SaveBatchResponse
+}
+var file_response_save_batch_response_proto_depIdxs = []int32{
+	0, // 0: pb.SaveBatchResponse.results:type_name -> pb.SaveBatchResult
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_response_save_batch_response_proto_init() }
+func file_response_save_batch_response_proto_init() {
+	if File_response_save_batch_response_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_response_save_batch_response_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveBatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_save_batch_response_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_response_save_batch_response_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_response_save_batch_response_proto_goTypes,
+		DependencyIndexes: file_response_save_batch_response_proto_depIdxs,
+		MessageInfos:      file_response_save_batch_response_proto_msgTypes,
+	}.Build()
+	File_response_save_batch_response_proto = out.File
+	file_response_save_batch_response_proto_rawDesc = nil
+	file_response_save_batch_response_proto_goTypes = nil
+	file_response_save_batch_response_proto_depIdxs = nil
+}
@@ -0,0 +1,156 @@
+package envelope
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const (
+	HeaderMessageID     = "message-id"
+	HeaderType          = "message-type"
+	HeaderSchemaVersion = "schema-version"
+	HeaderContentType   = "content-type"
+	HeaderCorrelationID = "correlation-id"
+	HeaderTimestamp     = "timestamp"
+	HeaderImportance    = "importance"
+)
+
+const (
+	ImportanceHigh   = "high"
+	ImportanceNormal = "normal"
+	ImportanceLow    = "low"
+)
+
+// MaxPriority is the x-max-priority the RabbitMQ queues are declared with.
+const MaxPriority = 10
+
+const (
+	ContentTypeJSON         = "application/json"
+	ContentTypeEncryptedGob = "application/x-gob+aes"
+	// ContentTypeEncryptedProtobuf bodies start with the key ID used to encrypt them.
+	ContentTypeEncryptedProtobuf = "application/x-protobuf+aes"
+)
+
+type (
+	correlationKey struct{}
+	envelopeKey    struct{}
+)
+
+// Envelope describes a message sent through the broker. It travels in the
+// message metadata, next to the W3C trace context, so bodies stay unchanged.
+type Envelope struct {
+	ID            string
+	Type          string
+	SchemaVersion string
+	ContentType   string
+	CorrelationID string
+	Importance    string
+	Timestamp     time.Time
+}
+
+func New(ctx context.Context, msgType, schemaVersion, contentType string) *Envelope {
+	id := uuid.NewString()
+
+	correlationID := CorrelationID(ctx)
+	if correlationID == "" {
+		correlationID = id
+	}
+
+	return &Envelope{
+		ID:            id,
+		Type:          msgType,
+		SchemaVersion: schemaVersion,
+		ContentType:   contentType,
+		CorrelationID: correlationID,
+		Importance:    ImportanceNormal,
+		Timestamp:     time.Now().UTC(),
+	}
+}
+
+func (e *Envelope) WithImportance(importance string) *Envelope {
+	e.Importance = importance
+	return e
+}
+
+// Metadata writes the envelope into metadata, creating it when nil.
+func (e *Envelope) Metadata(metadata map[string]string) map[string]string {
+	if metadata == nil {
+		metadata = make(map[string]string, 7)
+	}
+
+	metadata[HeaderMessageID] = e.ID
+	metadata[HeaderType] = e.Type
+	metadata[HeaderSchemaVersion] = e.SchemaVersion
+	metadata[HeaderContentType] = e.ContentType
+	metadata[HeaderCorrelationID] = e.CorrelationID
+	metadata[HeaderImportance] = e.Importance
+	metadata[HeaderTimestamp] = e.Timestamp.Format(time.RFC3339Nano)
+
+	return metadata
+}
+
+// FromMetadata reads the envelope of a received message. Messages published
+// before the envelope existed return an envelope with empty fields.
+func FromMetadata(metadata map[string]string) *Envelope {
+	timestamp, _ := time.Parse(time.RFC3339Nano, metadata[HeaderTimestamp])
+
+	return &Envelope{
+		ID:            metadata[HeaderMessageID],
+		Type:          metadata[HeaderType],
+		SchemaVersion: metadata[HeaderSchemaVersion],
+		ContentType:   metadata[HeaderContentType],
+		CorrelationID: metadata[HeaderCorrelationID],
+		Importance:    metadata[HeaderImportance],
+		Timestamp:     timestamp,
+	}
+}
+
+// Priority maps an importance to the AMQP message priority, unknown values
+// are treated as normal.
+func Priority(importance string) uint8 {
+	switch importance {
+	case ImportanceHigh:
+		return 9
+	case ImportanceLow:
+		return 1
+	default:
+		return 5
+	}
+}
+
+// Inject writes the traceparent, tracestate and baggage of ctx into metadata.
+func Inject(ctx context.Context, metadata map[string]string) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(metadata))
+}
+
+// Extract returns ctx with the remote span context and baggage found in
+// metadata, so spans started from it join the producer trace.
+func Extract(ctx context.Context, metadata map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(metadata))
+}
+
+func WithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, correlationKey{}, correlationID)
+}
+
+func CorrelationID(ctx context.Context) string {
+	correlationID, _ := ctx.Value(correlationKey{}).(string)
+	return correlationID
+}
+
+func NewContext(ctx context.Context, e *Envelope) context.Context {
+	return context.WithValue(ctx, envelopeKey{}, e)
+}
+
+// FromContext returns the envelope of the message being processed, or an
+// empty envelope when ctx carries none.
+func FromContext(ctx context.Context) *Envelope {
+	if e, ok := ctx.Value(envelopeKey{}).(*Envelope); ok {
+		return e
+	}
+	return &Envelope{}
+}
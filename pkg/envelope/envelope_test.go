@@ -0,0 +1,105 @@
+package envelope_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lucasd-coder/fast-feet/pkg/envelope"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestEnvelopeMetadataRoundTrip(t *testing.T) {
+	ctx := envelope.WithCorrelationID(context.Background(), "req-1")
+
+	env := envelope.New(ctx, "order.created", "1", envelope.ContentTypeJSON).WithImportance(envelope.ImportanceHigh)
+	metadata := env.Metadata(map[string]string{"language": "en"})
+
+	got := envelope.FromMetadata(metadata)
+
+	if got.ID == "" || got.ID != env.ID {
+		t.Errorf("ID = %q, want %q", got.ID, env.ID)
+	}
+	if got.CorrelationID != "req-1" {
+		t.Errorf("CorrelationID = %q, want %q", got.CorrelationID, "req-1")
+	}
+	if got.Type != "order.created" || got.SchemaVersion != "1" || got.ContentType != envelope.ContentTypeJSON {
+		t.Errorf("FromMetadata() = %+v, want %+v", got, env)
+	}
+	if !got.Timestamp.Equal(env.Timestamp) {
+		t.Errorf("Timestamp = %v, want %v", got.Timestamp, env.Timestamp)
+	}
+	if got.Importance != envelope.ImportanceHigh {
+		t.Errorf("Importance = %q, want %q", got.Importance, envelope.ImportanceHigh)
+	}
+	if metadata["language"] != "en" {
+		t.Errorf("metadata lost existing key: %v", metadata)
+	}
+}
+
+func TestEnvelopeCorrelationDefaultsToID(t *testing.T) {
+	env := envelope.New(context.Background(), "user.created", "1", envelope.ContentTypeEncryptedGob)
+
+	if env.CorrelationID != env.ID {
+		t.Errorf("CorrelationID = %q, want %q", env.CorrelationID, env.ID)
+	}
+}
+
+func TestInjectExtract(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	metadata := map[string]string{}
+	envelope.Inject(ctx, metadata)
+
+	if metadata["traceparent"] != "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01" {
+		t.Errorf("traceparent = %q", metadata["traceparent"])
+	}
+
+	got := trace.SpanContextFromContext(envelope.Extract(context.Background(), metadata))
+	if got.TraceID() != traceID || !got.IsRemote() {
+		t.Errorf("Extract() span context = %+v", got)
+	}
+}
+
+func TestPriority(t *testing.T) {
+	tests := []struct {
+		importance string
+		want       uint8
+	}{
+		{importance: envelope.ImportanceHigh, want: 9},
+		{importance: envelope.ImportanceNormal, want: 5},
+		{importance: envelope.ImportanceLow, want: 1},
+		{importance: "", want: 5},
+	}
+	for _, tt := range tests {
+		if got := envelope.Priority(tt.importance); got != tt.want {
+			t.Errorf("Priority(%q) = %d, want %d", tt.importance, got, tt.want)
+		}
+		if got := envelope.Priority(tt.importance); got >= envelope.MaxPriority {
+			t.Errorf("Priority(%q) = %d, exceeds MaxPriority", tt.importance, got)
+		}
+	}
+}
+
+func TestEnvelopeContext(t *testing.T) {
+	if got := envelope.FromContext(context.Background()); got.ID != "" {
+		t.Errorf("FromContext() ID = %q, want empty", got.ID)
+	}
+
+	env := envelope.New(context.Background(), "order.created", "1", envelope.ContentTypeJSON)
+	ctx := envelope.NewContext(context.Background(), env)
+
+	if got := envelope.FromContext(ctx); got != env {
+		t.Errorf("FromContext() = %+v, want %+v", got, env)
+	}
+}
@@ -82,20 +82,27 @@ func InterceptorLogger(l *slog.Logger) logging.Logger {
 	})
 }
 
-func (l *Log) GetLogUnaryServerInterceptor() grpc.UnaryServerInterceptor {
-	return logging.UnaryServerInterceptor(InterceptorLogger(slog.Default()), opts...)
+// withOptions appends extra to the default options, an option given in extra
+// overrides the default one, e.g. WithLogOnEvents to stop logging payloads
+// holding personal data.
+func withOptions(extra []logging.Option) []logging.Option {
+	return append(opts[:len(opts):len(opts)], extra...)
 }
 
-func (l *Log) GetLogStreamServerInterceptor() grpc.StreamServerInterceptor {
-	return logging.StreamServerInterceptor(InterceptorLogger(slog.Default()), opts...)
+func (l *Log) GetLogUnaryServerInterceptor(extra ...logging.Option) grpc.UnaryServerInterceptor {
+	return logging.UnaryServerInterceptor(InterceptorLogger(slog.Default()), withOptions(extra)...)
 }
 
-func (l *Log) GetLogUnaryClientInterceptor() grpc.UnaryClientInterceptor {
-	return logging.UnaryClientInterceptor(InterceptorLogger(slog.Default()), opts...)
+func (l *Log) GetLogStreamServerInterceptor(extra ...logging.Option) grpc.StreamServerInterceptor {
+	return logging.StreamServerInterceptor(InterceptorLogger(slog.Default()), withOptions(extra)...)
 }
 
-func (l *Log) GetLogStreamClientInterceptor() grpc.StreamClientInterceptor {
-	return logging.StreamClientInterceptor(InterceptorLogger(slog.Default()), opts...)
+func (l *Log) GetLogUnaryClientInterceptor(extra ...logging.Option) grpc.UnaryClientInterceptor {
+	return logging.UnaryClientInterceptor(InterceptorLogger(slog.Default()), withOptions(extra)...)
+}
+
+func (l *Log) GetLogStreamClientInterceptor(extra ...logging.Option) grpc.StreamClientInterceptor {
+	return logging.StreamClientInterceptor(InterceptorLogger(slog.Default()), withOptions(extra)...)
 }
 
 type log struct {
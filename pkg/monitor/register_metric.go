@@ -25,12 +25,28 @@ func RegisterSrvMetrics() *grpcprom.ServerMetrics {
 type Metrics interface {
 	IncHits(status, queueName string)
 	ObserveResponseTime(status, queueName string, observeTime float64)
+	IncDeadLetters(reason, queueName string)
+	IncDuplicates(queueName string)
+	IncRetries(queueName string)
+	IncPanics(queueName string)
+	SetInFlight(queueName string, n int)
+	SetSaturation(queueName string, ratio float64)
+	SetQueueDepth(queueName string, depth int)
+	ObserveEndToEnd(queueName string, observeTime float64)
 }
 
 type PrometheusMetrics struct {
-	HitsTotal prometheus.Counter
-	Hits      *prometheus.CounterVec
-	Times     *prometheus.HistogramVec
+	HitsTotal   prometheus.Counter
+	Hits        *prometheus.CounterVec
+	Times       *prometheus.HistogramVec
+	DeadLetters *prometheus.CounterVec
+	Duplicates  *prometheus.CounterVec
+	Retries     *prometheus.CounterVec
+	Panics      *prometheus.CounterVec
+	InFlight    *prometheus.GaugeVec
+	Saturation  *prometheus.GaugeVec
+	QueueDepth  *prometheus.GaugeVec
+	EndToEnd    *prometheus.HistogramVec
 }
 
 func CreateMetrics(name string, reg *prometheus.Registry) (Metrics, error) {
@@ -59,6 +75,79 @@ func CreateMetrics(name string, reg *prometheus.Registry) (Metrics, error) {
 	if err := reg.Register(metr.Times); err != nil {
 		return nil, fmt.Errorf("prometheus.Register: %w", err)
 	}
+	metr.DeadLetters = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: extractLabelName(name) + "_dead_letters",
+		},
+		[]string{"reason", "queueName"},
+	)
+	if err := reg.Register(metr.DeadLetters); err != nil {
+		return nil, fmt.Errorf("prometheus.Register: %w", err)
+	}
+	metr.Duplicates = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: extractLabelName(name) + "_duplicates",
+		},
+		[]string{"queueName"},
+	)
+	if err := reg.Register(metr.Duplicates); err != nil {
+		return nil, fmt.Errorf("prometheus.Register: %w", err)
+	}
+	metr.Retries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: extractLabelName(name) + "_retries",
+		},
+		[]string{"queueName"},
+	)
+	if err := reg.Register(metr.Retries); err != nil {
+		return nil, fmt.Errorf("prometheus.Register: %w", err)
+	}
+	metr.Panics = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: extractLabelName(name) + "_panics",
+		},
+		[]string{"queueName"},
+	)
+	if err := reg.Register(metr.Panics); err != nil {
+		return nil, fmt.Errorf("prometheus.Register: %w", err)
+	}
+	metr.InFlight = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: extractLabelName(name) + "_in_flight",
+		},
+		[]string{"queueName"},
+	)
+	if err := reg.Register(metr.InFlight); err != nil {
+		return nil, fmt.Errorf("prometheus.Register: %w", err)
+	}
+	metr.Saturation = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: extractLabelName(name) + "_saturation",
+		},
+		[]string{"queueName"},
+	)
+	if err := reg.Register(metr.Saturation); err != nil {
+		return nil, fmt.Errorf("prometheus.Register: %w", err)
+	}
+	metr.QueueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: extractLabelName(name) + "_queue_depth",
+		},
+		[]string{"queueName"},
+	)
+	if err := reg.Register(metr.QueueDepth); err != nil {
+		return nil, fmt.Errorf("prometheus.Register: %w", err)
+	}
+	metr.EndToEnd = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    extractLabelName(name) + "_end_to_end_seconds",
+			Buckets: []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 300, 900, 3600},
+		},
+		[]string{"queueName"},
+	)
+	if err := reg.Register(metr.EndToEnd); err != nil {
+		return nil, fmt.Errorf("prometheus.Register: %w", err)
+	}
 
 	return &metr, nil
 }
@@ -72,6 +161,38 @@ func (metr *PrometheusMetrics) ObserveResponseTime(status, queueName string, obs
 	metr.Times.WithLabelValues(status, extractLabelName(queueName)).Observe(observeTime)
 }
 
+func (metr *PrometheusMetrics) IncDeadLetters(reason, queueName string) {
+	metr.DeadLetters.WithLabelValues(reason, extractLabelName(queueName)).Inc()
+}
+
+func (metr *PrometheusMetrics) IncDuplicates(queueName string) {
+	metr.Duplicates.WithLabelValues(extractLabelName(queueName)).Inc()
+}
+
+func (metr *PrometheusMetrics) IncRetries(queueName string) {
+	metr.Retries.WithLabelValues(extractLabelName(queueName)).Inc()
+}
+
+func (metr *PrometheusMetrics) IncPanics(queueName string) {
+	metr.Panics.WithLabelValues(extractLabelName(queueName)).Inc()
+}
+
+func (metr *PrometheusMetrics) SetInFlight(queueName string, n int) {
+	metr.InFlight.WithLabelValues(extractLabelName(queueName)).Set(float64(n))
+}
+
+func (metr *PrometheusMetrics) SetSaturation(queueName string, ratio float64) {
+	metr.Saturation.WithLabelValues(extractLabelName(queueName)).Set(ratio)
+}
+
+func (metr *PrometheusMetrics) SetQueueDepth(queueName string, depth int) {
+	metr.QueueDepth.WithLabelValues(extractLabelName(queueName)).Set(float64(depth))
+}
+
+func (metr *PrometheusMetrics) ObserveEndToEnd(queueName string, observeTime float64) {
+	metr.EndToEnd.WithLabelValues(extractLabelName(queueName)).Observe(observeTime)
+}
+
 func extractLabelName(input string) string {
 	re := regexp.MustCompile(`[^a-zA-Z0-9_]`)
 	return re.ReplaceAllString(input, "_")
@@ -0,0 +1,22 @@
+package val
+
+import (
+	"regexp"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// plateRegexp accepts the old brazilian plate (ABC1234) and the Mercosul one
+// (ABC1D23).
+var plateRegexp = regexp.MustCompile(`^[A-Z]{3}-?\d[A-Z\d]\d{2}$`)
+
+func IsPlate(plate string) bool {
+	return plateRegexp.MatchString(plate)
+}
+
+func Plate(fl validator.FieldLevel) bool {
+	if fl.Field().String() == "" {
+		return true
+	}
+	return IsPlate(fl.Field().String())
+}
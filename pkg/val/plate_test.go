@@ -0,0 +1,29 @@
+package val_test
+
+import (
+	"testing"
+
+	"github.com/lucasd-coder/fast-feet/pkg/val"
+)
+
+func TestIsPlate(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want bool
+	}{
+		{"Old_ShouldReturnTrue", "ABC1234", true},
+		{"OldWithDash_ShouldReturnTrue", "ABC-1234", true},
+		{"Mercosul_ShouldReturnTrue", "ABC1D23", true},
+		{"Lowercase_ShouldReturnFalse", "abc1d23", false},
+		{"TooShort_ShouldReturnFalse", "AB1234", false},
+		{"Empty_ShouldReturnFalse", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := val.IsPlate(tt.arg); got != tt.want {
+				t.Errorf("IsPlate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -9,19 +9,52 @@ type (
 		App         `yaml:"app"`
 		Server      `yaml:"server"`
 		Log         `yaml:"logger"`
+		MongoDB     `yaml:"mongodb"`
+		Outbox      `yaml:"outbox"`
+		Publisher   `yaml:"publisher"`
 		Integration `yaml:"integration"`
 	}
 
 	App struct {
-		Name    string `env-required:"true" yaml:"name"    env:"APP_NAME"`
-		Version string `env-required:"true" yaml:"version" env:"APP_VERSION"`
-		AesKey  string `env-required:"true" yaml:"aes-key" env:"AES_KEY"`
+		Name     string `env-required:"true" yaml:"name"    env:"APP_NAME"`
+		Version  string `env-required:"true" yaml:"version" env:"APP_VERSION"`
+		AesKey   string `env-required:"true" yaml:"aes-key" env:"AES_KEY"`
+		AesKeyID string `yaml:"aes-key-id" env:"AES_KEY_ID" env-default:"v1"`
 	}
 
 	Log struct {
 		Level string `env-required:"true" yaml:"log_level"   env:"LOG_LEVEL"`
 	}
 
+	MongoDB struct {
+		URL                string           `env-required:"true" yaml:"url" env:"MONGODB_URL"`
+		MongoDBConnTimeout time.Duration    `yaml:"connTimeout" default:"10s"`
+		MongoDatabase      string           `env-required:"true" yaml:"database"`
+		MongoCollections   MongoCollections `env-required:"true" yaml:"collections"`
+	}
+
+	MongoCollections struct {
+		OutboxCollection `env-required:"true" yaml:"outbox"`
+	}
+
+	OutboxCollection struct {
+		Collection string `env-required:"true" yaml:"collection"`
+	}
+
+	Outbox struct {
+		PollInterval time.Duration `yaml:"poll-interval" env-default:"1s"`
+		BatchSize    int64         `yaml:"batch-size" env-default:"50"`
+		LockTimeout  time.Duration `yaml:"lock-timeout" env-default:"30s"`
+		MinBackoff   time.Duration `yaml:"min-backoff" env-default:"1s"`
+		MaxBackoff   time.Duration `yaml:"max-backoff" env-default:"5m"`
+		Retention    time.Duration `yaml:"retention" env-default:"168h"`
+	}
+
+	Publisher struct {
+		HealthCheckInterval time.Duration `yaml:"health-check-interval" env-default:"10s"`
+		ShutdownTimeout     time.Duration `yaml:"shutdown-timeout" env-default:"10s"`
+	}
+
 	Integration struct {
 		RabbitMQ      `env-required:"true" yaml:"rabbit-mq"`
 		GrpcClient    `env-required:"true" yaml:"grpc"`
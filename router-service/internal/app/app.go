@@ -15,9 +15,11 @@ import (
 	"github.com/lucasd-coder/fast-feet/router-service/config"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/controller"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/provider/middleware"
+	"github.com/lucasd-coder/fast-feet/router-service/internal/provider/publish"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/shared"
 
 	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"github.com/lucasd-coder/fast-feet/pkg/mongodb"
 	"github.com/lucasd-coder/fast-feet/pkg/monitor"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -35,19 +37,49 @@ func Run(cfg *config.Config) {
 
 	tp, err := monitor.RegisterOtel(ctx, &optOtel)
 	if err != nil {
-		logDefault.Error("Error creating register otel ", err)
+		logDefault.Error("Error creating register otel", "err", err)
 		return
 	}
 	defer func() {
 		if err := tp.Shutdown(ctx); err != nil {
-			logDefault.Error("Error shutting down tracer server provider ", err)
+			logDefault.Error("Error shutting down tracer server provider", "err", err)
 		}
 	}()
 	opencensus.InstallTraceBridge()
+
+	optMongo := shared.NewOptMongoDB(cfg)
+	mongodb.SetUpMongoDB(ctx, &optMongo)
+
+	defer func() {
+		if err := mongodb.CloseConnMongoDB(ctx); err != nil {
+			logDefault.Error("Unable to disconnect", "err", err)
+		}
+	}()
+
+	if err := InitializeOutboxRepository().EnsureIndexes(ctx); err != nil {
+		logDefault.Error("Error creating outbox indexes", "err", err)
+		return
+	}
+
+	publish.SetUpPool(ctx, publish.NewPoolOptions(cfg))
+	go publish.GetPool().Start(ctx)
+
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cfg.ShutdownTimeout)
+		defer cancel()
+		if err := publish.ClosePool(shutdownCtx); err != nil {
+			logDefault.Error(fmt.Sprintf("Unable to close publisher pool: %v", err))
+		}
+	}()
+
+	outboxRelay := InitializeOutboxRelay()
+	go outboxRelay.Start(ctx)
+
 	r := chi.NewRouter()
 	r.Use(middleware.OpenTelemetryMiddleware(cfg.Name))
 	r.Use(chiMiddleware.Recoverer)
 	r.Use(chiMiddleware.RequestID)
+	r.Use(middleware.CorrelationMiddleware)
 	r.Use(chiMiddleware.RealIP)
 	r.Use(middleware.LoggerMiddleware)
 	r.Use(middleware.PromMiddleware)
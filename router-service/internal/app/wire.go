@@ -6,11 +6,13 @@ package app
 import (
 	"github.com/google/wire"
 
+	"github.com/lucasd-coder/fast-feet/pkg/mongodb"
 	"github.com/lucasd-coder/fast-feet/router-service/config"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/controller"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/domain/order"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/domain/user"
 	businessservice "github.com/lucasd-coder/fast-feet/router-service/internal/provider/businessservice/repository"
+	"github.com/lucasd-coder/fast-feet/router-service/internal/provider/outbox"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/provider/publish"
 	val "github.com/lucasd-coder/fast-feet/router-service/internal/provider/validator"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/shared"
@@ -34,18 +36,62 @@ func extractOptionUserEvents() *shared.Options {
 	}
 }
 
+func extractOptionOutbox() *outbox.Options {
+	cfg := config.GetConfig()
+	return &outbox.Options{
+		PollInterval: cfg.Outbox.PollInterval,
+		BatchSize:    cfg.Outbox.BatchSize,
+		LockTimeout:  cfg.Outbox.LockTimeout,
+		MinBackoff:   cfg.Outbox.MinBackoff,
+		MaxBackoff:   cfg.Outbox.MaxBackoff,
+	}
+}
+
+// extractOutboxPublishers builds the broker publishers used by the outbox relay.
+// Each relay delivery is a single attempt; retries are scheduled by the relay itself.
+func extractOutboxPublishers() outbox.Publishers {
+	cfg := config.GetConfig()
+	return outbox.Publishers{
+		cfg.TopicUserEvents.URL: publish.NewPublished(&shared.Options{
+			TopicURL:   cfg.TopicUserEvents.URL,
+			MaxRetries: 1,
+		}, publish.GetPool()),
+		cfg.TopicOrderEvents.URL: publish.NewPublished(&shared.Options{
+			TopicURL:   cfg.TopicOrderEvents.URL,
+			MaxRetries: 1,
+		}, publish.GetPool()),
+	}
+}
+
 func InitializeValidator() *val.Validation {
 	wire.Build(val.NewValidation)
 	return nil
 }
 
-func InitializeOrderEventsPublish() *publish.Published {
-	wire.Build(extractOptionOrderEvents, publish.NewPublished)
+var initializeOutboxRepository = wire.NewSet(
+	wire.Bind(new(outbox.Store), new(*outbox.Repository)),
+	config.GetConfig,
+	mongodb.GetClientMongoDB,
+	outbox.NewRepository,
+)
+
+func InitializeOutboxRepository() *outbox.Repository {
+	wire.Build(initializeOutboxRepository)
+	return nil
+}
+
+func InitializeOrderEventsPublish() *outbox.Publisher {
+	wire.Build(extractOptionOrderEvents, initializeOutboxRepository, outbox.NewPublisher)
+	return nil
+}
+
+func InitializeUserEventsPublish() *outbox.Publisher {
+	wire.Build(extractOptionUserEvents, initializeOutboxRepository, outbox.NewPublisher)
 	return nil
 }
 
-func InitializeUserEventsPublish() *publish.Published {
-	wire.Build(extractOptionUserEvents, publish.NewPublished)
+func InitializeOutboxRelay() *outbox.Relay {
+	wire.Build(extractOptionOutbox, extractOutboxPublishers, initializeOutboxRepository, outbox.NewRelay)
 	return nil
 }
 
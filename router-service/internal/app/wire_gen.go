@@ -8,11 +8,13 @@ package app
 
 import (
 	"github.com/google/wire"
+	"github.com/lucasd-coder/fast-feet/pkg/mongodb"
 	"github.com/lucasd-coder/fast-feet/router-service/config"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/controller"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/domain/order"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/domain/user"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/provider/businessservice/repository"
+	"github.com/lucasd-coder/fast-feet/router-service/internal/provider/outbox"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/provider/publish"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/provider/validator"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/shared"
@@ -25,34 +27,57 @@ func InitializeValidator() *validator.Validation {
 	return validation
 }
 
-func InitializeOrderEventsPublish() *publish.Published {
+func InitializeOutboxRepository() *outbox.Repository {
+	configConfig := config.GetConfig()
+	client := mongodb.GetClientMongoDB()
+	repository := outbox.NewRepository(configConfig, client)
+	return repository
+}
+
+func InitializeOrderEventsPublish() *outbox.Publisher {
 	options := extractOptionOrderEvents()
-	published := publish.NewPublished(options)
-	return published
+	configConfig := config.GetConfig()
+	client := mongodb.GetClientMongoDB()
+	repository := outbox.NewRepository(configConfig, client)
+	publisher := outbox.NewPublisher(options, repository)
+	return publisher
 }
 
-func InitializeUserEventsPublish() *publish.Published {
+func InitializeUserEventsPublish() *outbox.Publisher {
 	options := extractOptionUserEvents()
-	published := publish.NewPublished(options)
-	return published
+	configConfig := config.GetConfig()
+	client := mongodb.GetClientMongoDB()
+	repository := outbox.NewRepository(configConfig, client)
+	publisher := outbox.NewPublisher(options, repository)
+	return publisher
+}
+
+func InitializeOutboxRelay() *outbox.Relay {
+	configConfig := config.GetConfig()
+	client := mongodb.GetClientMongoDB()
+	repository := outbox.NewRepository(configConfig, client)
+	publishers := extractOutboxPublishers()
+	options := extractOptionOutbox()
+	relay := outbox.NewRelay(repository, publishers, options)
+	return relay
 }
 
 func InitializeUserController() *controller.UserController {
 	validation := InitializeValidator()
-	published := InitializeUserEventsPublish()
+	publisher := InitializeUserEventsPublish()
 	configConfig := config.GetConfig()
 	businessRepository := repository.NewBusinessRepository(configConfig)
-	serviceImpl := user.NewService(validation, published, configConfig, businessRepository)
+	serviceImpl := user.NewService(validation, publisher, configConfig, businessRepository)
 	userController := controller.NewUserController(serviceImpl)
 	return userController
 }
 
 func InitializeOrderController() *controller.OrderController {
 	validation := InitializeValidator()
-	published := InitializeOrderEventsPublish()
+	publisher := InitializeOrderEventsPublish()
 	configConfig := config.GetConfig()
 	businessRepository := repository.NewBusinessRepository(configConfig)
-	serviceImpl := order.NewService(validation, published, configConfig, businessRepository)
+	serviceImpl := order.NewService(validation, publisher, configConfig, businessRepository)
 	orderController := controller.NewOrderController(serviceImpl)
 	return orderController
 }
@@ -77,4 +102,33 @@ func extractOptionUserEvents() *shared.Options {
 	}
 }
 
+func extractOptionOutbox() *outbox.Options {
+	cfg := config.GetConfig()
+	return &outbox.Options{
+		PollInterval: cfg.Outbox.PollInterval,
+		BatchSize:    cfg.Outbox.BatchSize,
+		LockTimeout:  cfg.Outbox.LockTimeout,
+		MinBackoff:   cfg.Outbox.MinBackoff,
+		MaxBackoff:   cfg.Outbox.MaxBackoff,
+	}
+}
+
+// extractOutboxPublishers builds the broker publishers used by the outbox relay.
+// Each relay delivery is a single attempt; retries are scheduled by the relay itself.
+func extractOutboxPublishers() outbox.Publishers {
+	cfg := config.GetConfig()
+	return outbox.Publishers{
+		cfg.TopicUserEvents.URL: publish.NewPublished(&shared.Options{
+			TopicURL:   cfg.TopicUserEvents.URL,
+			MaxRetries: 1,
+		}, publish.GetPool()),
+		cfg.TopicOrderEvents.URL: publish.NewPublished(&shared.Options{
+			TopicURL:   cfg.TopicOrderEvents.URL,
+			MaxRetries: 1,
+		}, publish.GetPool()),
+	}
+}
+
+var initializeOutboxRepository = wire.NewSet(wire.Bind(new(outbox.Store), new(*outbox.Repository)), config.GetConfig, mongodb.GetClientMongoDB, outbox.NewRepository)
+
 var initializeBusinessRepository = wire.NewSet(wire.Bind(new(shared.BusinessRepository), new(*repository.BusinessRepository)), repository.NewBusinessRepository)
@@ -32,7 +32,16 @@ func NewRouter(
 	r.Group(func(r chi.Router) {
 		r.Route("/users", func(r chi.Router) {
 			r.Post("/", user.Save)
+			r.Get("/", user.ListUsers)
+			r.Get("/attributes/schema", user.GetAttributeSchema)
+			r.Put("/attributes/schema", user.SaveAttributeSchema)
 			r.Get("/{email}", user.FindUserByEmail)
+			r.Patch("/{id}", user.UpdateUser)
+			r.Delete("/{id}", user.EraseUser)
+			r.Post("/{id}/disable", user.DisableUser)
+			r.Post("/{id}/enable", user.EnableUser)
+			r.Get("/{id}/erasure", user.GetErasure)
+			r.Get("/{id}/export", user.ExportUser)
 		})
 	})
 
@@ -40,6 +49,8 @@ func NewRouter(
 		r.Route("/orders", func(r chi.Router) {
 			r.Post("/{userId}", order.Save)
 			r.Get("/{userId}", order.GetAllOrder)
+			r.Get("/{userId}/scheduled", order.GetScheduledOrders)
+			r.Delete("/{userId}/scheduled/{id}", order.CancelScheduledOrder)
 		})
 	})
 
@@ -76,6 +76,45 @@ func (h *OrderController) GetAllOrder(w http.ResponseWriter, r *http.Request) {
 	h.Response(ctx, w, resp, http.StatusOK)
 }
 
+func (h *OrderController) GetScheduledOrders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	log := logger.FromContext(ctx)
+
+	req := &order.GetScheduledOrdersRequest{
+		UserID: chi.URLParam(r, "userId"),
+	}
+
+	resp, err := h.orderService.GetScheduledOrders(ctx, req)
+	if err != nil {
+		log.Error(err.Error())
+		h.SendError(ctx, w, err)
+		return
+	}
+
+	h.Response(ctx, w, resp, http.StatusOK)
+}
+
+func (h *OrderController) CancelScheduledOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	log := logger.FromContext(ctx)
+
+	req := &order.CancelScheduledOrderRequest{
+		UserID: chi.URLParam(r, "userId"),
+		ID:     chi.URLParam(r, "id"),
+	}
+
+	resp, err := h.orderService.CancelScheduledOrder(ctx, req)
+	if err != nil {
+		log.Error(err.Error())
+		h.SendError(ctx, w, err)
+		return
+	}
+
+	h.Response(ctx, w, resp, http.StatusOK)
+}
+
 func (h *OrderController) extractGetAllOrderRequest(r *http.Request) *order.GetAllOrderRequest {
 	limit := h.getQueryParamConvertStringToInt(r.URL,
 		"limit", defaultLimit)
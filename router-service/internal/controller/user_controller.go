@@ -4,13 +4,25 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/lucasd-coder/fast-feet/pkg/logger"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/domain/user"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/router-service/internal/shared/bundle"
+	"github.com/lucasd-coder/fast-feet/router-service/pkg/pb"
 )
 
+// userIDHeader carries the subject of the token, the gateway propagates it
+// from the validated JWT.
+const userIDHeader = "X-User-Id"
+
+const exportWriteTimeout = 10 * time.Second
+
+const defaultListLimit = 20
+
 type UserController struct {
 	controller
 	userService user.Service
@@ -64,3 +76,217 @@ func (h *UserController) FindUserByEmail(w http.ResponseWriter, r *http.Request)
 	}
 	h.Response(ctx, w, resp, http.StatusOK)
 }
+
+// ListUsers serves a page of users, the attribute query param repeats as
+// key or key:value.
+func (h *UserController) ListUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	query := r.URL.Query()
+
+	pld := user.ListUsersRequest{
+		Cursor:      query.Get("cursor"),
+		Limit:       h.getQueryParamConvertStringToInt(r.URL, "limit", defaultListLimit),
+		NamePrefix:  query.Get("name"),
+		CreatedFrom: query.Get("createdFrom"),
+		CreatedTo:   query.Get("createdTo"),
+		Role:        strings.ToUpper(query.Get("role")),
+		Status:      query.Get("status"),
+	}
+
+	for _, attribute := range query["attribute"] {
+		if pld.Attributes == nil {
+			pld.Attributes = make(map[string]string)
+		}
+		key, value, _ := strings.Cut(attribute, ":")
+		pld.Attributes[key] = value
+	}
+
+	resp, err := h.userService.ListUsers(ctx, &pld)
+	if err != nil {
+		h.SendError(ctx, w, err)
+		return
+	}
+	h.Response(ctx, w, resp, http.StatusOK)
+}
+
+func (h *UserController) UpdateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	log := logger.FromContext(ctx)
+
+	pld := &user.UpdateUser{}
+
+	if err := json.NewDecoder(r.Body).Decode(pld); err != nil {
+		msg := fmt.Errorf("error when doing decoder payload: %w", err)
+		log.Error(msg.Error())
+		h.SendError(ctx, w, msg)
+		return
+	}
+
+	pld.ID = chi.URLParam(r, "id")
+
+	resp, err := h.userService.UpdateUser(ctx, pld)
+	if err != nil {
+		h.SendError(ctx, w, err)
+		return
+	}
+	h.Response(ctx, w, resp, http.StatusOK)
+}
+
+// SaveAttributeSchema replaces the attributes a user may have, it applies
+// to the next save or attributes update of each user.
+func (h *UserController) SaveAttributeSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	log := logger.FromContext(ctx)
+
+	pld := &user.AttributeSchema{}
+
+	if err := json.NewDecoder(r.Body).Decode(pld); err != nil {
+		msg := fmt.Errorf("error when doing decoder payload: %w", err)
+		log.Error(msg.Error())
+		h.SendError(ctx, w, msg)
+		return
+	}
+
+	resp, err := h.userService.SaveAttributeSchema(ctx, pld)
+	if err != nil {
+		h.SendError(ctx, w, err)
+		return
+	}
+	h.Response(ctx, w, resp, http.StatusOK)
+}
+
+// GetAttributeSchema lets front-ends render the attributes form.
+func (h *UserController) GetAttributeSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resp, err := h.userService.GetAttributeSchema(ctx)
+	if err != nil {
+		h.SendError(ctx, w, err)
+		return
+	}
+	h.Response(ctx, w, resp, http.StatusOK)
+}
+
+// DisableUser blocks the login of the user and ends its sessions, the open
+// orders of a deliveryman are flagged in the background.
+func (h *UserController) DisableUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pld := user.UserStatusRequest{
+		ID: chi.URLParam(r, "id"),
+	}
+
+	resp, err := h.userService.DisableUser(ctx, &pld)
+	if err != nil {
+		h.SendError(ctx, w, err)
+		return
+	}
+	h.Response(ctx, w, resp, http.StatusOK)
+}
+
+func (h *UserController) EnableUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pld := user.UserStatusRequest{
+		ID: chi.URLParam(r, "id"),
+	}
+
+	resp, err := h.userService.EnableUser(ctx, &pld)
+	if err != nil {
+		h.SendError(ctx, w, err)
+		return
+	}
+	h.Response(ctx, w, resp, http.StatusOK)
+}
+
+// EraseUser accepts a right-to-erasure request, it completes in the
+// background and GetErasure reports its progress.
+func (h *UserController) EraseUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pld := user.EraseUserRequest{
+		ID: chi.URLParam(r, "id"),
+	}
+
+	resp, err := h.userService.EraseUser(ctx, &pld)
+	if err != nil {
+		h.SendError(ctx, w, err)
+		return
+	}
+	h.Response(ctx, w, resp, http.StatusAccepted)
+}
+
+func (h *UserController) GetErasure(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pld := user.EraseUserRequest{
+		ID: chi.URLParam(r, "id"),
+	}
+
+	resp, err := h.userService.GetErasure(ctx, &pld)
+	if err != nil {
+		h.SendError(ctx, w, err)
+		return
+	}
+	h.Response(ctx, w, resp, http.StatusOK)
+}
+
+// ExportUser streams the personal data of the user as a JSON document or a
+// ZIP archive. The response starts with the first part received, an error
+// after that aborts the connection so the client never gets a truncated
+// bundle that looks complete.
+func (h *UserController) ExportUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	log := logger.FromContext(ctx)
+
+	pld := user.ExportUserRequest{
+		ID:          chi.URLParam(r, "id"),
+		RequesterID: r.Header.Get(userIDHeader),
+		Format:      r.URL.Query().Get("format"),
+	}
+
+	if pld.Format == "" {
+		pld.Format = bundle.FormatJSON
+	}
+
+	var writer bundle.Writer
+	rc := http.NewResponseController(w)
+
+	err := h.userService.ExportUser(ctx, &pld, func(part *pb.UserDataPart) error {
+		if writer == nil {
+			writer = h.startExport(w, &pld)
+		}
+		// the server write timeout bounds a whole response, an export is
+		// only bounded while it keeps making progress.
+		_ = rc.SetWriteDeadline(time.Now().Add(exportWriteTimeout))
+		return writer.Write(part)
+	})
+
+	switch {
+	case err != nil && writer == nil:
+		h.SendError(ctx, w, err)
+	case err != nil:
+		log.Errorf("export of user id: %s interrupted err: %v", pld.ID, err)
+		panic(http.ErrAbortHandler)
+	default:
+		if writer == nil {
+			writer = h.startExport(w, &pld)
+		}
+		if err := writer.Close(); err != nil {
+			log.Errorf("err during close export of user id: %s err: %v", pld.ID, err)
+		}
+	}
+}
+
+func (h *UserController) startExport(w http.ResponseWriter, pld *user.ExportUserRequest) bundle.Writer {
+	w.Header().Set("Content-type", bundle.ContentType(pld.Format))
+	w.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=\"%s.%s\"", pld.ID, pld.Format))
+	w.WriteHeader(http.StatusOK)
+
+	return bundle.NewWriter(pld.Format, w)
+}
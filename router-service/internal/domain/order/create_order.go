@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/lucasd-coder/fast-feet/pkg/envelope"
 	"github.com/lucasd-coder/fast-feet/pkg/logger"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/shared"
 )
@@ -33,10 +34,12 @@ func (s *ServiceImpl) Save(ctx context.Context, order *Order) error {
 
 	msg := shared.Message{
 		Body: enc,
-		Metadata: map[string]string{
-			"language":   "en",
-			"importance": "high",
-		},
+		Metadata: envelope.New(ctx, EventCreated, SchemaVersion, envelope.ContentTypeJSON).
+			WithImportance(order.Importance()).
+			Metadata(map[string]string{
+				"language":      "en",
+				"deliverymanId": order.DeliverymanID,
+			}),
 	}
 
 	if err := s.publish.Send(ctx, &msg); err != nil {
@@ -10,5 +10,7 @@ type (
 	Service interface {
 		Save(ctx context.Context, order *Order) error
 		GetAllOrder(ctx context.Context, pld *GetAllOrderPayload) (*pb.GetAllOrderResponse, error)
+		GetScheduledOrders(ctx context.Context, req *GetScheduledOrdersRequest) (*pb.GetScheduledOrdersResponse, error)
+		CancelScheduledOrder(ctx context.Context, req *CancelScheduledOrderRequest) (*pb.CancelScheduledOrderResponse, error)
 	}
 )
@@ -1,9 +1,15 @@
 package order
 
 import (
+	"github.com/lucasd-coder/fast-feet/pkg/envelope"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/shared"
 )
 
+const (
+	EventCreated  = "order.created"
+	SchemaVersion = "1"
+)
+
 type Payload struct {
 	Data      Order  `json:"data,omitempty" validate:"required"`
 	EventDate string `json:"eventDate,omitempty" validate:"required,rfc3339"`
@@ -14,6 +20,8 @@ type Order struct {
 	DeliverymanID string  `json:"deliverymanId,omitempty" validate:"required,uuid4"`
 	Product       Product `json:"product,omitempty" validate:"required"`
 	Address       Address `json:"address,omitempty" validate:"required"`
+	Express       bool    `json:"express,omitempty"`
+	ScheduledFor  string  `json:"scheduledFor,omitempty" validate:"rfc3339"`
 }
 
 type Product struct {
@@ -25,6 +33,14 @@ type Address struct {
 	Number     int32  `json:"number,omitempty" validate:"numeric=integer,min=1"`
 }
 
+// Importance of the order event, express orders skip ahead of bulk work.
+func (order *Order) Importance() string {
+	if order.Express {
+		return envelope.ImportanceHigh
+	}
+	return envelope.ImportanceNormal
+}
+
 func (order *Order) Validate(val shared.Validator) error {
 	return val.ValidateStruct(order)
 }
@@ -33,6 +49,8 @@ type CreateOrder struct {
 	DeliverymanID string  `json:"deliverymanId,omitempty"`
 	Product       Product `json:"product,omitempty"`
 	Address       Address `json:"address,omitempty"`
+	Express       bool    `json:"express,omitempty"`
+	ScheduledFor  string  `json:"scheduledFor,omitempty"`
 }
 
 func (c *CreateOrder) NewOrder(userID string) *Order {
@@ -44,6 +62,8 @@ func (c *CreateOrder) NewOrder(userID string) *Order {
 		DeliverymanID: c.DeliverymanID,
 		Product:       c.Product,
 		Address:       c.Address,
+		Express:       c.Express,
+		ScheduledFor:  c.ScheduledFor,
 	}
 }
 
@@ -82,3 +102,20 @@ type GetAllOrderPayload struct {
 func (g *GetAllOrderPayload) Validate(val shared.Validator) error {
 	return val.ValidateStruct(g)
 }
+
+type GetScheduledOrdersRequest struct {
+	UserID string `json:"userId,omitempty" validate:"required,uuid4"`
+}
+
+func (g *GetScheduledOrdersRequest) Validate(val shared.Validator) error {
+	return val.ValidateStruct(g)
+}
+
+type CancelScheduledOrderRequest struct {
+	UserID string `json:"userId,omitempty" validate:"required,uuid4"`
+	ID     string `json:"id,omitempty" validate:"required,uuid4"`
+}
+
+func (c *CancelScheduledOrderRequest) Validate(val shared.Validator) error {
+	return val.ValidateStruct(c)
+}
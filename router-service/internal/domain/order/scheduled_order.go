@@ -0,0 +1,62 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"github.com/lucasd-coder/fast-feet/router-service/internal/shared/errors"
+	"github.com/lucasd-coder/fast-feet/router-service/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServiceImpl) GetScheduledOrders(ctx context.Context,
+	req *GetScheduledOrdersRequest) (*pb.GetScheduledOrdersResponse, error) {
+	log := logger.FromContext(ctx)
+
+	slog.With("payload", req).Info("received request")
+
+	if err := req.Validate(s.validate); err != nil {
+		msg := fmt.Errorf("err validating payload: %w", err)
+		log.Error(msg.Error())
+		return nil, msg
+	}
+
+	res, err := s.businessRepo.GetScheduledOrders(ctx, &pb.GetScheduledOrdersRequest{UserId: req.UserID})
+	if err != nil {
+		return nil, fmt.Errorf("fail call businessRepository err: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *ServiceImpl) CancelScheduledOrder(ctx context.Context,
+	req *CancelScheduledOrderRequest) (*pb.CancelScheduledOrderResponse, error) {
+	log := logger.FromContext(ctx)
+
+	slog.With("payload", req).Info("received request")
+
+	if err := req.Validate(s.validate); err != nil {
+		msg := fmt.Errorf("err validating payload: %w", err)
+		log.Error(msg.Error())
+		return nil, msg
+	}
+
+	res, err := s.businessRepo.CancelScheduledOrder(ctx, &pb.CancelScheduledOrderRequest{
+		UserId: req.UserID,
+		Id:     req.ID,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return nil, errors.ErrScheduledOrderNotFound
+		case codes.FailedPrecondition:
+			return nil, errors.ErrScheduledOrderFired
+		}
+		return nil, fmt.Errorf("fail call businessRepository err: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,35 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"github.com/lucasd-coder/fast-feet/router-service/pkg/pb"
+)
+
+func (s *ServiceImpl) SaveAttributeSchema(ctx context.Context, pld *AttributeSchema) (*pb.AttributeSchemaResponse, error) {
+	log := logger.FromContext(ctx)
+
+	if err := pld.Validate(s.validate); err != nil {
+		msg := fmt.Errorf("err validating payload: %w", err)
+		log.Error(msg.Error())
+		return nil, msg
+	}
+
+	resp, err := s.businessRepo.SaveAttributeSchema(ctx, pld.ToAttributeSchemaRequest())
+	if err != nil {
+		return nil, fmt.Errorf("fail call businessRepository err: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (s *ServiceImpl) GetAttributeSchema(ctx context.Context) (*pb.AttributeSchemaResponse, error) {
+	resp, err := s.businessRepo.GetAttributeSchema(ctx, &pb.GetAttributeSchemaRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("fail call businessRepository err: %w", err)
+	}
+
+	return resp, nil
+}
@@ -5,10 +5,11 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/lucasd-coder/fast-feet/pkg/envelope"
 	"github.com/lucasd-coder/fast-feet/pkg/logger"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/shared"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/shared/ciphers"
-	"github.com/lucasd-coder/fast-feet/router-service/internal/shared/codec"
+	"google.golang.org/protobuf/proto"
 )
 
 func (s *ServiceImpl) Save(ctx context.Context, user *User) error {
@@ -27,16 +28,14 @@ func (s *ServiceImpl) Save(ctx context.Context, user *User) error {
 		EventDate: eventDate,
 	}
 
-	codec := codec.New[Payload]()
-
-	enc, err := codec.Encode(pld)
+	enc, err := proto.Marshal(pld.ToEvent())
 	if err != nil {
 		msg := fmt.Errorf("err encoding payload: %w", err)
 		log.Error(msg.Error())
 		return msg
 	}
 
-	encrypt, err := ciphers.Encrypt(ciphers.ExtractKey([]byte(s.cfg.AesKey)), enc)
+	encrypt, err := ciphers.Seal(s.cfg.AesKeyID, []byte(s.cfg.AesKey), enc)
 	if err != nil {
 		msg := fmt.Errorf("err encrypting payload: %w", err)
 		log.Error(msg.Error())
@@ -45,10 +44,9 @@ func (s *ServiceImpl) Save(ctx context.Context, user *User) error {
 
 	msg := shared.Message{
 		Body: encrypt,
-		Metadata: map[string]string{
-			"language":   "en",
-			"importance": "high",
-		},
+		Metadata: envelope.New(ctx, EventCreated, SchemaVersion, envelope.ContentTypeEncryptedProtobuf).Metadata(map[string]string{
+			"language": "en",
+		}),
 	}
 
 	if err := s.publish.Send(ctx, &msg); err != nil {
@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"github.com/lucasd-coder/fast-feet/router-service/internal/shared/errors"
+	"github.com/lucasd-coder/fast-feet/router-service/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServiceImpl) EraseUser(ctx context.Context, pld *EraseUserRequest) (*pb.ErasureResponse, error) {
+	log := logger.FromContext(ctx)
+
+	if err := pld.Validate(s.validate); err != nil {
+		msg := fmt.Errorf("err validating payload: %w", err)
+		log.Error(msg.Error())
+		return nil, msg
+	}
+
+	resp, err := s.businessRepo.EraseUser(ctx, &pb.EraseUserRequest{Id: pld.ID})
+	if err != nil {
+		return nil, fmt.Errorf("fail call businessRepository err: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (s *ServiceImpl) GetErasure(ctx context.Context, pld *EraseUserRequest) (*pb.ErasureResponse, error) {
+	log := logger.FromContext(ctx)
+
+	if err := pld.Validate(s.validate); err != nil {
+		msg := fmt.Errorf("err validating payload: %w", err)
+		log.Error(msg.Error())
+		return nil, msg
+	}
+
+	resp, err := s.businessRepo.GetErasure(ctx, &pb.EraseUserRequest{Id: pld.ID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, errors.ErrErasureNotFound
+		}
+		return nil, fmt.Errorf("fail call businessRepository err: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,42 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"github.com/lucasd-coder/fast-feet/router-service/internal/shared/errors"
+	"github.com/lucasd-coder/fast-feet/router-service/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportUser calls fn for every part of the personal data streamed by
+// business-service. An error returned before the first part means nothing
+// was exported.
+func (s *ServiceImpl) ExportUser(ctx context.Context, pld *ExportUserRequest, fn func(*pb.UserDataPart) error) error {
+	log := logger.FromContext(ctx)
+
+	if err := pld.Validate(s.validate); err != nil {
+		msg := fmt.Errorf("err validating payload: %w", err)
+		log.Error(msg.Error())
+		return msg
+	}
+
+	req := &pb.ExportUserDataRequest{
+		Id:          pld.ID,
+		RequesterId: pld.RequesterID,
+	}
+
+	err := s.businessRepo.ExportUserData(ctx, req, fn)
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.NotFound:
+		return errors.ErrUserNotFound
+	case codes.Unauthenticated, codes.PermissionDenied:
+		return errors.ErrExportForbidden
+	default:
+		return fmt.Errorf("fail call businessRepository err: %w", err)
+	}
+}
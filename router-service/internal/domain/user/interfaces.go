@@ -10,5 +10,14 @@ type (
 	Service interface {
 		Save(ctx context.Context, user *User) error
 		FindUserByEmail(ctx context.Context, pld *FindByEmailRequest) (*pb.UserResponse, error)
+		UpdateUser(ctx context.Context, pld *UpdateUser) (*pb.UserResponse, error)
+		ListUsers(ctx context.Context, pld *ListUsersRequest) (*pb.UserListResponse, error)
+		SaveAttributeSchema(ctx context.Context, pld *AttributeSchema) (*pb.AttributeSchemaResponse, error)
+		GetAttributeSchema(ctx context.Context) (*pb.AttributeSchemaResponse, error)
+		DisableUser(ctx context.Context, pld *UserStatusRequest) (*pb.UserStatusResponse, error)
+		EnableUser(ctx context.Context, pld *UserStatusRequest) (*pb.UserStatusResponse, error)
+		EraseUser(ctx context.Context, pld *EraseUserRequest) (*pb.ErasureResponse, error)
+		GetErasure(ctx context.Context, pld *EraseUserRequest) (*pb.ErasureResponse, error)
+		ExportUser(ctx context.Context, pld *ExportUserRequest, fn func(*pb.UserDataPart) error) error
 	}
 )
@@ -0,0 +1,26 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"github.com/lucasd-coder/fast-feet/router-service/pkg/pb"
+)
+
+func (s *ServiceImpl) ListUsers(ctx context.Context, pld *ListUsersRequest) (*pb.UserListResponse, error) {
+	log := logger.FromContext(ctx)
+
+	if err := pld.Validate(s.validate); err != nil {
+		msg := fmt.Errorf("err validating payload: %w", err)
+		log.Error(msg.Error())
+		return nil, msg
+	}
+
+	resp, err := s.businessRepo.ListUsers(ctx, pld.ToUserListRequest())
+	if err != nil {
+		return nil, fmt.Errorf("fail call businessRepository err: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,52 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"github.com/lucasd-coder/fast-feet/router-service/internal/shared/errors"
+	"github.com/lucasd-coder/fast-feet/router-service/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServiceImpl) DisableUser(ctx context.Context, pld *UserStatusRequest) (*pb.UserStatusResponse, error) {
+	log := logger.FromContext(ctx)
+
+	if err := pld.Validate(s.validate); err != nil {
+		msg := fmt.Errorf("err validating payload: %w", err)
+		log.Error(msg.Error())
+		return nil, msg
+	}
+
+	resp, err := s.businessRepo.DisableUser(ctx, &pb.UserStatusRequest{Id: pld.ID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, errors.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("fail call businessRepository err: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (s *ServiceImpl) EnableUser(ctx context.Context, pld *UserStatusRequest) (*pb.UserStatusResponse, error) {
+	log := logger.FromContext(ctx)
+
+	if err := pld.Validate(s.validate); err != nil {
+		msg := fmt.Errorf("err validating payload: %w", err)
+		log.Error(msg.Error())
+		return nil, msg
+	}
+
+	resp, err := s.businessRepo.EnableUser(ctx, &pb.UserStatusRequest{Id: pld.ID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, errors.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("fail call businessRepository err: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,39 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"github.com/lucasd-coder/fast-feet/router-service/internal/shared/errors"
+	"github.com/lucasd-coder/fast-feet/router-service/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServiceImpl) UpdateUser(ctx context.Context, pld *UpdateUser) (*pb.UserResponse, error) {
+	log := logger.FromContext(ctx)
+
+	if err := pld.Validate(s.validate); err != nil {
+		msg := fmt.Errorf("err validating payload: %w", err)
+		log.Error(msg.Error())
+		return nil, msg
+	}
+
+	if len(pld.UpdateMask()) == 0 {
+		return nil, errors.ErrEmptyUpdate
+	}
+
+	resp, err := s.businessRepo.UpdateUser(ctx, pld.ToUpdateUserRequest())
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return nil, errors.ErrUserNotFound
+		case codes.AlreadyExists:
+			return nil, errors.ErrUserAlreadyExists
+		}
+		return nil, fmt.Errorf("fail call businessRepository err: %w", err)
+	}
+
+	return resp, nil
+}
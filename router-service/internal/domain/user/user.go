@@ -2,6 +2,13 @@ package user
 
 import (
 	"github.com/lucasd-coder/fast-feet/router-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/router-service/pkg/pb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	EventCreated  = "user.created"
+	SchemaVersion = "2"
 )
 
 type Payload struct {
@@ -20,6 +27,59 @@ type FindByEmailRequest struct {
 	Email string `json:"email,omitempty" validate:"required,email,pattern"`
 }
 
+// UpdateUser holds a partial profile edit: only the fields present in the
+// request body are changed.
+type UpdateUser struct {
+	ID         string            `json:"-" validate:"required,uuid4"`
+	Name       *string           `json:"name,omitempty" validate:"omitempty,pattern"`
+	Email      *string           `json:"email,omitempty" validate:"omitempty,email"`
+	CPF        *string           `json:"cpf,omitempty" validate:"omitempty,isCPF"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+type UserStatusRequest struct {
+	ID string `json:"id,omitempty" validate:"required,uuid4"`
+}
+
+type EraseUserRequest struct {
+	ID string `json:"id,omitempty" validate:"required,uuid4"`
+}
+
+// ExportUserRequest asks for the personal data of ID, RequesterID is the
+// authenticated user propagated by the gateway.
+type ExportUserRequest struct {
+	ID          string `json:"id,omitempty" validate:"required,uuid4"`
+	RequesterID string `json:"requesterId,omitempty" validate:"required,uuid4"`
+	Format      string `json:"format,omitempty" validate:"required,oneof=json zip"`
+}
+
+// ListUsersRequest filters a page of users, an attribute with an empty
+// value matches every user that has the key.
+type ListUsersRequest struct {
+	Cursor      string            `json:"cursor,omitempty" validate:"omitempty,objectID"`
+	Limit       int64             `json:"limit,omitempty" validate:"gte=0,lte=100"`
+	NamePrefix  string            `json:"namePrefix,omitempty" validate:"omitempty,pattern"`
+	CreatedFrom string            `json:"createdFrom,omitempty" validate:"omitempty,rfc3339"`
+	CreatedTo   string            `json:"createdTo,omitempty" validate:"omitempty,rfc3339"`
+	Attributes  map[string]string `json:"attributes,omitempty" validate:"dive,keys,required,excludesall=.$,endkeys"`
+	Role        string            `json:"role,omitempty" validate:"omitempty,oneof=ADMIN USER"`
+	Status      string            `json:"status,omitempty" validate:"omitempty,oneof=enabled disabled"`
+}
+
+// AttributeSchema replaces the allowed user attributes, user-manager-service
+// checks the patterns and reports the invalid fields.
+type AttributeSchema struct {
+	Fields []AttributeField `json:"fields" validate:"dive"`
+}
+
+type AttributeField struct {
+	Key       string `json:"key" validate:"required,excludesall=.$"`
+	Type      string `json:"type" validate:"required,oneof=STRING NUMBER BOOLEAN DATE"`
+	Pattern   string `json:"pattern,omitempty"`
+	Required  bool   `json:"required,omitempty"`
+	MaxLength int32  `json:"maxLength,omitempty" validate:"gte=0"`
+}
+
 func (user *User) Validate(val shared.Validator) error {
 	return val.ValidateStruct(user)
 }
@@ -27,3 +87,103 @@ func (user *User) Validate(val shared.Validator) error {
 func (f *FindByEmailRequest) Validate(val shared.Validator) error {
 	return val.ValidateStruct(f)
 }
+
+func (u *UserStatusRequest) Validate(val shared.Validator) error {
+	return val.ValidateStruct(u)
+}
+
+func (e *EraseUserRequest) Validate(val shared.Validator) error {
+	return val.ValidateStruct(e)
+}
+
+func (e *ExportUserRequest) Validate(val shared.Validator) error {
+	return val.ValidateStruct(e)
+}
+
+func (l *ListUsersRequest) Validate(val shared.Validator) error {
+	return val.ValidateStruct(l)
+}
+
+func (l *ListUsersRequest) ToUserListRequest() *pb.UserListRequest {
+	return &pb.UserListRequest{
+		Cursor:      l.Cursor,
+		Limit:       l.Limit,
+		NamePrefix:  l.NamePrefix,
+		CreatedFrom: l.CreatedFrom,
+		CreatedTo:   l.CreatedTo,
+		Attributes:  l.Attributes,
+		Role:        l.Role,
+		Status:      l.Status,
+	}
+}
+
+func (a *AttributeSchema) Validate(val shared.Validator) error {
+	return val.ValidateStruct(a)
+}
+
+func (a *AttributeSchema) ToAttributeSchemaRequest() *pb.AttributeSchemaRequest {
+	fields := make([]*pb.AttributeField, 0, len(a.Fields))
+	for _, field := range a.Fields {
+		fields = append(fields, &pb.AttributeField{
+			Key:       field.Key,
+			Type:      field.Type,
+			Pattern:   field.Pattern,
+			Required:  field.Required,
+			MaxLength: field.MaxLength,
+		})
+	}
+
+	return &pb.AttributeSchemaRequest{Fields: fields}
+}
+
+func (u *UpdateUser) Validate(val shared.Validator) error {
+	return val.ValidateStruct(u)
+}
+
+func (u *UpdateUser) UpdateMask() []string {
+	var paths []string
+	if u.Name != nil {
+		paths = append(paths, "name")
+	}
+	if u.Email != nil {
+		paths = append(paths, "email")
+	}
+	if u.CPF != nil {
+		paths = append(paths, "cpf")
+	}
+	if u.Attributes != nil {
+		paths = append(paths, "attributes")
+	}
+	return paths
+}
+
+func (u *UpdateUser) ToUpdateUserRequest() *pb.UpdateUserRequest {
+	req := &pb.UpdateUserRequest{
+		Id:         u.ID,
+		Attributes: u.Attributes,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: u.UpdateMask()},
+	}
+	if u.Name != nil {
+		req.Name = *u.Name
+	}
+	if u.Email != nil {
+		req.Email = *u.Email
+	}
+	if u.CPF != nil {
+		req.Cpf = *u.CPF
+	}
+	return req
+}
+
+func (payload *Payload) ToEvent() *pb.UserEvent {
+	return &pb.UserEvent{
+		Data: &pb.UserEventData{
+			Name:      payload.Data.Name,
+			Email:     payload.Data.Email,
+			Cpf:       payload.Data.CPF,
+			Password:  payload.Data.Password,
+			Authority: payload.Data.Authority,
+		},
+		EventDate: payload.EventDate,
+	}
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/lucasd-coder/fast-feet/pkg/logger"
 	"github.com/lucasd-coder/fast-feet/router-service/config"
@@ -34,6 +36,40 @@ func (r *BusinessRepository) GetAllOrder(ctx context.Context, req *pb.GetAllOrde
 	return client.GetAllOrder(ctx, req)
 }
 
+func (r *BusinessRepository) GetScheduledOrders(ctx context.Context,
+	req *pb.GetScheduledOrdersRequest) (*pb.GetScheduledOrdersResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := businessservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration getScheduledOrders: %+v", err)
+		return nil, fmt.Errorf("err while integration getScheduledOrders: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewOrderHandlerClient(conn)
+
+	return client.GetScheduledOrders(ctx, req)
+}
+
+func (r *BusinessRepository) CancelScheduledOrder(ctx context.Context,
+	req *pb.CancelScheduledOrderRequest) (*pb.CancelScheduledOrderResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := businessservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration cancelScheduledOrder: %+v", err)
+		return nil, fmt.Errorf("err while integration cancelScheduledOrder: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewOrderHandlerClient(conn)
+
+	return client.CancelScheduledOrder(ctx, req)
+}
+
 func (r *BusinessRepository) FindByEmail(ctx context.Context, req *pb.UserByEmailRequest) (*pb.UserResponse, error) {
 	log := logger.FromContext(ctx)
 
@@ -49,3 +85,170 @@ func (r *BusinessRepository) FindByEmail(ctx context.Context, req *pb.UserByEmai
 
 	return client.FindByEmail(ctx, req)
 }
+
+func (r *BusinessRepository) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UserResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := businessservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration updateUser: %+v", err)
+		return nil, fmt.Errorf("err while integration updateUser: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewUserHandlerClient(conn)
+
+	return client.UpdateUser(ctx, req)
+}
+
+func (r *BusinessRepository) ListUsers(ctx context.Context, req *pb.UserListRequest) (*pb.UserListResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := businessservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration listUsers: %+v", err)
+		return nil, fmt.Errorf("err while integration listUsers: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewUserHandlerClient(conn)
+
+	return client.ListUsers(ctx, req)
+}
+
+func (r *BusinessRepository) SaveAttributeSchema(ctx context.Context,
+	req *pb.AttributeSchemaRequest) (*pb.AttributeSchemaResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := businessservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration saveAttributeSchema: %+v", err)
+		return nil, fmt.Errorf("err while integration saveAttributeSchema: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewUserHandlerClient(conn)
+
+	return client.SaveAttributeSchema(ctx, req)
+}
+
+func (r *BusinessRepository) GetAttributeSchema(ctx context.Context,
+	req *pb.GetAttributeSchemaRequest) (*pb.AttributeSchemaResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := businessservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration getAttributeSchema: %+v", err)
+		return nil, fmt.Errorf("err while integration getAttributeSchema: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewUserHandlerClient(conn)
+
+	return client.GetAttributeSchema(ctx, req)
+}
+
+func (r *BusinessRepository) DisableUser(ctx context.Context, req *pb.UserStatusRequest) (*pb.UserStatusResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := businessservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration disableUser: %+v", err)
+		return nil, fmt.Errorf("err while integration disableUser: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewUserHandlerClient(conn)
+
+	return client.DisableUser(ctx, req)
+}
+
+func (r *BusinessRepository) EnableUser(ctx context.Context, req *pb.UserStatusRequest) (*pb.UserStatusResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := businessservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration enableUser: %+v", err)
+		return nil, fmt.Errorf("err while integration enableUser: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewUserHandlerClient(conn)
+
+	return client.EnableUser(ctx, req)
+}
+
+func (r *BusinessRepository) EraseUser(ctx context.Context, req *pb.EraseUserRequest) (*pb.ErasureResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := businessservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration eraseUser: %+v", err)
+		return nil, fmt.Errorf("err while integration eraseUser: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewErasureHandlerClient(conn)
+
+	return client.EraseUser(ctx, req)
+}
+
+func (r *BusinessRepository) GetErasure(ctx context.Context, req *pb.EraseUserRequest) (*pb.ErasureResponse, error) {
+	log := logger.FromContext(ctx)
+
+	conn, err := businessservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration getErasure: %+v", err)
+		return nil, fmt.Errorf("err while integration getErasure: %w", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewErasureHandlerClient(conn)
+
+	return client.GetErasure(ctx, req)
+}
+
+// ExportUserData calls fn for every part received from the stream.
+func (r *BusinessRepository) ExportUserData(ctx context.Context,
+	req *pb.ExportUserDataRequest, fn func(*pb.UserDataPart) error) error {
+	log := logger.FromContext(ctx)
+
+	conn, err := businessservice.NewClient(ctx, r.cfg)
+	if err != nil {
+		log.Errorf("err while integration exportUserData: %+v", err)
+		return fmt.Errorf("err while integration exportUserData: %w", err)
+	}
+
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	client := pb.NewExportHandlerClient(conn)
+
+	stream, err := client.ExportUserData(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		part, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(part); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/lucasd-coder/fast-feet/pkg/envelope"
+)
+
+const CorrelationIDHeader = "X-Correlation-ID"
+
+// CorrelationMiddleware stores the correlation ID sent by the client, or the
+// request ID when there is none, so it is copied into published messages.
+func CorrelationMiddleware(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		correlationID := r.Header.Get(CorrelationIDHeader)
+		if correlationID == "" {
+			correlationID = middleware.GetReqID(r.Context())
+		}
+
+		w.Header().Set(CorrelationIDHeader, correlationID)
+
+		ctx := envelope.WithCorrelationID(r.Context(), correlationID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	}
+
+	return http.HandlerFunc(fn)
+}
@@ -0,0 +1,43 @@
+package outbox
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	namespace = "outbox"
+)
+
+var (
+	messagesEnqueued = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "messages_enqueued_total",
+		Help:      "Number of messages stored in the outbox",
+	}, []string{"topic"})
+
+	messagesPublished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "messages_published_total",
+		Help:      "Number of outbox messages delivered to the broker",
+	}, []string{"topic"})
+
+	publishFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "publish_failures_total",
+		Help:      "Number of failed attempts to deliver an outbox message",
+	}, []string{"topic"})
+
+	publishLag = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "publish_lag_seconds",
+		Help:      "Time between storing a message in the outbox and delivering it",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.5, 1, 2, 5, 10, 30, 60, 300, 900, 3600},
+	}, []string{"topic"})
+
+	pendingMessages = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "pending_messages",
+		Help:      "Number of outbox messages waiting to be delivered",
+	})
+)
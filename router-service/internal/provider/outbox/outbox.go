@@ -0,0 +1,70 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/router-service/internal/shared"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	StatusPending   = "PENDING"
+	StatusPublished = "PUBLISHED"
+)
+
+var ErrNoPendingMessage = errors.New("no pending message in outbox")
+
+type Message struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	TopicURL      string             `bson:"topicUrl"`
+	Body          []byte             `bson:"body"`
+	Metadata      map[string]string  `bson:"metadata,omitempty"`
+	Status        string             `bson:"status"`
+	Attempts      int                `bson:"attempts"`
+	LastError     string             `bson:"lastError,omitempty"`
+	NextAttemptAt time.Time          `bson:"nextAttemptAt"`
+	LockedUntil   time.Time          `bson:"lockedUntil,omitempty"`
+	CreatedAt     time.Time          `bson:"createdAt"`
+	PublishedAt   time.Time          `bson:"publishedAt,omitempty"`
+}
+
+type Store interface {
+	Save(ctx context.Context, msg *Message) error
+	ClaimNext(ctx context.Context, lockTimeout time.Duration) (*Message, error)
+	MarkPublished(ctx context.Context, id primitive.ObjectID) error
+	MarkFailed(ctx context.Context, id primitive.ObjectID, nextAttemptAt time.Time, cause error) error
+	CountPending(ctx context.Context) (int64, error)
+}
+
+// Publishers maps the topic URL stored with each outbox message to the
+// publisher the relay uses to deliver it.
+type Publishers map[string]shared.Publish
+
+type Options struct {
+	PollInterval time.Duration
+	BatchSize    int64
+	LockTimeout  time.Duration
+	MinBackoff   time.Duration
+	MaxBackoff   time.Duration
+}
+
+func NewMessage(topicURL string, msg *shared.Message) *Message {
+	now := time.Now()
+	return &Message{
+		TopicURL:      topicURL,
+		Body:          msg.Body,
+		Metadata:      msg.Metadata,
+		Status:        StatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
+
+func (m *Message) ToShared() *shared.Message {
+	return &shared.Message{
+		Body:     m.Body,
+		Metadata: m.Metadata,
+	}
+}
@@ -0,0 +1,62 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lucasd-coder/fast-feet/pkg/envelope"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"github.com/lucasd-coder/fast-feet/router-service/internal/shared"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Publisher stores messages in the outbox instead of sending them to the
+// broker, so the request path does not depend on RabbitMQ being available.
+type Publisher struct {
+	opt   *shared.Options
+	store Store
+}
+
+func NewPublisher(opt *shared.Options, store Store) *Publisher {
+	return &Publisher{
+		opt:   opt,
+		store: store,
+	}
+}
+
+func (p *Publisher) Send(ctx context.Context, msg *shared.Message) error {
+	log := logger.FromContext(ctx)
+
+	tracer := otel.GetTracerProvider().Tracer(tracerName)
+
+	commonAttrs := []attribute.KeyValue{
+		attribute.String("queueURL", p.opt.TopicURL),
+	}
+	ctx, span := tracer.Start(ctx, "Outbox.Save",
+		trace.WithAttributes(commonAttrs...),
+		trace.WithSpanKind(trace.SpanKindInternal),
+	)
+	defer span.End()
+
+	if msg.Metadata == nil {
+		msg.Metadata = map[string]string{}
+	}
+	// keep the request trace, the relay continues it when delivering.
+	envelope.Inject(ctx, msg.Metadata)
+
+	m := NewMessage(p.opt.TopicURL, msg)
+
+	if err := p.store.Save(ctx, m); err != nil {
+		span.RecordError(err)
+		log.Errorf("error saving message in outbox for topicURL: %s, err: %v", p.opt.TopicURL, err)
+		return fmt.Errorf("fail outbox save err: %w", err)
+	}
+
+	messagesEnqueued.WithLabelValues(p.opt.TopicURL).Inc()
+
+	log.Infof("message %s stored in outbox for topicURL: %s", m.ID.Hex(), p.opt.TopicURL)
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/pkg/envelope"
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName = "github.com/lucasd-coder/fast-feet/router-service/outbox"
+)
+
+var errUnknownTopic = errors.New("no publisher configured for topic")
+
+// Relay polls the outbox and delivers pending messages to the broker with
+// at-least-once semantics: a message is only marked as published after the
+// broker accepted it, and failed deliveries are retried with exponential
+// backoff.
+type Relay struct {
+	store      Store
+	publishers Publishers
+	opt        *Options
+}
+
+func NewRelay(store Store, publishers Publishers, opt *Options) *Relay {
+	return &Relay{
+		store:      store,
+		publishers: publishers,
+		opt:        opt,
+	}
+}
+
+func (r *Relay) Start(ctx context.Context) {
+	log := logger.FromContext(ctx)
+
+	log.Infof("outbox relay has been started with poll interval: %s", r.opt.PollInterval)
+
+	ticker := time.NewTicker(r.opt.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("context cancelled, stopping outbox relay...")
+			return
+		case <-ticker.C:
+			r.dispatch(ctx)
+		}
+	}
+}
+
+func (r *Relay) dispatch(ctx context.Context) {
+	log := logger.FromContext(ctx)
+
+	for i := int64(0); i < r.opt.BatchSize; i++ {
+		if ctx.Err() != nil {
+			return
+		}
+
+		msg, err := r.store.ClaimNext(ctx, r.opt.LockTimeout)
+		if err != nil {
+			if !errors.Is(err, ErrNoPendingMessage) {
+				log.Errorf("error claiming outbox message: %v", err)
+			}
+			break
+		}
+
+		r.deliver(ctx, msg)
+	}
+
+	pending, err := r.store.CountPending(ctx)
+	if err != nil {
+		log.Errorf("error counting pending outbox messages: %v", err)
+		return
+	}
+	pendingMessages.Set(float64(pending))
+}
+
+func (r *Relay) deliver(ctx context.Context, msg *Message) {
+	log := logger.FromContext(ctx)
+
+	tracer := otel.GetTracerProvider().Tracer(tracerName)
+
+	commonAttrs := []attribute.KeyValue{
+		attribute.String("queueURL", msg.TopicURL),
+		attribute.String("outboxID", msg.ID.Hex()),
+		attribute.Int("attempts", msg.Attempts),
+	}
+	ctx = envelope.Extract(ctx, msg.Metadata)
+	ctx, span := tracer.Start(ctx, "Outbox.Relay",
+		trace.WithAttributes(commonAttrs...),
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer span.End()
+
+	err := r.publish(ctx, msg)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Error Publishing Message")
+		publishFailures.WithLabelValues(msg.TopicURL).Inc()
+
+		next := time.Now().Add(r.backoff(msg.Attempts))
+		log.Errorf("error publishing outbox message %s for topicURL: %s, retrying at %s, err: %v",
+			msg.ID.Hex(), msg.TopicURL, next.Format(time.RFC3339), err)
+
+		if err := r.store.MarkFailed(ctx, msg.ID, next, err); err != nil {
+			log.Errorf("error marking outbox message %s as failed: %v", msg.ID.Hex(), err)
+		}
+		return
+	}
+
+	if err := r.store.MarkPublished(ctx, msg.ID); err != nil {
+		// the lease expires and the message is delivered again,
+		// which at-least-once consumers must tolerate.
+		span.RecordError(err)
+		log.Errorf("error marking outbox message %s as published: %v", msg.ID.Hex(), err)
+		return
+	}
+
+	span.SetStatus(codes.Ok, "Successfully Publishing Message")
+	messagesPublished.WithLabelValues(msg.TopicURL).Inc()
+	publishLag.WithLabelValues(msg.TopicURL).Observe(time.Since(msg.CreatedAt).Seconds())
+}
+
+func (r *Relay) publish(ctx context.Context, msg *Message) error {
+	publisher, ok := r.publishers[msg.TopicURL]
+	if !ok {
+		return fmt.Errorf("%w: %s", errUnknownTopic, msg.TopicURL)
+	}
+
+	return publisher.Send(ctx, msg.ToShared())
+}
+
+func (r *Relay) backoff(attempts int) time.Duration {
+	wait := r.opt.MinBackoff
+	for i := 0; i < attempts && wait < r.opt.MaxBackoff; i++ {
+		wait *= 2
+	}
+
+	if wait > r.opt.MaxBackoff {
+		return r.opt.MaxBackoff
+	}
+	return wait
+}
@@ -0,0 +1,197 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/router-service/internal/provider/outbox"
+	"github.com/lucasd-coder/fast-feet/router-service/internal/shared"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const topicURL = "mem://router-service-order-events"
+
+type memoryStore struct {
+	mu       sync.Mutex
+	messages map[primitive.ObjectID]*outbox.Message
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{messages: map[primitive.ObjectID]*outbox.Message{}}
+}
+
+func (s *memoryStore) Save(_ context.Context, msg *outbox.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	msg.ID = primitive.NewObjectID()
+	s.messages[msg.ID] = msg
+	return nil
+}
+
+func (s *memoryStore) ClaimNext(_ context.Context, lockTimeout time.Duration) (*outbox.Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for _, msg := range s.messages {
+		if msg.Status == outbox.StatusPending && !msg.NextAttemptAt.After(now) && !msg.LockedUntil.After(now) {
+			msg.LockedUntil = now.Add(lockTimeout)
+			claimed := *msg
+			return &claimed, nil
+		}
+	}
+	return nil, outbox.ErrNoPendingMessage
+}
+
+func (s *memoryStore) MarkPublished(_ context.Context, id primitive.ObjectID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages[id].Status = outbox.StatusPublished
+	s.messages[id].Attempts++
+	s.messages[id].LockedUntil = time.Time{}
+	return nil
+}
+
+func (s *memoryStore) MarkFailed(_ context.Context, id primitive.ObjectID, next time.Time, cause error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages[id].NextAttemptAt = next
+	s.messages[id].LastError = cause.Error()
+	s.messages[id].Attempts++
+	s.messages[id].LockedUntil = time.Time{}
+	return nil
+}
+
+func (s *memoryStore) CountPending(_ context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var count int64
+	for _, msg := range s.messages {
+		if msg.Status == outbox.StatusPending {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (s *memoryStore) get(id primitive.ObjectID) outbox.Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.messages[id]
+}
+
+type fakePublisher struct {
+	mu   sync.Mutex
+	err  error
+	sent []*shared.Message
+}
+
+func (p *fakePublisher) Send(_ context.Context, msg *shared.Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	p.sent = append(p.sent, msg)
+	return nil
+}
+
+func (p *fakePublisher) count() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.sent)
+}
+
+type RelaySuite struct {
+	suite.Suite
+	ctx    context.Context
+	cancel context.CancelFunc
+	store  *memoryStore
+	broker *fakePublisher
+	opt    *outbox.Options
+}
+
+func (suite *RelaySuite) SetupTest() {
+	suite.ctx, suite.cancel = context.WithCancel(context.Background())
+	suite.store = newMemoryStore()
+	suite.broker = &fakePublisher{}
+	suite.opt = &outbox.Options{
+		PollInterval: 10 * time.Millisecond,
+		BatchSize:    10,
+		LockTimeout:  time.Second,
+		MinBackoff:   time.Minute,
+		MaxBackoff:   time.Hour,
+	}
+}
+
+func (suite *RelaySuite) TearDownTest() {
+	suite.cancel()
+}
+
+func (suite *RelaySuite) enqueue(topic string) primitive.ObjectID {
+	publisher := outbox.NewPublisher(&shared.Options{TopicURL: topic}, suite.store)
+	err := publisher.Send(suite.ctx, &shared.Message{
+		Body:     []byte(`{"data":{}}`),
+		Metadata: map[string]string{"importance": "high"},
+	})
+	suite.NoError(err)
+
+	for id := range suite.store.messages {
+		if suite.store.messages[id].TopicURL == topic {
+			return id
+		}
+	}
+	return primitive.NilObjectID
+}
+
+func (suite *RelaySuite) TestRelayPublishesPendingMessage() {
+	id := suite.enqueue(topicURL)
+
+	relay := outbox.NewRelay(suite.store, outbox.Publishers{topicURL: suite.broker}, suite.opt)
+	go relay.Start(suite.ctx)
+
+	suite.Eventually(func() bool {
+		return suite.store.get(id).Status == outbox.StatusPublished
+	}, time.Second, 10*time.Millisecond)
+
+	suite.Equal(1, suite.broker.count())
+	suite.Equal(1, suite.store.get(id).Attempts)
+}
+
+func (suite *RelaySuite) TestRelayBacksOffWhenBrokerIsDown() {
+	id := suite.enqueue(topicURL)
+	suite.broker.err = errors.New("connection refused")
+
+	relay := outbox.NewRelay(suite.store, outbox.Publishers{topicURL: suite.broker}, suite.opt)
+	go relay.Start(suite.ctx)
+
+	suite.Eventually(func() bool {
+		return suite.store.get(id).Attempts == 1
+	}, time.Second, 10*time.Millisecond)
+
+	msg := suite.store.get(id)
+	suite.Equal(outbox.StatusPending, msg.Status)
+	suite.Equal("connection refused", msg.LastError)
+	suite.True(msg.NextAttemptAt.After(time.Now().Add(suite.opt.MinBackoff / 2)))
+}
+
+func (suite *RelaySuite) TestRelayKeepsMessageWithUnknownTopic() {
+	id := suite.enqueue("mem://unknown")
+
+	relay := outbox.NewRelay(suite.store, outbox.Publishers{topicURL: suite.broker}, suite.opt)
+	go relay.Start(suite.ctx)
+
+	suite.Eventually(func() bool {
+		return suite.store.get(id).Attempts == 1
+	}, time.Second, 10*time.Millisecond)
+
+	suite.Equal(outbox.StatusPending, suite.store.get(id).Status)
+	suite.Zero(suite.broker.count())
+}
+
+func TestRelaySuite(t *testing.T) {
+	suite.Run(t, new(RelaySuite))
+}
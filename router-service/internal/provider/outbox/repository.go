@@ -0,0 +1,128 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/router-service/config"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type Repository struct {
+	config     *config.Config
+	connection *mongo.Client
+}
+
+func NewRepository(cfg *config.Config, con *mongo.Client) *Repository {
+	return &Repository{
+		config:     cfg,
+		connection: con,
+	}
+}
+
+// EnsureIndexes creates the index ClaimNext looks the due messages up with
+// and the TTL index that removes the published messages once the retention
+// period is over, so the collection only grows with the pending ones.
+func (repo *Repository) EnsureIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "nextAttemptAt", Value: 1},
+				{Key: "lockedUntil", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{{Key: "publishedAt", Value: 1}},
+			Options: options.Index().
+				SetExpireAfterSeconds(int32(repo.config.Outbox.Retention.Seconds())),
+		},
+	}
+
+	if _, err := repo.collection().Indexes().CreateMany(ctx, models); err != nil {
+		return fmt.Errorf("fail create outbox indexes: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *Repository) Save(ctx context.Context, msg *Message) error {
+	result, err := repo.collection().InsertOne(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	msg.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+// ClaimNext leases the oldest due message for lockTimeout, so that several
+// relay instances can poll the same collection without publishing twice
+// while the lease holds.
+func (repo *Repository) ClaimNext(ctx context.Context, lockTimeout time.Duration) (*Message, error) {
+	now := time.Now()
+
+	filter := bson.M{
+		"status":        StatusPending,
+		"nextAttemptAt": bson.M{"$lte": now},
+		"$or": bson.A{
+			bson.M{"lockedUntil": bson.M{"$exists": false}},
+			bson.M{"lockedUntil": bson.M{"$lte": now}},
+		},
+	}
+
+	update := bson.M{
+		"$set": bson.M{"lockedUntil": now.Add(lockTimeout)},
+	}
+
+	opt := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "nextAttemptAt", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	msg := new(Message)
+	if err := repo.collection().FindOneAndUpdate(ctx, filter, update, opt).Decode(msg); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrNoPendingMessage
+		}
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+func (repo *Repository) MarkPublished(ctx context.Context, id primitive.ObjectID) error {
+	update := bson.M{
+		"$set":   bson.M{"status": StatusPublished, "publishedAt": time.Now()},
+		"$inc":   bson.M{"attempts": 1},
+		"$unset": bson.M{"lockedUntil": "", "lastError": ""},
+	}
+
+	_, err := repo.collection().UpdateByID(ctx, id, update)
+	return err
+}
+
+func (repo *Repository) MarkFailed(ctx context.Context, id primitive.ObjectID, nextAttemptAt time.Time, cause error) error {
+	update := bson.M{
+		"$set":   bson.M{"nextAttemptAt": nextAttemptAt, "lastError": cause.Error()},
+		"$inc":   bson.M{"attempts": 1},
+		"$unset": bson.M{"lockedUntil": ""},
+	}
+
+	_, err := repo.collection().UpdateByID(ctx, id, update)
+	return err
+}
+
+func (repo *Repository) CountPending(ctx context.Context) (int64, error) {
+	return repo.collection().CountDocuments(ctx, bson.M{"status": StatusPending})
+}
+
+func (repo *Repository) collection() *mongo.Collection {
+	database := repo.connection.Database(repo.config.MongoDatabase)
+
+	return database.Collection(repo.config.MongoCollections.OutboxCollection.Collection)
+}
@@ -3,13 +3,12 @@ package publish
 import (
 	"context"
 
-	// revive
-	_ "gocloud.dev/pubsub/rabbitpubsub"
+	"github.com/lucasd-coder/fast-feet/router-service/internal/provider/broker"
 
 	"gocloud.dev/pubsub"
 )
 
 func NewClient(ctx context.Context, queueURL string) (*pubsub.Topic, error) {
-	client, err := pubsub.OpenTopic(ctx, queueURL)
+	client, err := broker.OpenTopic(ctx, queueURL)
 	return client, err
 }
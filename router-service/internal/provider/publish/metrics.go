@@ -0,0 +1,37 @@
+package publish
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	namespace = "publisher"
+)
+
+var (
+	sendDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "send_duration_seconds",
+		Help:      "Time spent sending a message to the broker",
+		Buckets:   []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.3, 0.6, 1, 3, 6},
+	}, []string{"topic", "status"})
+
+	sendErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "send_errors_total",
+		Help:      "Number of failed attempts to send a message to the broker",
+	}, []string{"topic"})
+
+	reconnects = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reconnects_total",
+		Help:      "Number of times a topic was reopened after losing its connection",
+	}, []string{"topic"})
+
+	topicUp = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "topic_up",
+		Help:      "Whether the topic connection is open (1) or not (0)",
+	}, []string{"topic"})
+)
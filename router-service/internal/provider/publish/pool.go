@@ -0,0 +1,198 @@
+package publish
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"github.com/lucasd-coder/fast-feet/router-service/config"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"gocloud.dev/pubsub"
+)
+
+var pool *Pool
+
+var ErrPoolClosed = errors.New("publisher pool is closed")
+
+type PoolOptions struct {
+	URLs                []string
+	HealthCheckInterval time.Duration
+}
+
+// Pool keeps one long-lived topic per URL, shared by every request, instead
+// of opening and shutting down an AMQP channel for each message.
+type Pool struct {
+	mu     sync.RWMutex
+	opt    *PoolOptions
+	topics map[string]*pubsub.Topic
+	closed bool
+}
+
+func NewPoolOptions(cfg *config.Config) *PoolOptions {
+	return &PoolOptions{
+		URLs:                []string{cfg.TopicUserEvents.URL, cfg.TopicOrderEvents.URL},
+		HealthCheckInterval: cfg.HealthCheckInterval,
+	}
+}
+
+func NewPool(opt *PoolOptions) *Pool {
+	return &Pool{
+		opt:    opt,
+		topics: make(map[string]*pubsub.Topic, len(opt.URLs)),
+	}
+}
+
+func SetUpPool(ctx context.Context, opt *PoolOptions) {
+	log := logger.FromContext(ctx)
+
+	p := NewPool(opt)
+	for _, url := range opt.URLs {
+		if _, err := p.Topic(ctx, url); err != nil {
+			log.Errorf("error opening topic for url: %s, it will be retried on use, err: %v", url, err)
+		}
+	}
+
+	pool = p
+
+	log.Info("Publisher pool started")
+}
+
+func GetPool() *Pool {
+	return pool
+}
+
+func ClosePool(ctx context.Context) error {
+	return pool.Shutdown(ctx)
+}
+
+// Topic returns the open topic for url, opening it on first use or after it
+// was dropped because its connection was lost.
+func (p *Pool) Topic(ctx context.Context, url string) (*pubsub.Topic, error) {
+	p.mu.RLock()
+	topic, ok := p.topics[url]
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return nil, ErrPoolClosed
+	}
+	if ok {
+		return topic, nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return nil, ErrPoolClosed
+	}
+	if topic, ok := p.topics[url]; ok {
+		return topic, nil
+	}
+
+	topic, err := NewClient(ctx, url)
+	if err != nil {
+		topicUp.WithLabelValues(url).Set(0)
+		return nil, fmt.Errorf("fail open topic: %s err: %w", url, err)
+	}
+
+	p.topics[url] = topic
+	topicUp.WithLabelValues(url).Set(1)
+
+	return topic, nil
+}
+
+// Release drops topic from the pool when its connection is gone, so the
+// next Topic call reconnects. Healthy topics are kept.
+func (p *Pool) Release(ctx context.Context, url string, topic *pubsub.Topic) {
+	if healthy(topic) {
+		return
+	}
+
+	p.mu.Lock()
+	current, ok := p.topics[url]
+	if !ok || current != topic {
+		p.mu.Unlock()
+		return
+	}
+	delete(p.topics, url)
+	p.mu.Unlock()
+
+	topicUp.WithLabelValues(url).Set(0)
+	reconnects.WithLabelValues(url).Inc()
+
+	go func() {
+		if err := topic.Shutdown(context.WithoutCancel(ctx)); err != nil {
+			logger.FromContext(ctx).Errorf("error closing broken topic for url: %s, err: %v", url, err)
+		}
+	}()
+}
+
+// Start checks the pooled topics every HealthCheckInterval and reopens the
+// ones that lost their connection, until ctx is cancelled.
+func (p *Pool) Start(ctx context.Context) {
+	log := logger.FromContext(ctx)
+
+	ticker := time.NewTicker(p.opt.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("context cancelled, stopping publisher pool health check...")
+			return
+		case <-ticker.C:
+			p.healthCheck(ctx)
+		}
+	}
+}
+
+func (p *Pool) healthCheck(ctx context.Context) {
+	log := logger.FromContext(ctx)
+
+	for _, url := range p.opt.URLs {
+		p.mu.RLock()
+		topic, ok := p.topics[url]
+		p.mu.RUnlock()
+
+		if ok {
+			p.Release(ctx, url, topic)
+		}
+
+		if _, err := p.Topic(ctx, url); err != nil {
+			log.Errorf("error reconnecting topic for url: %s, err: %v", url, err)
+		}
+	}
+}
+
+// Shutdown flushes pending messages and closes every topic. Topics can not
+// be obtained from the pool afterwards.
+func (p *Pool) Shutdown(ctx context.Context) error {
+	p.mu.Lock()
+	p.closed = true
+	topics := p.topics
+	p.topics = map[string]*pubsub.Topic{}
+	p.mu.Unlock()
+
+	var errs []error
+	for url, topic := range topics {
+		if err := topic.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("fail shutdown topic: %s err: %w", url, err))
+		}
+		topicUp.WithLabelValues(url).Set(0)
+	}
+
+	return errors.Join(errs...)
+}
+
+func healthy(topic *pubsub.Topic) bool {
+	var conn *amqp.Connection
+	if topic.As(&conn) {
+		return !conn.IsClosed()
+	}
+	// drivers without a connection, like mempubsub, are always healthy.
+	return true
+}
@@ -0,0 +1,93 @@
+package publish_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/router-service/internal/provider/publish"
+	"github.com/lucasd-coder/fast-feet/router-service/internal/shared"
+	"github.com/stretchr/testify/suite"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/mempubsub"
+)
+
+type PoolSuite struct {
+	suite.Suite
+	ctx   context.Context
+	url   string
+	topic *pubsub.Topic
+	sub   *pubsub.Subscription
+	pool  *publish.Pool
+}
+
+func (suite *PoolSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.url = fmt.Sprintf("mem://router-service-order-events-%d", time.Now().UnixNano())
+
+	var err error
+	suite.topic, err = pubsub.OpenTopic(suite.ctx, suite.url)
+	suite.Require().NoError(err)
+	suite.sub = mempubsub.NewSubscription(suite.topic, time.Minute)
+
+	suite.pool = publish.NewPool(&publish.PoolOptions{
+		URLs:                []string{suite.url},
+		HealthCheckInterval: time.Second,
+	})
+}
+
+func (suite *PoolSuite) TearDownTest() {
+	_ = suite.sub.Shutdown(suite.ctx)
+	_ = suite.topic.Shutdown(suite.ctx)
+}
+
+func (suite *PoolSuite) TestTopicIsReused() {
+	first, err := suite.pool.Topic(suite.ctx, suite.url)
+	suite.NoError(err)
+
+	second, err := suite.pool.Topic(suite.ctx, suite.url)
+	suite.NoError(err)
+
+	suite.Same(first, second)
+}
+
+func (suite *PoolSuite) TestConcurrentSend() {
+	publisher := publish.NewPublished(&shared.Options{TopicURL: suite.url, MaxRetries: 1}, suite.pool)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			err := publisher.Send(suite.ctx, &shared.Message{Body: []byte(fmt.Sprintf(`{"id":%d}`, i))})
+			suite.NoError(err)
+		}(i)
+	}
+	wg.Wait()
+
+	suite.NoError(suite.pool.Shutdown(suite.ctx))
+
+	ctx, cancel := context.WithTimeout(suite.ctx, 2*time.Second)
+	defer cancel()
+	for i := 0; i < 20; i++ {
+		msg, err := suite.sub.Receive(ctx)
+		suite.Require().NoError(err)
+		msg.Ack()
+	}
+}
+
+func (suite *PoolSuite) TestTopicAfterShutdown() {
+	_, err := suite.pool.Topic(suite.ctx, suite.url)
+	suite.NoError(err)
+
+	suite.NoError(suite.pool.Shutdown(suite.ctx))
+
+	_, err = suite.pool.Topic(suite.ctx, suite.url)
+	suite.ErrorIs(err, publish.ErrPoolClosed)
+}
+
+func TestPoolSuite(t *testing.T) {
+	suite.Run(t, new(PoolSuite))
+}
@@ -2,11 +2,13 @@ package publish
 
 import (
 	"context"
-	"log"
 	"time"
 
+	"github.com/lucasd-coder/fast-feet/pkg/envelope"
 	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"github.com/lucasd-coder/fast-feet/pkg/monitor"
 	"github.com/lucasd-coder/fast-feet/router-service/internal/shared"
+	amqp "github.com/rabbitmq/amqp091-go"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -14,12 +16,14 @@ import (
 )
 
 type Published struct {
-	opt *shared.Options
+	opt  *shared.Options
+	pool *Pool
 }
 
-func NewPublished(opt *shared.Options) *Published {
+func NewPublished(opt *shared.Options, pool *Pool) *Published {
 	return &Published{
-		opt: opt,
+		opt:  opt,
+		pool: pool,
 	}
 }
 
@@ -38,30 +42,31 @@ func (p *Published) Send(ctx context.Context, msg *shared.Message) error {
 	)
 	defer span.End()
 
-	client, err := NewClient(ctx, p.opt.TopicURL)
-	if err != nil {
-		span.RecordError(err)
-		logDefault.Errorf("error creating Publish client: %v", err)
+	metadata := make(map[string]string, len(msg.Metadata)+3)
+	for k, v := range msg.Metadata {
+		metadata[k] = v
 	}
-
-	defer func() {
-		if err := client.Shutdown(ctx); err != nil {
-			span.RecordError(err)
-			log.Fatalf("error client shutdown: %v", err)
-		}
-	}()
+	envelope.Inject(ctx, metadata)
 
 	m := &pubsub.Message{
 		Body:     msg.Body,
-		Metadata: msg.Metadata,
+		Metadata: metadata,
+		BeforeSend: func(asFunc func(interface{}) bool) error {
+			var publishing *amqp.Publishing
+			if asFunc(&publishing) {
+				publishing.Priority = envelope.Priority(metadata[envelope.HeaderImportance])
+			}
+			return nil
+		},
 	}
 
 	var er error
 	for i := 0; i < p.opt.MaxRetries; i++ {
-		er = client.Send(ctx, m)
+		er = p.send(ctx, m)
 		if er == nil {
 			break
 		}
+		span.RecordError(er)
 		logDefault.Errorf("error when trying to publish to queue with err: %v", er)
 
 		if i == p.opt.MaxRetries-1 {
@@ -70,8 +75,28 @@ func (p *Published) Send(ctx context.Context, msg *shared.Message) error {
 		}
 		backOffTime := time.Duration(1+i) * p.opt.WaitingTime
 		logDefault.Infof("waiting %v before retrying", backOffTime)
-		span.RecordError(err)
 		time.Sleep(backOffTime)
 	}
 	return er
 }
+
+func (p *Published) send(ctx context.Context, m *pubsub.Message) error {
+	start := time.Now()
+
+	topic, err := p.pool.Topic(ctx, p.opt.TopicURL)
+	if err == nil {
+		err = topic.Send(ctx, m)
+		if err != nil {
+			p.pool.Release(ctx, p.opt.TopicURL, topic)
+		}
+	}
+
+	status := monitor.OK
+	if err != nil {
+		status = monitor.ERROR
+		sendErrors.WithLabelValues(p.opt.TopicURL).Inc()
+	}
+	sendDuration.WithLabelValues(p.opt.TopicURL, status).Observe(time.Since(start).Seconds())
+
+	return err
+}
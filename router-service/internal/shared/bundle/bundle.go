@@ -0,0 +1,169 @@
+// Package bundle writes the parts of a personal data export as a single
+// document, either JSON or a ZIP archive with one file per section.
+package bundle
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/lucasd-coder/fast-feet/router-service/pkg/pb"
+)
+
+const (
+	FormatJSON = "json"
+	FormatZIP  = "zip"
+)
+
+const (
+	sectionProfile = "profile"
+	sectionAccount = "account"
+	sectionOrders  = "orders"
+)
+
+// Writer receives the parts in the order business-service streams them and
+// must be closed to complete the document.
+type Writer interface {
+	Write(part *pb.UserDataPart) error
+	Close() error
+}
+
+func NewWriter(format string, w io.Writer) Writer {
+	if format == FormatZIP {
+		return &zipWriter{zip: zip.NewWriter(w)}
+	}
+	return &jsonWriter{w: w}
+}
+
+func ContentType(format string) string {
+	if format == FormatZIP {
+		return "application/zip"
+	}
+	return "application/json"
+}
+
+func section(part *pb.UserDataPart) (string, interface{}) {
+	switch p := part.GetPart().(type) {
+	case *pb.UserDataPart_Profile:
+		return sectionProfile, p.Profile
+	case *pb.UserDataPart_Account:
+		return sectionAccount, p.Account
+	case *pb.UserDataPart_Order:
+		return sectionOrders, p.Order
+	default:
+		return "", nil
+	}
+}
+
+// jsonWriter writes {"profile": {...}, "account": {...}, "orders": [...]}.
+type jsonWriter struct {
+	w       io.Writer
+	started bool
+	orders  int
+}
+
+func (j *jsonWriter) Write(part *pb.UserDataPart) error {
+	name, value := section(part)
+	if value == nil {
+		return nil
+	}
+
+	content, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("fail marshal %s: %w", name, err)
+	}
+
+	var prefix string
+	switch {
+	case name == sectionOrders && j.orders > 0:
+		prefix = ","
+	case name == sectionOrders:
+		prefix = j.separator() + `"orders":[`
+	default:
+		prefix = j.separator() + `"` + name + `":`
+	}
+
+	if name == sectionOrders {
+		j.orders++
+	}
+
+	_, err = io.WriteString(j.w, prefix+string(content))
+	return err
+}
+
+func (j *jsonWriter) separator() string {
+	if !j.started {
+		j.started = true
+		return "{"
+	}
+	if j.orders > 0 {
+		return "],"
+	}
+	return ","
+}
+
+func (j *jsonWriter) Close() error {
+	suffix := "]}"
+	if j.orders == 0 {
+		suffix = j.separator() + `"orders":[]}`
+	}
+	_, err := io.WriteString(j.w, suffix)
+	return err
+}
+
+// zipWriter writes profile.json, account.json and orders.json, the orders
+// are written to the archive as they arrive.
+type zipWriter struct {
+	zip     *zip.Writer
+	current io.Writer
+	orders  int
+}
+
+func (z *zipWriter) Write(part *pb.UserDataPart) error {
+	name, value := section(part)
+	if value == nil {
+		return nil
+	}
+
+	content, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("fail marshal %s: %w", name, err)
+	}
+
+	if name != sectionOrders {
+		f, err := z.zip.Create(name + ".json")
+		if err != nil {
+			return err
+		}
+		_, err = f.Write(content)
+		return err
+	}
+
+	prefix := ","
+	if z.orders == 0 {
+		if z.current, err = z.zip.Create(sectionOrders + ".json"); err != nil {
+			return err
+		}
+		prefix = "["
+	}
+	z.orders++
+
+	_, err = io.WriteString(z.current, prefix+string(content))
+	return err
+}
+
+func (z *zipWriter) Close() error {
+	suffix := "]"
+	if z.orders == 0 {
+		var err error
+		if z.current, err = z.zip.Create(sectionOrders + ".json"); err != nil {
+			return err
+		}
+		suffix = "[]"
+	}
+	if _, err := io.WriteString(z.current, suffix); err != nil {
+		return err
+	}
+	return z.zip.Close()
+}
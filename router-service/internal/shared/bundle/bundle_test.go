@@ -0,0 +1,97 @@
+package bundle_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/lucasd-coder/fast-feet/router-service/internal/shared/bundle"
+	"github.com/lucasd-coder/fast-feet/router-service/pkg/pb"
+	"github.com/stretchr/testify/suite"
+)
+
+type BundleSuite struct {
+	suite.Suite
+	parts []*pb.UserDataPart
+}
+
+func (suite *BundleSuite) SetupTest() {
+	suite.parts = []*pb.UserDataPart{
+		{Part: &pb.UserDataPart_Profile{Profile: &pb.ExportUserResponse{UserId: "1", Cpf: "80961164088"}}},
+		{Part: &pb.UserDataPart_Account{Account: &pb.GetAccountResponse{Id: "1", Roles: []string{"USER"}}}},
+		{Part: &pb.UserDataPart_Order{Order: &pb.Order{Id: "a"}}},
+		{Part: &pb.UserDataPart_Order{Order: &pb.Order{Id: "b"}}},
+	}
+}
+
+func (suite *BundleSuite) write(format string, parts []*pb.UserDataPart) []byte {
+	var buf bytes.Buffer
+	w := bundle.NewWriter(format, &buf)
+	for _, part := range parts {
+		suite.Require().NoError(w.Write(part))
+	}
+	suite.Require().NoError(w.Close())
+	return buf.Bytes()
+}
+
+func (suite *BundleSuite) TestJSON() {
+	var doc struct {
+		Profile pb.ExportUserResponse `json:"profile"`
+		Account pb.GetAccountResponse `json:"account"`
+		Orders  []pb.Order            `json:"orders"`
+	}
+
+	suite.Require().NoError(json.Unmarshal(suite.write(bundle.FormatJSON, suite.parts), &doc))
+	suite.Equal("80961164088", doc.Profile.GetCpf())
+	suite.Equal([]string{"USER"}, doc.Account.GetRoles())
+	suite.Len(doc.Orders, 2)
+	suite.Equal("b", doc.Orders[1].GetId())
+}
+
+func (suite *BundleSuite) TestJSONWithoutOrders() {
+	content := suite.write(bundle.FormatJSON, suite.parts[:1])
+
+	var doc map[string]json.RawMessage
+	suite.Require().NoError(json.Unmarshal(content, &doc))
+	suite.JSONEq(`[]`, string(doc["orders"]))
+	suite.NotContains(doc, "account")
+}
+
+func (suite *BundleSuite) TestZIP() {
+	content := suite.write(bundle.FormatZIP, suite.parts)
+
+	r, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	suite.Require().NoError(err)
+
+	files := map[string][]byte{}
+	for _, f := range r.File {
+		rc, err := f.Open()
+		suite.Require().NoError(err)
+		files[f.Name], err = io.ReadAll(rc)
+		suite.Require().NoError(err)
+		rc.Close()
+	}
+
+	suite.Len(files, 3)
+	suite.Contains(string(files["profile.json"]), "80961164088")
+	suite.Contains(string(files["account.json"]), "USER")
+
+	var orders []pb.Order
+	suite.Require().NoError(json.Unmarshal(files["orders.json"], &orders))
+	suite.Len(orders, 2)
+}
+
+func (suite *BundleSuite) TestZIPWithoutOrders() {
+	content := suite.write(bundle.FormatZIP, suite.parts[:2])
+
+	r, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	suite.Require().NoError(err)
+	suite.Len(r.File, 3)
+	suite.Equal("orders.json", r.File[2].Name)
+}
+
+func TestBundleSuite(t *testing.T) {
+	suite.Run(t, new(BundleSuite))
+}
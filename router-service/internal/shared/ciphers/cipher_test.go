@@ -52,3 +52,60 @@ func TestDecrypt(t *testing.T) {
 		})
 	}
 }
+
+func TestOpen(t *testing.T) {
+	sealed, _ := ciphers.Seal("v2", []byte("key2"), []byte("value"))
+	tests := []struct {
+		name    string
+		secrets map[string]string
+		sealed  []byte
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "test open with rotated keys",
+			secrets: map[string]string{"v1": "key1", "v2": "key2"},
+			sealed:  sealed,
+			want:    []byte("value"),
+			wantErr: false,
+		},
+		{
+			name:    "test fail open unknown key id",
+			secrets: map[string]string{"v1": "key1"},
+			sealed:  sealed,
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "test fail open wrong secret",
+			secrets: map[string]string{"v2": "key1"},
+			sealed:  sealed,
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ciphers.Open(tt.secrets, tt.sealed)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Open() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Open() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSealUsesRandomSalt(t *testing.T) {
+	first, _ := ciphers.Seal("v1", []byte("key"), []byte("value"))
+	second, _ := ciphers.Seal("v1", []byte("key"), []byte("value"))
+
+	if reflect.DeepEqual(first, second) {
+		t.Errorf("Seal() produced the same output twice")
+	}
+	if !ciphers.IsSealed(first) {
+		t.Errorf("IsSealed() = false, want true")
+	}
+}
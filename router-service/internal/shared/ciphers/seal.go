@@ -0,0 +1,143 @@
+package ciphers
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	"github.com/lucasd-coder/fast-feet/router-service/internal/shared/errors"
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// messages of version 1 derived the key with PBKDF2, they are still opened
+	// while the ones sealed before the upgrade are in the queues.
+	sealedVersionPBKDF2 = 1
+	sealedVersion       = 2
+	saltSize            = 16
+	nonceSize           = 12
+	keySize             = 32
+	maxKeyIDSize        = 255
+)
+
+var hkdfInfo = []byte("fast-feet sealed message")
+
+var sealedMagic = []byte{0xFF, 0xFE}
+
+// Seal encrypts plaintext with a key derived from secret and a random
+// per-message salt with HKDF, the secret is a random key rather than a
+// password so it needs no stretching. The result starts with a header carrying
+// keyID, so the reader can pick the right secret while keys are being rotated:
+//
+//	magic(2) | version(1) | len(keyID)(1) | keyID | salt(16) | nonce(12) | ciphertext
+func Seal(keyID string, secret, plaintext []byte) ([]byte, error) {
+	if keyID == "" || len(keyID) > maxKeyIDSize {
+		return nil, fmt.Errorf("err invalid key id: %q", keyID)
+	}
+
+	header := make([]byte, 0, len(sealedMagic)+2+len(keyID))
+	header = append(header, sealedMagic...)
+	header = append(header, sealedVersion, byte(len(keyID)))
+	header = append(header, keyID...)
+
+	salt := make([]byte, saltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, nonceSize)
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	aesgcm, err := newGCM(sealedVersion, secret, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, len(header)+saltSize+nonceSize+len(plaintext)+aesgcm.Overhead())
+	out = append(out, header...)
+	out = append(out, salt...)
+	out = append(out, nonce...)
+	return aesgcm.Seal(out, nonce, plaintext, header), nil
+}
+
+// Open decrypts a message produced by Seal with the secret registered for
+// its key ID in secrets.
+func Open(secrets map[string]string, sealed []byte) ([]byte, error) {
+	keyID, header, err := parseHeader(sealed)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, ok := secrets[keyID]
+	if !ok {
+		return nil, fmt.Errorf("err while open key id %q: %w", keyID, errors.ErrUnknownKeyID)
+	}
+
+	rest := sealed[len(header):]
+	if len(rest) < saltSize+nonceSize {
+		return nil, fmt.Errorf("err while open: %w", errors.ErrCipherText)
+	}
+
+	salt, nonce, ciphertext := rest[:saltSize], rest[saltSize:saltSize+nonceSize], rest[saltSize+nonceSize:]
+
+	aesgcm, err := newGCM(header[len(sealedMagic)], []byte(secret), salt)
+	if err != nil {
+		return nil, err
+	}
+
+	return aesgcm.Open(nil, nonce, ciphertext, header)
+}
+
+// IsSealed reports whether data was produced by Seal rather than Encrypt.
+func IsSealed(data []byte) bool {
+	_, _, err := parseHeader(data)
+	return err == nil
+}
+
+func parseHeader(data []byte) (string, []byte, error) {
+	prefix := len(sealedMagic) + 2
+	if len(data) < prefix || !bytes.Equal(data[:len(sealedMagic)], sealedMagic) ||
+		(data[len(sealedMagic)] != sealedVersion && data[len(sealedMagic)] != sealedVersionPBKDF2) {
+		return "", nil, fmt.Errorf("err while open: %w", errors.ErrCipherText)
+	}
+
+	size := int(data[len(sealedMagic)+1])
+	if size == 0 || len(data) < prefix+size {
+		return "", nil, fmt.Errorf("err while open: %w", errors.ErrCipherText)
+	}
+
+	return string(data[prefix : prefix+size]), data[:prefix+size], nil
+}
+
+func newGCM(version byte, secret, salt []byte) (cipher.AEAD, error) {
+	key, err := deriveKey(version, secret, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func deriveKey(version byte, secret, salt []byte) ([]byte, error) {
+	if version == sealedVersionPBKDF2 {
+		return pbkdf2.Key(secret, salt, 10000, keySize, sha256.New), nil
+	}
+
+	key := make([]byte, keySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, hkdfInfo), key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	"github.com/lucasd-coder/fast-feet/pkg/mongodb"
 	"github.com/lucasd-coder/fast-feet/pkg/monitor"
 	"github.com/lucasd-coder/fast-feet/router-service/config"
 )
@@ -37,3 +38,10 @@ func NewOptOtel(cfg *config.Config) monitor.Option {
 		URL:         cfg.OpenTelemetry.URL,
 	}
 }
+
+func NewOptMongoDB(cfg *config.Config) mongodb.Option {
+	return mongodb.Option{
+		ConnTimeout: cfg.MongoDBConnTimeout,
+		URL:         cfg.MongoDB.URL,
+	}
+}
@@ -8,10 +8,20 @@ import (
 	"time"
 
 	"github.com/go-playground/validator/v10"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var ErrCipherText = errors.New("cipher text too short")
+var ErrUnknownKeyID = errors.New("unknown encryption key id")
 var ErrUserNotFound = errors.New("user not found")
+var ErrScheduledOrderNotFound = errors.New("scheduled order not found")
+var ErrScheduledOrderFired = errors.New("scheduled order already fired")
+var ErrUserAlreadyExists = errors.New("user already exists")
+var ErrEmptyUpdate = errors.New("at least one field must be provided")
+var ErrErasureNotFound = errors.New("erasure not found")
+var ErrExportForbidden = errors.New("user not allowed to export this data")
 
 type fieldError struct {
 	err validator.FieldError
@@ -51,14 +61,28 @@ func BuildError(err error) StandardError {
 	var ve validator.ValidationErrors
 	var errResp StandardError
 
+	badRequest := badRequestDetails(err)
+
 	switch {
 	case errors.As(err, &ve):
 		errResp = NewStandardError("Validation Error", http.StatusUnprocessableEntity)
 		for _, e := range ve {
 			errResp.AddError(e.StructField(), fieldError{err: e}.String())
 		}
-	case errors.Is(err, ErrUserNotFound):
+	case badRequest != nil:
+		errResp = NewStandardError("Validation Error", http.StatusUnprocessableEntity)
+		for _, violation := range badRequest.GetFieldViolations() {
+			errResp.AddError(violation.GetField(), violation.GetDescription())
+		}
+	case errors.Is(err, ErrEmptyUpdate):
+		errResp = NewStandardError(err.Error(), http.StatusUnprocessableEntity)
+	case errors.Is(err, ErrUserNotFound), errors.Is(err, ErrScheduledOrderNotFound),
+		errors.Is(err, ErrErasureNotFound):
 		errResp = NewStandardError(err.Error(), http.StatusNotFound)
+	case errors.Is(err, ErrExportForbidden):
+		errResp = NewStandardError(err.Error(), http.StatusForbidden)
+	case errors.Is(err, ErrScheduledOrderFired), errors.Is(err, ErrUserAlreadyExists):
+		errResp = NewStandardError(err.Error(), http.StatusConflict)
 	default:
 		errResp = NewStandardError(err.Error(), http.StatusInternalServerError)
 	}
@@ -66,6 +90,23 @@ func BuildError(err error) StandardError {
 	return errResp
 }
 
+// badRequestDetails returns the field violations of an invalid argument
+// returned by a downstream service, nil when there are none.
+func badRequestDetails(err error) *errdetails.BadRequest {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		return nil
+	}
+
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			return badRequest
+		}
+	}
+
+	return nil
+}
+
 func (q fieldError) String() string {
 	var sb strings.Builder
 
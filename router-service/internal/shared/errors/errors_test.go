@@ -0,0 +1,34 @@
+package errors_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/lucasd-coder/fast-feet/router-service/internal/shared/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBuildErrorFieldViolations(t *testing.T) {
+	st, err := status.New(codes.InvalidArgument, "invalid parameters").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "attributes.vehicle", Description: "attribute is required"},
+		},
+	})
+	require.NoError(t, err)
+
+	resp := errors.BuildError(fmt.Errorf("fail call businessRepository err: %w", st.Err()))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	assert.Equal(t, []errors.FieldMessage{{FieldName: "attributes.vehicle", Message: "attribute is required"}}, resp.Errors)
+}
+
+func TestBuildErrorInvalidArgumentWithoutDetails(t *testing.T) {
+	resp := errors.BuildError(status.Error(codes.InvalidArgument, "invalid parameters"))
+
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+}
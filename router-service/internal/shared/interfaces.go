@@ -18,5 +18,16 @@ type (
 	BusinessRepository interface {
 		GetAllOrder(ctx context.Context, req *pb.GetAllOrderRequest) (*pb.GetAllOrderResponse, error)
 		FindByEmail(ctx context.Context, req *pb.UserByEmailRequest) (*pb.UserResponse, error)
+		UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UserResponse, error)
+		ListUsers(ctx context.Context, req *pb.UserListRequest) (*pb.UserListResponse, error)
+		SaveAttributeSchema(ctx context.Context, req *pb.AttributeSchemaRequest) (*pb.AttributeSchemaResponse, error)
+		GetAttributeSchema(ctx context.Context, req *pb.GetAttributeSchemaRequest) (*pb.AttributeSchemaResponse, error)
+		DisableUser(ctx context.Context, req *pb.UserStatusRequest) (*pb.UserStatusResponse, error)
+		EnableUser(ctx context.Context, req *pb.UserStatusRequest) (*pb.UserStatusResponse, error)
+		EraseUser(ctx context.Context, req *pb.EraseUserRequest) (*pb.ErasureResponse, error)
+		GetErasure(ctx context.Context, req *pb.EraseUserRequest) (*pb.ErasureResponse, error)
+		ExportUserData(ctx context.Context, req *pb.ExportUserDataRequest, fn func(*pb.UserDataPart) error) error
+		GetScheduledOrders(ctx context.Context, req *pb.GetScheduledOrdersRequest) (*pb.GetScheduledOrdersResponse, error)
+		CancelScheduledOrder(ctx context.Context, req *pb.CancelScheduledOrderRequest) (*pb.CancelScheduledOrderResponse, error)
 	}
 )
@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.21.12
+// source: response/attribute_schema_response.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AttributeSchemaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields    []*AttributeField `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	UpdatedAt string            `protobuf:"bytes,2,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *AttributeSchemaResponse) Reset() {
+	*x = AttributeSchemaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_attribute_schema_response_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeSchemaResponse) ProtoMessage() {}
+
+func (x *AttributeSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_attribute_schema_response_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeSchemaResponse.ProtoReflect.Descriptor instead.
+func (*AttributeSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_response_attribute_schema_response_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AttributeSchemaResponse) GetFields() []*AttributeField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *AttributeSchemaResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+var File_response_attribute_schema_response_proto protoreflect.FileDescriptor
+
+var file_response_attribute_schema_response_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2f, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x26,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x63, 0x0a, 0x17, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0a, 0x5a, 0x08, 0x2e,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_response_attribute_schema_response_proto_rawDescOnce sync.Once
+	file_response_attribute_schema_response_proto_rawDescData = file_response_attribute_schema_response_proto_rawDesc
+)
+
+func file_response_attribute_schema_response_proto_rawDescGZIP() []byte {
+	file_response_attribute_schema_response_proto_rawDescOnce.Do(func() {
+		file_response_attribute_schema_response_proto_rawDescData = protoimpl.X.CompressGZIP(file_response_attribute_schema_response_proto_rawDescData)
+	})
+	return file_response_attribute_schema_response_proto_rawDescData
+}
+
+var file_response_attribute_schema_response_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_response_attribute_schema_response_proto_goTypes = []interface{}{
+	(*AttributeSchemaResponse)(nil), // 0: pb.AttributeSchemaResponse
+	(*AttributeField)(nil),          // 1: pb.AttributeField
+}
+var file_response_attribute_schema_response_proto_depIdxs = []int32{
+	1, // 0: pb.AttributeSchemaResponse.fields:type_name -> pb.AttributeField
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_response_attribute_schema_response_proto_init() }
+func file_response_attribute_schema_response_proto_init() {
+	if File_response_attribute_schema_response_proto != nil {
+		return
+	}
+	file_request_attribute_schema_request_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_response_attribute_schema_response_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeSchemaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_response_attribute_schema_response_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_response_attribute_schema_response_proto_goTypes,
+		DependencyIndexes: file_response_attribute_schema_response_proto_depIdxs,
+		MessageInfos:      file_response_attribute_schema_response_proto_msgTypes,
+	}.Build()
+	File_response_attribute_schema_response_proto = out.File
+	file_response_attribute_schema_response_proto_rawDesc = nil
+	file_response_attribute_schema_response_proto_goTypes = nil
+	file_response_attribute_schema_response_proto_depIdxs = nil
+}
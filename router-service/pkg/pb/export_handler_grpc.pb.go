@@ -0,0 +1,136 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: client/business_service/export_handler.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ExportHandler_ExportUserData_FullMethodName = "/pb.ExportHandler/ExportUserData"
+)
+
+// ExportHandlerClient is the client API for ExportHandler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExportHandlerClient interface {
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (ExportHandler_ExportUserDataClient, error)
+}
+
+type exportHandlerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExportHandlerClient(cc grpc.ClientConnInterface) ExportHandlerClient {
+	return &exportHandlerClient{cc}
+}
+
+func (c *exportHandlerClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (ExportHandler_ExportUserDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExportHandler_ServiceDesc.Streams[0], ExportHandler_ExportUserData_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exportHandlerExportUserDataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExportHandler_ExportUserDataClient interface {
+	Recv() (*UserDataPart, error)
+	grpc.ClientStream
+}
+
+type exportHandlerExportUserDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *exportHandlerExportUserDataClient) Recv() (*UserDataPart, error) {
+	m := new(UserDataPart)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ExportHandlerServer is the server API for ExportHandler service.
+// All implementations must embed UnimplementedExportHandlerServer
+// for forward compatibility
+type ExportHandlerServer interface {
+	ExportUserData(*ExportUserDataRequest, ExportHandler_ExportUserDataServer) error
+	mustEmbedUnimplementedExportHandlerServer()
+}
+
+// UnimplementedExportHandlerServer must be embedded to have forward compatible implementations.
+type UnimplementedExportHandlerServer struct {
+}
+
+func (UnimplementedExportHandlerServer) ExportUserData(*ExportUserDataRequest, ExportHandler_ExportUserDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedExportHandlerServer) mustEmbedUnimplementedExportHandlerServer() {}
+
+// UnsafeExportHandlerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExportHandlerServer will
+// result in compilation errors.
+type UnsafeExportHandlerServer interface {
+	mustEmbedUnimplementedExportHandlerServer()
+}
+
+func RegisterExportHandlerServer(s grpc.ServiceRegistrar, srv ExportHandlerServer) {
+	s.RegisterService(&ExportHandler_ServiceDesc, srv)
+}
+
+func _ExportHandler_ExportUserData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUserDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportHandlerServer).ExportUserData(m, &exportHandlerExportUserDataServer{stream})
+}
+
+type ExportHandler_ExportUserDataServer interface {
+	Send(*UserDataPart) error
+	grpc.ServerStream
+}
+
+type exportHandlerExportUserDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *exportHandlerExportUserDataServer) Send(m *UserDataPart) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// ExportHandler_ServiceDesc is the grpc.ServiceDesc for ExportHandler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExportHandler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.ExportHandler",
+	HandlerType: (*ExportHandlerServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportUserData",
+			Handler:       _ExportHandler_ExportUserData_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "client/business_service/export_handler.proto",
+}
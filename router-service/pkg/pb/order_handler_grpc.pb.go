@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	OrderHandler_GetAllOrder_FullMethodName = "/pb.OrderHandler/GetAllOrder"
+	OrderHandler_GetAllOrder_FullMethodName          = "/pb.OrderHandler/GetAllOrder"
+	OrderHandler_GetScheduledOrders_FullMethodName   = "/pb.OrderHandler/GetScheduledOrders"
+	OrderHandler_CancelScheduledOrder_FullMethodName = "/pb.OrderHandler/CancelScheduledOrder"
 )
 
 // OrderHandlerClient is the client API for OrderHandler service.
@@ -27,6 +29,8 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OrderHandlerClient interface {
 	GetAllOrder(ctx context.Context, in *GetAllOrderRequest, opts ...grpc.CallOption) (*GetAllOrderResponse, error)
+	GetScheduledOrders(ctx context.Context, in *GetScheduledOrdersRequest, opts ...grpc.CallOption) (*GetScheduledOrdersResponse, error)
+	CancelScheduledOrder(ctx context.Context, in *CancelScheduledOrderRequest, opts ...grpc.CallOption) (*CancelScheduledOrderResponse, error)
 }
 
 type orderHandlerClient struct {
@@ -46,11 +50,31 @@ func (c *orderHandlerClient) GetAllOrder(ctx context.Context, in *GetAllOrderReq
 	return out, nil
 }
 
+func (c *orderHandlerClient) GetScheduledOrders(ctx context.Context, in *GetScheduledOrdersRequest, opts ...grpc.CallOption) (*GetScheduledOrdersResponse, error) {
+	out := new(GetScheduledOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderHandler_GetScheduledOrders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderHandlerClient) CancelScheduledOrder(ctx context.Context, in *CancelScheduledOrderRequest, opts ...grpc.CallOption) (*CancelScheduledOrderResponse, error) {
+	out := new(CancelScheduledOrderResponse)
+	err := c.cc.Invoke(ctx, OrderHandler_CancelScheduledOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderHandlerServer is the server API for OrderHandler service.
 // All implementations must embed UnimplementedOrderHandlerServer
 // for forward compatibility
 type OrderHandlerServer interface {
 	GetAllOrder(context.Context, *GetAllOrderRequest) (*GetAllOrderResponse, error)
+	GetScheduledOrders(context.Context, *GetScheduledOrdersRequest) (*GetScheduledOrdersResponse, error)
+	CancelScheduledOrder(context.Context, *CancelScheduledOrderRequest) (*CancelScheduledOrderResponse, error)
 	mustEmbedUnimplementedOrderHandlerServer()
 }
 
@@ -61,6 +85,12 @@ type UnimplementedOrderHandlerServer struct {
 func (UnimplementedOrderHandlerServer) GetAllOrder(context.Context, *GetAllOrderRequest) (*GetAllOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllOrder not implemented")
 }
+func (UnimplementedOrderHandlerServer) GetScheduledOrders(context.Context, *GetScheduledOrdersRequest) (*GetScheduledOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledOrders not implemented")
+}
+func (UnimplementedOrderHandlerServer) CancelScheduledOrder(context.Context, *CancelScheduledOrderRequest) (*CancelScheduledOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledOrder not implemented")
+}
 func (UnimplementedOrderHandlerServer) mustEmbedUnimplementedOrderHandlerServer() {}
 
 // UnsafeOrderHandlerServer may be embedded to opt out of forward compatibility for this service.
@@ -92,6 +122,42 @@ func _OrderHandler_GetAllOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderHandler_GetScheduledOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderHandlerServer).GetScheduledOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderHandler_GetScheduledOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderHandlerServer).GetScheduledOrders(ctx, req.(*GetScheduledOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderHandler_CancelScheduledOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderHandlerServer).CancelScheduledOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderHandler_CancelScheduledOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderHandlerServer).CancelScheduledOrder(ctx, req.(*CancelScheduledOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderHandler_ServiceDesc is the grpc.ServiceDesc for OrderHandler service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -103,6 +169,14 @@ var OrderHandler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllOrder",
 			Handler:    _OrderHandler_GetAllOrder_Handler,
 		},
+		{
+			MethodName: "GetScheduledOrders",
+			Handler:    _OrderHandler_GetScheduledOrders_Handler,
+		},
+		{
+			MethodName: "CancelScheduledOrder",
+			Handler:    _OrderHandler_CancelScheduledOrder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "client/business_service/order_handler.proto",
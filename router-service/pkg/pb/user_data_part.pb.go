@@ -0,0 +1,219 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.21.12
+// source: response/user_data_part.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UserDataPart is one piece of the personal data export: the profile comes
+// first, then the account and then every order of the user.
+type UserDataPart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Part:
+	//	*UserDataPart_Profile
+	//	*UserDataPart_Account
+	//	*UserDataPart_Order
+	Part isUserDataPart_Part `protobuf_oneof:"part"`
+}
+
+func (x *UserDataPart) Reset() {
+	*x = UserDataPart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_user_data_part_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserDataPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataPart) ProtoMessage() {}
+
+func (x *UserDataPart) ProtoReflect() protoreflect.Message {
+	mi := &file_response_user_data_part_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataPart.ProtoReflect.Descriptor instead.
+func (*UserDataPart) Descriptor() ([]byte, []int) {
+	return file_response_user_data_part_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *UserDataPart) GetPart() isUserDataPart_Part {
+	if m != nil {
+		return m.Part
+	}
+	return nil
+}
+
+func (x *UserDataPart) GetProfile() *ExportUserResponse {
+	if x, ok := x.GetPart().(*UserDataPart_Profile); ok {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *UserDataPart) GetAccount() *GetAccountResponse {
+	if x, ok := x.GetPart().(*UserDataPart_Account); ok {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *UserDataPart) GetOrder() *Order {
+	if x, ok := x.GetPart().(*UserDataPart_Order); ok {
+		return x.Order
+	}
+	return nil
+}
+
+type isUserDataPart_Part interface {
+	isUserDataPart_Part()
+}
+
+type UserDataPart_Profile struct {
+	Profile *ExportUserResponse `protobuf:"bytes,1,opt,name=profile,proto3,oneof"`
+}
+
+type UserDataPart_Account struct {
+	Account *GetAccountResponse `protobuf:"bytes,2,opt,name=account,proto3,oneof"`
+}
+
+type UserDataPart_Order struct {
+	Order *Order `protobuf:"bytes,3,opt,name=order,proto3,oneof"`
+}
+
+func (*UserDataPart_Profile) isUserDataPart_Part() {}
+
+func (*UserDataPart_Account) isUserDataPart_Part() {}
+
+func (*UserDataPart_Order) isUserDataPart_Part() {}
+
+var File_response_user_data_part_proto protoreflect.FileDescriptor
+
+var file_response_user_data_part_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x02, 0x70, 0x62, 0x1a, 0x23, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2f, 0x67, 0x65,
+	0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xa1, 0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x50, 0x61, 0x72,
+	0x74, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x05, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x06, 0x0a, 0x04,
+	0x70, 0x61, 0x72, 0x74, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_response_user_data_part_proto_rawDescOnce sync.Once
+	file_response_user_data_part_proto_rawDescData = file_response_user_data_part_proto_rawDesc
+)
+
+func file_response_user_data_part_proto_rawDescGZIP() []byte {
+	file_response_user_data_part_proto_rawDescOnce.Do(func() {
+		file_response_user_data_part_proto_rawDescData = protoimpl.X.CompressGZIP(file_response_user_data_part_proto_rawDescData)
+	})
+	return file_response_user_data_part_proto_rawDescData
+}
+
+var file_response_user_data_part_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_response_user_data_part_proto_goTypes = []interface{}{
+	(*UserDataPart)(nil),       // 0: pb.UserDataPart
+	(*ExportUserResponse)(nil), // 1: pb.ExportUserResponse
+	(*GetAccountResponse)(nil), // 2: pb.GetAccountResponse
+	(*Order)(nil),              // 3: pb.Order
+}
+var file_response_user_data_part_proto_depIdxs = []int32{
+	1, // 0: pb.UserDataPart.profile:type_name -> pb.ExportUserResponse
+	2, // 1: pb.UserDataPart.account:type_name -> pb.GetAccountResponse
+	3, // 2: pb.UserDataPart.order:type_name -> pb.Order
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_response_user_data_part_proto_init() }
+func file_response_user_data_part_proto_init() {
+	if File_response_user_data_part_proto != nil {
+		return
+	}
+	file_response_get_account_response_proto_init()
+	file_response_export_user_response_proto_init()
+	file_model_order_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_response_user_data_part_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserDataPart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_response_user_data_part_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*UserDataPart_Profile)(nil),
+		(*UserDataPart_Account)(nil),
+		(*UserDataPart_Order)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_response_user_data_part_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_response_user_data_part_proto_goTypes,
+		DependencyIndexes: file_response_user_data_part_proto_depIdxs,
+		MessageInfos:      file_response_user_data_part_proto_msgTypes,
+	}.Build()
+	File_response_user_data_part_proto = out.File
+	file_response_user_data_part_proto_rawDesc = nil
+	file_response_user_data_part_proto_goTypes = nil
+	file_response_user_data_part_proto_depIdxs = nil
+}
@@ -0,0 +1,142 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.21.12
+// source: request/user_status_request.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UserStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *UserStatusRequest) Reset() {
+	*x = UserStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_request_user_status_request_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStatusRequest) ProtoMessage() {}
+
+func (x *UserStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_request_user_status_request_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserStatusRequest.ProtoReflect.Descriptor instead.
+func (*UserStatusRequest) Descriptor() ([]byte, []int) {
+	return file_request_user_status_request_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_request_user_status_request_proto protoreflect.FileDescriptor
+
+var file_request_user_status_request_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x23, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x42, 0x0a, 0x5a, 0x08,
+	0x2e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_request_user_status_request_proto_rawDescOnce sync.Once
+	file_request_user_status_request_proto_rawDescData = file_request_user_status_request_proto_rawDesc
+)
+
+func file_request_user_status_request_proto_rawDescGZIP() []byte {
+	file_request_user_status_request_proto_rawDescOnce.Do(func() {
+		file_request_user_status_request_proto_rawDescData = protoimpl.X.CompressGZIP(file_request_user_status_request_proto_rawDescData)
+	})
+	return file_request_user_status_request_proto_rawDescData
+}
+
+var file_request_user_status_request_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_request_user_status_request_proto_goTypes = []interface{}{
+	(*UserStatusRequest)(nil), // 0: pb.UserStatusRequest
+}
+var file_request_user_status_request_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_request_user_status_request_proto_init() }
+func file_request_user_status_request_proto_init() {
+	if File_request_user_status_request_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_request_user_status_request_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_request_user_status_request_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_request_user_status_request_proto_goTypes,
+		DependencyIndexes: file_request_user_status_request_proto_depIdxs,
+		MessageInfos:      file_request_user_status_request_proto_msgTypes,
+	}.Build()
+	File_request_user_status_request_proto = out.File
+	file_request_user_status_request_proto_rawDesc = nil
+	file_request_user_status_request_proto_goTypes = nil
+	file_request_user_status_request_proto_depIdxs = nil
+}
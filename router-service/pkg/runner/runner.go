@@ -0,0 +1,13 @@
+package runner
+
+import (
+	"github.com/lucasd-coder/fast-feet/router-service/config"
+	"github.com/lucasd-coder/fast-feet/router-service/internal/app"
+)
+
+// Run starts router-service with an already loaded config, it lets other
+// modules embed the service without reaching into its internal packages.
+func Run(cfg *config.Config) {
+	config.ExportConfig(cfg)
+	app.Run(cfg)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/lucasd-coder/fast-feet/pkg/mongodb"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/config"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/domain/user/repository"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/shared/ciphers"
+)
+
+const usage = `fastfeet-migrate encrypts the email and cpf of the users with the current key.
+
+Documents in plain text or encrypted with a previous key are rewritten, then
+the unique blind indexes are created. Run it again after a key rotation before
+removing the old key from the previous keys.
+
+Usage:
+  fastfeet-migrate [flags]
+
+Flags:
+`
+
+func main() {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		fs.PrintDefaults()
+	}
+
+	batchSize := fs.Int("batch", 500, "number of documents read per round trip")
+	dryRun := fs.Bool("dry-run", false, "count the documents to migrate without writing them")
+
+	if err := fs.Parse(os.Args[1:]); err != nil {
+		log.Fatalf("flag error: %v", err)
+	}
+
+	cfg := loadConfig()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	optMongo := shared.NewOptMongoDB(cfg)
+	mongodb.SetUpMongoDB(ctx, &optMongo)
+
+	defer func() {
+		if err := mongodb.CloseConnMongoDB(context.WithoutCancel(ctx)); err != nil {
+			log.Printf("unable to disconnect: %v", err)
+		}
+	}()
+
+	cipher, err := ciphers.New(cfg)
+	if err != nil {
+		log.Panicf("encryption config error: %v", err)
+	}
+
+	repo := repository.NewUserRepository(cfg, mongodb.GetClientMongoDB(), cipher)
+
+	result, err := repo.Migrate(ctx, int32(*batchSize), *dryRun)
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if result != nil {
+		if encErr := enc.Encode(result); encErr != nil {
+			log.Printf("encode error: %v", encErr)
+		}
+	}
+
+	if err != nil {
+		log.Panicf("migrate error: %v", err)
+	}
+
+	if *dryRun {
+		return
+	}
+
+	if err := repo.EnsureIndexes(ctx); err != nil {
+		log.Panicf("index error: %v", err)
+	}
+}
+
+func loadConfig() *config.Config {
+	var cfg config.Config
+
+	path := "./config/config.yml"
+	if os.Getenv("GO_PROFILE") == "dev" {
+		path = "./config/config-dev.yml"
+	}
+
+	if err := cleanenv.ReadConfig(path, &cfg); err != nil {
+		log.Fatalf("Config error: %v", err)
+	}
+
+	return &cfg
+}
@@ -12,6 +12,8 @@ type (
 		Log         `yaml:"logger"`
 		MongoDB     `yaml:"mongodb"`
 		Integration `yaml:"integration"`
+		BlobStore   `yaml:"blobStore"`
+		Encryption  `yaml:"encryption"`
 	}
 
 	App struct {
@@ -39,12 +41,32 @@ type (
 		MongoCollections   MongoCollections `env-required:"true" yaml:"collections"`
 	}
 	MongoCollections struct {
-		User `env-required:"true" yaml:"user"`
+		User            `env-required:"true" yaml:"user"`
+		AttributeSchema `yaml:"attributeSchema"`
 	}
 
 	User struct {
 		Collection string `env-required:"true" yaml:"collection"`
 	}
+
+	AttributeSchema struct {
+		Collection string `yaml:"collection" env-default:"attributeSchemas"`
+	}
+	BlobStore struct {
+		Driver  string `yaml:"driver" env:"BLOB_STORE_DRIVER" env-default:"filesystem"`
+		Path    string `yaml:"path" env:"BLOB_STORE_PATH" env-default:"/var/lib/user-manger-service/blobs"`
+		BaseURL string `yaml:"baseUrl" env:"BLOB_STORE_BASE_URL" env-default:"http://localhost:8090/blobs"`
+	}
+
+	// Encryption keys of the email and cpf fields, a rotated key is kept in
+	// PreviousKeys until the migrate command re-encrypted every document.
+	Encryption struct {
+		KeyID        string            `yaml:"keyId" env:"ENCRYPTION_KEY_ID" env-default:"v1"`
+		Key          string            `env-required:"true" yaml:"key" env:"ENCRYPTION_KEY"`
+		PreviousKeys map[string]string `yaml:"previousKeys" env:"ENCRYPTION_PREVIOUS_KEYS"`
+		IndexKey     string            `env-required:"true" yaml:"indexKey" env:"ENCRYPTION_INDEX_KEY"`
+	}
+
 	Integration struct {
 		OpenTelemetry `env-required:"true" yaml:"otlp"`
 	}
@@ -56,6 +78,16 @@ type (
 	}
 )
 
+// Keys returns every key able to decrypt a field, indexed by key ID.
+func (e *Encryption) Keys() map[string]string {
+	keys := make(map[string]string, len(e.PreviousKeys)+1)
+	for id, key := range e.PreviousKeys {
+		keys[id] = key
+	}
+	keys[e.KeyID] = e.Key
+	return keys
+}
+
 func ExportConfig(config *Config) {
 	cfg = config
 }
@@ -16,6 +16,7 @@ import (
 	"syscall"
 
 	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	grpcrecovery "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
@@ -28,6 +29,7 @@ import (
 	"github.com/lucasd-coder/fast-feet/pkg/monitor"
 	"github.com/lucasd-coder/fast-feet/pkg/profiler"
 	"github.com/lucasd-coder/fast-feet/user-manger-service/config"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/domain/user/repository"
 	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/domain/user/service"
 	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/shared"
 	"github.com/lucasd-coder/fast-feet/user-manger-service/pkg/pb"
@@ -72,9 +74,21 @@ func Run(cfg *config.Config) {
 			logDefault.Error("Error shutting down tracer server provider: ", err)
 		}
 	}()
+	blobStore, err := InitializeBlobStore()
+	if err != nil {
+		logDefault.Error("Error creating blob store", "err", err)
+		return
+	}
+
+	userRepository, err := InitializeUserRepository()
+	if err != nil {
+		logDefault.Error("Error creating user repository", "err", err)
+		return
+	}
+
 	reg := prometheus.NewRegistry()
 	grpcServer := newGrpcServer(ctx, logger, reg)
-	registerServices(grpcServer)
+	registerServices(grpcServer, userRepository, blobStore)
 
 	slog.Info(fmt.Sprintf("Started listening... address[:%s]", cfg.GRPC.Port))
 
@@ -84,7 +98,7 @@ func Run(cfg *config.Config) {
 		}
 	}()
 
-	go newHTTPServer(ctx, cfg, reg)
+	go newHTTPServer(ctx, cfg, reg, blobStore)
 
 	stopChan := make(chan os.Signal, 1)
 
@@ -94,6 +108,9 @@ func Run(cfg *config.Config) {
 	grpcServer.GracefulStop()
 }
 
+// payloadless keeps the email and cpf of the requests out of the logs.
+var payloadless = logging.WithLogOnEvents(logging.StartCall, logging.FinishCall)
+
 func newGrpcServer(ctx context.Context, logger *logger.Log, reg *prometheus.Registry) *grpc.Server {
 	exemplarFromContext := func(ctx context.Context) prometheus.Labels {
 		if span := trace.SpanContextFromContext(ctx); span.IsSampled() {
@@ -115,12 +132,12 @@ func newGrpcServer(ctx context.Context, logger *logger.Log, reg *prometheus.Regi
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			srvMetrics.UnaryServerInterceptor(grpcprom.WithExemplarFromContext(exemplarFromContext)),
-			logger.GetLogUnaryServerInterceptor(),
+			logger.GetLogUnaryServerInterceptor(payloadless),
 			grpcrecovery.UnaryServerInterceptor(grpcrecovery.WithRecoveryHandler(grpcPanicRecoveryHandler)),
 		),
 		grpc.ChainStreamInterceptor(
 			srvMetrics.StreamServerInterceptor(grpcprom.WithExemplarFromContext(exemplarFromContext)),
-			logger.GetLogStreamServerInterceptor(),
+			logger.GetLogStreamServerInterceptor(payloadless),
 			grpcrecovery.StreamServerInterceptor(grpcrecovery.WithRecoveryHandler(grpcPanicRecoveryHandler)),
 		),
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
@@ -129,7 +146,7 @@ func newGrpcServer(ctx context.Context, logger *logger.Log, reg *prometheus.Regi
 	return grpcServer
 }
 
-func newHTTPServer(ctx context.Context, cfg *config.Config, reg prometheus.Gatherer) {
+func newHTTPServer(ctx context.Context, cfg *config.Config, reg prometheus.Gatherer, blobStore shared.BlobStore) {
 	log := logger.FromContext(ctx)
 
 	m := http.NewServeMux()
@@ -145,6 +162,11 @@ func newHTTPServer(ctx context.Context, cfg *config.Config, reg prometheus.Gathe
 		_, _ = w.Write([]byte("ok"))
 	})
 
+	// a blob store that serves its own blobs, such as the filesystem one.
+	if h, ok := blobStore.(http.Handler); ok {
+		m.Handle("/blobs/", http.StripPrefix("/blobs", h))
+	}
+
 	profiler.StartProfiling(m)
 
 	httpSrv := &http.Server{
@@ -164,8 +186,9 @@ func newHTTPServer(ctx context.Context, cfg *config.Config, reg prometheus.Gathe
 	}
 }
 
-func registerServices(grpcServer *grpc.Server) {
-	userService := service.NewUserService(InitializeUserRepository(), InitializeValidator())
+func registerServices(grpcServer *grpc.Server, userRepository *repository.UserRepository, blobStore shared.BlobStore) {
+	userService := service.NewUserService(userRepository, InitializeAttributeSchemaRepository(),
+		InitializeValidator(), blobStore)
 	pb.RegisterUserServiceServer(grpcServer, userService)
 	grpc_health_v1.RegisterHealthServer(grpcServer, health.NewServer())
 	reflection.Register(grpcServer)
@@ -8,15 +8,28 @@ import (
 	"github.com/lucasd-coder/fast-feet/pkg/mongodb"
 	"github.com/lucasd-coder/fast-feet/user-manger-service/config"
 	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/domain/user/repository"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/provider/blobstore"
 	val "github.com/lucasd-coder/fast-feet/user-manger-service/internal/provider/validator"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/shared/ciphers"
 )
 
-func InitializeUserRepository() *repository.UserRepository {
-	wire.Build(config.GetConfig, mongodb.GetClientMongoDB, repository.NewUserRepository)
-	return &repository.UserRepository{}
+func InitializeUserRepository() (*repository.UserRepository, error) {
+	wire.Build(config.GetConfig, mongodb.GetClientMongoDB, ciphers.New, repository.NewUserRepository)
+	return &repository.UserRepository{}, nil
+}
+
+func InitializeAttributeSchemaRepository() *repository.AttributeSchemaRepository {
+	wire.Build(config.GetConfig, mongodb.GetClientMongoDB, repository.NewAttributeSchemaRepository)
+	return &repository.AttributeSchemaRepository{}
 }
 
 func InitializeValidator() *val.Validation {
 	wire.Build(val.NewValidation)
 	return nil
 }
+
+func InitializeBlobStore() (shared.BlobStore, error) {
+	wire.Build(config.GetConfig, blobstore.New)
+	return nil, nil
+}
@@ -10,8 +10,10 @@ import (
 	"github.com/lucasd-coder/fast-feet/pkg/mongodb"
 	"github.com/lucasd-coder/fast-feet/user-manger-service/config"
 	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/domain/user/repository"
-	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/domain/user/service"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/provider/blobstore"
 	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/provider/validator"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/shared"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/shared/ciphers"
 )
 
 import (
@@ -20,11 +22,22 @@ import (
 
 // Injectors from wire.go:
 
-func InitializeUserRepository() *repository.UserRepository {
+func InitializeUserRepository() (*repository.UserRepository, error) {
 	configConfig := config.GetConfig()
 	client := mongodb.GetClientMongoDB()
-	userRepository := repository.NewUserRepository(configConfig, client)
-	return userRepository
+	fieldCipher, err := ciphers.New(configConfig)
+	if err != nil {
+		return nil, err
+	}
+	userRepository := repository.NewUserRepository(configConfig, client, fieldCipher)
+	return userRepository, nil
+}
+
+func InitializeAttributeSchemaRepository() *repository.AttributeSchemaRepository {
+	configConfig := config.GetConfig()
+	client := mongodb.GetClientMongoDB()
+	attributeSchemaRepository := repository.NewAttributeSchemaRepository(configConfig, client)
+	return attributeSchemaRepository
 }
 
 func InitializeValidator() *validator.Validation {
@@ -32,9 +45,11 @@ func InitializeValidator() *validator.Validation {
 	return validation
 }
 
-func InitializeUserService() *service.UserService {
-	userRepository := InitializeUserRepository()
-	validation := InitializeValidator()
-	userService := service.NewUserService(userRepository, validation)
-	return userService
+func InitializeBlobStore() (shared.BlobStore, error) {
+	configConfig := config.GetConfig()
+	blobStore, err := blobstore.New(configConfig)
+	if err != nil {
+		return nil, err
+	}
+	return blobStore, nil
 }
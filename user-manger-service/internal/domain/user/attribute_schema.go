@@ -0,0 +1,150 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/shared"
+)
+
+const (
+	AttributeString  = "STRING"
+	AttributeNumber  = "NUMBER"
+	AttributeBoolean = "BOOLEAN"
+	AttributeDate    = "DATE"
+)
+
+const attributeDateLayout = "2006-01-02"
+
+// AttributeSchema restricts the user attributes to its fields, without
+// fields any attribute is accepted.
+type AttributeSchema struct {
+	Fields    []AttributeField `bson:"fields" validate:"dive"`
+	UpdatedAt time.Time        `bson:"updatedAt,omitempty"`
+}
+
+type AttributeField struct {
+	Key       string `bson:"key" validate:"required,max=64,excludesall=.$,pattern"`
+	Type      string `bson:"type" validate:"required,oneof=STRING NUMBER BOOLEAN DATE"`
+	Pattern   string `bson:"pattern,omitempty" validate:"max=256"`
+	Required  bool   `bson:"required"`
+	MaxLength int32  `bson:"maxLength,omitempty" validate:"gte=0,lte=1024"`
+}
+
+// AttributeViolation is an attribute that does not match the schema.
+type AttributeViolation struct {
+	Key         string
+	Description string
+}
+
+func (schema *AttributeSchema) Validate(val shared.Validator) error {
+	return val.ValidateStruct(schema)
+}
+
+// Violations reports the keys declared twice and the patterns that do not
+// compile, which the struct validation can not see.
+func (schema *AttributeSchema) Violations() []AttributeViolation {
+	var violations []AttributeViolation
+
+	seen := make(map[string]bool, len(schema.Fields))
+	for i, field := range schema.Fields {
+		if seen[field.Key] {
+			violations = append(violations, AttributeViolation{
+				Key:         fmt.Sprintf("fields[%d].key", i),
+				Description: fmt.Sprintf("duplicate key %q", field.Key),
+			})
+		}
+		seen[field.Key] = true
+
+		if _, err := regexp.Compile(field.Pattern); err != nil {
+			violations = append(violations, AttributeViolation{
+				Key:         fmt.Sprintf("fields[%d].pattern", i),
+				Description: err.Error(),
+			})
+		}
+	}
+
+	return violations
+}
+
+// Check returns the violations of attributes ordered by key, an empty value
+// is the same as a missing one.
+func (schema *AttributeSchema) Check(attributes map[string]string) []AttributeViolation {
+	if schema == nil || len(schema.Fields) == 0 {
+		return nil
+	}
+
+	var violations []AttributeViolation
+
+	fields := make(map[string]AttributeField, len(schema.Fields))
+	for _, field := range schema.Fields {
+		fields[field.Key] = field
+	}
+
+	for key := range attributes {
+		if _, ok := fields[key]; !ok {
+			violations = append(violations, AttributeViolation{Key: key, Description: "attribute is not allowed"})
+		}
+	}
+
+	for _, field := range schema.Fields {
+		value := attributes[field.Key]
+		if value == "" {
+			if field.Required {
+				violations = append(violations, AttributeViolation{Key: field.Key, Description: "attribute is required"})
+			}
+			continue
+		}
+
+		if description := field.check(value); description != "" {
+			violations = append(violations, AttributeViolation{Key: field.Key, Description: description})
+		}
+	}
+
+	sort.Slice(violations, func(i, j int) bool {
+		return violations[i].Key < violations[j].Key
+	})
+
+	return violations
+}
+
+func (field *AttributeField) check(value string) string {
+	if field.MaxLength > 0 && utf8.RuneCountInString(value) > int(field.MaxLength) {
+		return fmt.Sprintf("must have at most %d characters", field.MaxLength)
+	}
+
+	switch field.Type {
+	case AttributeNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return "must be a number"
+		}
+	case AttributeBoolean:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return "must be true or false"
+		}
+	case AttributeDate:
+		if _, err := time.Parse(attributeDateLayout, value); err != nil {
+			return "must be a date formatted as yyyy-mm-dd"
+		}
+	}
+
+	if field.Pattern != "" {
+		matched, err := regexp.MatchString(field.Pattern, value)
+		if err != nil || !matched {
+			return fmt.Sprintf("must match %s", field.Pattern)
+		}
+	}
+
+	return ""
+}
+
+func (schema *AttributeSchema) GetUpdatedAt() string {
+	if schema.UpdatedAt.IsZero() {
+		return ""
+	}
+	return schema.UpdatedAt.Format(time.RFC3339)
+}
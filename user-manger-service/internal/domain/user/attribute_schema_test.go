@@ -0,0 +1,85 @@
+package model_test
+
+import (
+	"testing"
+
+	model "github.com/lucasd-coder/fast-feet/user-manger-service/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+var schema = &model.AttributeSchema{
+	Fields: []model.AttributeField{
+		{Key: "vehicle", Type: model.AttributeString, Pattern: "^(bike|car)$", Required: true},
+		{Key: "seats", Type: model.AttributeNumber},
+		{Key: "since", Type: model.AttributeDate},
+		{Key: "nickname", Type: model.AttributeString, MaxLength: 5},
+	},
+}
+
+func TestAttributeSchema_Check(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes map[string]string
+		want       []model.AttributeViolation
+	}{
+		{
+			name:       "should accept valid attributes",
+			attributes: map[string]string{"vehicle": "bike", "seats": "2", "since": "2024-01-31", "nickname": "zé"},
+		},
+		{
+			name:       "should require vehicle",
+			attributes: map[string]string{"seats": "2"},
+			want:       []model.AttributeViolation{{Key: "vehicle", Description: "attribute is required"}},
+		},
+		{
+			name: "should report every invalid attribute ordered by key",
+			attributes: map[string]string{
+				"vehicle":  "truck",
+				"seats":    "two",
+				"since":    "31/01/2024",
+				"nickname": "too long",
+				"color":    "red",
+			},
+			want: []model.AttributeViolation{
+				{Key: "color", Description: "attribute is not allowed"},
+				{Key: "nickname", Description: "must have at most 5 characters"},
+				{Key: "seats", Description: "must be a number"},
+				{Key: "since", Description: "must be a date formatted as yyyy-mm-dd"},
+				{Key: "vehicle", Description: "must match ^(bike|car)$"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, schema.Check(tt.attributes))
+		})
+	}
+}
+
+func TestAttributeSchema_CheckWithoutFields(t *testing.T) {
+	assert.Empty(t, (&model.AttributeSchema{}).Check(map[string]string{"any": "value"}))
+}
+
+func TestAttributeSchema_Violations(t *testing.T) {
+	invalid := &model.AttributeSchema{
+		Fields: []model.AttributeField{
+			{Key: "vehicle", Type: model.AttributeString},
+			{Key: "vehicle", Type: model.AttributeString, Pattern: "(bike"},
+		},
+	}
+
+	violations := invalid.Violations()
+	assert.Len(t, violations, 2)
+	assert.Equal(t, "fields[1].key", violations[0].Key)
+	assert.Equal(t, "fields[1].pattern", violations[1].Key)
+}
+
+func TestAttributeSchema_Validate(t *testing.T) {
+	invalid := &model.AttributeSchema{
+		Fields: []model.AttributeField{{Key: "vehicle.type", Type: "TEXT", MaxLength: -1}},
+	}
+
+	assert.Error(t, invalid.Validate(val))
+	assert.NoError(t, schema.Validate(val))
+}
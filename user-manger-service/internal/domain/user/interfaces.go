@@ -10,5 +10,16 @@ type (
 		FindByEmail(ctx context.Context, email string) (*User, error)
 		FindByUserID(ctx context.Context, userID string) (*User, error)
 		FindByCpf(ctx context.Context, cpf string) (*User, error)
+		Update(ctx context.Context, user *User) error
+		Delete(ctx context.Context, userID string) error
+		FindAll(ctx context.Context, pld *ListUsers) ([]User, error)
+		SaveDeliveryman(ctx context.Context, userID string, deliveryman *Deliveryman) error
+		SetAvailability(ctx context.Context, userID string, available bool) error
+		SetAvatar(ctx context.Context, userID, key string) error
+	}
+
+	AttributeSchemaRepository interface {
+		FindAttributeSchema(ctx context.Context) (*AttributeSchema, error)
+		SaveAttributeSchema(ctx context.Context, schema *AttributeSchema) error
 	}
 )
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lucasd-coder/fast-feet/user-manger-service/config"
+	model "github.com/lucasd-coder/fast-feet/user-manger-service/internal/domain/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// userSchemaID is the _id of the schema of the user attributes, the only
+// one kept in the collection.
+const userSchemaID = "user"
+
+type AttributeSchemaRepository struct {
+	Config     *config.Config
+	Connection *mongo.Client
+}
+
+func NewAttributeSchemaRepository(cfg *config.Config, con *mongo.Client) *AttributeSchemaRepository {
+	return &AttributeSchemaRepository{
+		Config:     cfg,
+		Connection: con,
+	}
+}
+
+// FindAttributeSchema returns mongo.ErrNoDocuments while no schema was saved.
+func (repo *AttributeSchemaRepository) FindAttributeSchema(ctx context.Context) (*model.AttributeSchema, error) {
+	database := repo.Connection.Database(repo.Config.MongoDatabase)
+
+	collection := repo.Config.MongoCollections.AttributeSchema.Collection
+
+	filter := bson.M{
+		"_id": userSchemaID,
+	}
+
+	schema := new(model.AttributeSchema)
+	if err := database.Collection(collection).FindOne(ctx, filter).Decode(schema); err != nil {
+		return nil, fmt.Errorf("fail decode mongo result err: %w", err)
+	}
+
+	return schema, nil
+}
+
+func (repo *AttributeSchemaRepository) SaveAttributeSchema(ctx context.Context, schema *model.AttributeSchema) error {
+	database := repo.Connection.Database(repo.Config.MongoDatabase)
+
+	collection := repo.Config.MongoCollections.AttributeSchema.Collection
+
+	filter := bson.M{
+		"_id": userSchemaID,
+	}
+
+	opt := options.Replace().SetUpsert(true)
+
+	_, err := database.Collection(collection).ReplaceOne(ctx, filter, schema, opt)
+
+	return err
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	model "github.com/lucasd-coder/fast-feet/user-manger-service/internal/domain/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// legacyIndexes are the unique indexes of the plain text fields, the blind
+// index ones replace them.
+var legacyIndexes = []string{"email_1", "cpf_1"}
+
+type MigrateResult struct {
+	Scanned  int `json:"scanned"`
+	Migrated int `json:"migrated"`
+	// Skipped documents changed while being migrated, a new run picks them
+	// up.
+	Skipped int  `json:"skipped"`
+	DryRun  bool `json:"dryRun"`
+}
+
+// Migrate encrypts with the current key every email and cpf still in plain
+// text or encrypted with a previous key and fills their blind index.
+func (repo *UserRepository) Migrate(ctx context.Context, batchSize int32, dryRun bool) (*MigrateResult, error) {
+	database := repo.Connection.Database(repo.Config.MongoDatabase)
+
+	collection := database.Collection(repo.Config.MongoCollections.User.Collection)
+
+	current := primitive.Regex{Pattern: "^" + regexp.QuoteMeta(repo.Cipher.CurrentPrefix())}
+	filter := bson.M{
+		"$or": bson.A{
+			bson.M{"email": bson.M{"$exists": true, "$not": current}},
+			bson.M{"cpf": bson.M{"$exists": true, "$not": current}},
+			bson.M{"email": bson.M{"$exists": true}, "emailHash": bson.M{"$exists": false}},
+			bson.M{"cpf": bson.M{"$exists": true}, "cpfHash": bson.M{"$exists": false}},
+		},
+	}
+
+	opt := options.Find()
+	opt.SetSort(bson.D{{Key: "_id", Value: 1}})
+	opt.SetBatchSize(batchSize)
+
+	cursor, err := collection.Find(ctx, filter, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cursor.Close(ctx)
+
+	result := &MigrateResult{DryRun: dryRun}
+
+	for cursor.Next(ctx) {
+		stored := new(model.User)
+		if err := cursor.Decode(stored); err != nil {
+			return result, fmt.Errorf("fail mongo cursor decode: %w", err)
+		}
+
+		result.Scanned++
+
+		user := *stored
+		if err := repo.decrypt(&user); err != nil {
+			return result, fmt.Errorf("fail decrypt user %s: %w", stored.ID.Hex(), err)
+		}
+
+		encrypted, err := repo.encrypt(&user)
+		if err != nil {
+			return result, err
+		}
+
+		if dryRun {
+			result.Migrated++
+			continue
+		}
+
+		// the values read are part of the filter so a concurrent update is
+		// not overwritten with stale data.
+		updated, err := collection.UpdateOne(ctx, bson.M{
+			"_id":   stored.ID,
+			"email": stored.Email,
+			"cpf":   stored.CPF,
+		}, bson.M{
+			"$set": bson.M{
+				"email":     encrypted.Email,
+				"cpf":       encrypted.CPF,
+				"emailHash": encrypted.EmailHash,
+				"cpfHash":   encrypted.CPFHash,
+			},
+		})
+		if err != nil {
+			return result, err
+		}
+
+		if updated.MatchedCount == 0 {
+			result.Skipped++
+			continue
+		}
+
+		result.Migrated++
+	}
+
+	return result, cursor.Err()
+}
+
+// EnsureIndexes creates the unique blind indexes and drops the ones of the
+// plain text fields, the values are encrypted with a random nonce so those
+// no longer enforce anything.
+func (repo *UserRepository) EnsureIndexes(ctx context.Context) error {
+	database := repo.Connection.Database(repo.Config.MongoDatabase)
+
+	collection := database.Collection(repo.Config.MongoCollections.User.Collection)
+
+	models := make([]mongo.IndexModel, 0, 2)
+	for _, field := range []string{"emailHash", "cpfHash"} {
+		models = append(models, mongo.IndexModel{
+			Keys: bson.D{{Key: field, Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{field: bson.M{"$type": "string"}}),
+		})
+	}
+
+	if _, err := collection.Indexes().CreateMany(ctx, models); err != nil {
+		return fmt.Errorf("fail create blind indexes: %w", err)
+	}
+
+	for _, name := range legacyIndexes {
+		if _, err := collection.Indexes().DropOne(ctx, name); err != nil && !isIndexNotFound(err) {
+			return fmt.Errorf("fail drop index %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func isIndexNotFound(err error) bool {
+	var cmdErr mongo.CommandError
+	return errors.As(err, &cmdErr) && (cmdErr.Code == 27 || cmdErr.Name == "IndexNotFound")
+}
@@ -3,23 +3,37 @@ package repository
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"time"
 
 	"github.com/lucasd-coder/fast-feet/user-manger-service/config"
 	model "github.com/lucasd-coder/fast-feet/user-manger-service/internal/domain/user"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/shared/ciphers"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+const (
+	fieldEmail = "email"
+	fieldCPF   = "cpf"
+)
+
+// UserRepository keeps email and cpf encrypted, they are looked up by their
+// blind index. Documents written before the encryption are still read and
+// found by the plain text value until the migrate command rewrites them.
 type UserRepository struct {
 	Config     *config.Config
 	Connection *mongo.Client
+	Cipher     *ciphers.FieldCipher
 }
 
-func NewUserRepository(cfg *config.Config, con *mongo.Client) *UserRepository {
+func NewUserRepository(cfg *config.Config, con *mongo.Client, cipher *ciphers.FieldCipher) *UserRepository {
 	return &UserRepository{
 		Config:     cfg,
 		Connection: con,
+		Cipher:     cipher,
 	}
 }
 
@@ -28,7 +42,12 @@ func (repo *UserRepository) Save(ctx context.Context, user *model.User) (*model.
 
 	collection := repo.Config.MongoCollections.User.Collection
 
-	result, err := database.Collection(collection).InsertOne(ctx, user)
+	encrypted, err := repo.encrypt(user)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := database.Collection(collection).InsertOne(ctx, encrypted)
 	if err != nil {
 		return nil, err
 	}
@@ -44,12 +63,15 @@ func (repo *UserRepository) FindByEmail(ctx context.Context, email string) (*mod
 	collection := repo.Config.MongoCollections.User.Collection
 
 	filter := bson.M{
-		"email": email,
+		"$or": bson.A{
+			bson.M{"emailHash": repo.Cipher.BlindIndex(fieldEmail, email)},
+			bson.M{"email": email},
+		},
 	}
 
 	result := database.Collection(collection).FindOne(ctx, filter)
 
-	return decode(result)
+	return repo.decode(result)
 }
 
 func (repo *UserRepository) FindByUserID(ctx context.Context, userID string) (*model.User, error) {
@@ -63,7 +85,7 @@ func (repo *UserRepository) FindByUserID(ctx context.Context, userID string) (*m
 
 	result := database.Collection(collection).FindOne(ctx, filter)
 
-	return decode(result)
+	return repo.decode(result)
 }
 
 func (repo *UserRepository) FindByCpf(ctx context.Context, cpf string) (*model.User, error) {
@@ -72,19 +94,273 @@ func (repo *UserRepository) FindByCpf(ctx context.Context, cpf string) (*model.U
 	collection := repo.Config.MongoCollections.User.Collection
 
 	filter := bson.M{
-		"cpf": cpf,
+		"$or": bson.A{
+			bson.M{"cpfHash": repo.Cipher.BlindIndex(fieldCPF, cpf)},
+			bson.M{"cpf": cpf},
+		},
 	}
 
 	result := database.Collection(collection).FindOne(ctx, filter)
 
-	return decode(result)
+	return repo.decode(result)
 }
 
-func decode(result *mongo.SingleResult) (*model.User, error) {
+func (repo *UserRepository) Update(ctx context.Context, user *model.User) error {
+	database := repo.Connection.Database(repo.Config.MongoDatabase)
+
+	collection := repo.Config.MongoCollections.User.Collection
+
+	filter := bson.M{
+		"userId": user.UserID,
+	}
+
+	encrypted, err := repo.encrypt(user)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"name":       encrypted.Name,
+			"email":      encrypted.Email,
+			"cpf":        encrypted.CPF,
+			"emailHash":  encrypted.EmailHash,
+			"cpfHash":    encrypted.CPFHash,
+			"attributes": encrypted.Attributes,
+			"updatedAt":  encrypted.UpdatedAt,
+		},
+	}
+
+	result, err := database.Collection(collection).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+func (repo *UserRepository) Delete(ctx context.Context, userID string) error {
+	database := repo.Connection.Database(repo.Config.MongoDatabase)
+
+	collection := repo.Config.MongoCollections.User.Collection
+
+	filter := bson.M{
+		"userId": userID,
+	}
+
+	result, err := database.Collection(collection).DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+// SaveDeliveryman sets the vehicle and licence of the deliveryman profile, a
+// new profile starts unavailable.
+func (repo *UserRepository) SaveDeliveryman(ctx context.Context, userID string, deliveryman *model.Deliveryman) error {
+	database := repo.Connection.Database(repo.Config.MongoDatabase)
+
+	collection := repo.Config.MongoCollections.User.Collection
+
+	filter := bson.M{
+		"userId": userID,
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"deliveryman.vehicleType":   deliveryman.VehicleType,
+			"deliveryman.vehiclePlate":  deliveryman.VehiclePlate,
+			"deliveryman.licenseNumber": deliveryman.LicenseNumber,
+			"deliveryman.licenseExpiry": deliveryman.LicenseExpiry,
+			"deliveryman.updatedAt":     deliveryman.UpdatedAt,
+		},
+	}
+
+	result, err := database.Collection(collection).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+func (repo *UserRepository) SetAvailability(ctx context.Context, userID string, available bool) error {
+	return repo.updateDeliveryman(ctx, userID, bson.M{
+		"deliveryman.available": available,
+	})
+}
+
+func (repo *UserRepository) SetAvatar(ctx context.Context, userID, key string) error {
+	return repo.updateDeliveryman(ctx, userID, bson.M{
+		"deliveryman.avatarKey": key,
+	})
+}
+
+// updateDeliveryman returns mongo.ErrNoDocuments when the user has no
+// deliveryman profile.
+func (repo *UserRepository) updateDeliveryman(ctx context.Context, userID string, fields bson.M) error {
+	database := repo.Connection.Database(repo.Config.MongoDatabase)
+
+	collection := repo.Config.MongoCollections.User.Collection
+
+	filter := bson.M{
+		"userId":      userID,
+		"deliveryman": bson.M{"$exists": true},
+	}
+
+	fields["deliveryman.updatedAt"] = time.Now()
+
+	result, err := database.Collection(collection).UpdateOne(ctx, filter, bson.M{"$set": fields})
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+// FindAll returns up to pld.GetLimit()+1 users after the cursor ordered by
+// _id, the extra one tells the caller there is a next page.
+func (repo *UserRepository) FindAll(ctx context.Context, pld *model.ListUsers) ([]model.User, error) {
+	database := repo.Connection.Database(repo.Config.MongoDatabase)
+
+	collection := repo.Config.MongoCollections.User.Collection
+
+	filter, err := extractFilterListUsers(pld)
+	if err != nil {
+		return nil, fmt.Errorf("fail when extractFilter err: %w", err)
+	}
+
+	opt := options.Find()
+	opt.SetSort(bson.D{{Key: "_id", Value: 1}})
+	opt.SetLimit(pld.GetLimit() + 1)
+
+	result, err := database.Collection(collection).Find(ctx, filter, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	defer result.Close(ctx)
+
+	var users []model.User
+	if err := result.All(ctx, &users); err != nil {
+		return nil, fmt.Errorf("fail mongo cursor decode: %w", err)
+	}
+
+	for i := range users {
+		if err := repo.decrypt(&users[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return users, nil
+}
+
+func extractFilterListUsers(pld *model.ListUsers) (bson.M, error) {
+	filter := bson.M{}
+
+	if pld.Cursor != "" {
+		cursor, err := primitive.ObjectIDFromHex(pld.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor: %w", err)
+		}
+		filter["_id"] = bson.M{"$gt": cursor}
+	}
+
+	if pld.NamePrefix != "" {
+		filter["name"] = primitive.Regex{Pattern: "^" + regexp.QuoteMeta(pld.NamePrefix), Options: "i"}
+	}
+
+	createdAt := bson.M{}
+	for op, value := range map[string]string{"$gte": pld.CreatedFrom, "$lte": pld.CreatedTo} {
+		if value == "" {
+			continue
+		}
+		date, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, err
+		}
+		createdAt[op] = date
+	}
+	if len(createdAt) > 0 {
+		filter["createdAt"] = createdAt
+	}
+
+	for key, value := range pld.Attributes {
+		if value == "" {
+			filter["attributes."+key] = bson.M{"$exists": true}
+			continue
+		}
+		filter["attributes."+key] = value
+	}
+
+	return filter, nil
+}
+
+func (repo *UserRepository) decode(result *mongo.SingleResult) (*model.User, error) {
 	user := new(model.User)
 	if err := result.Decode(user); err != nil {
 		return nil, fmt.Errorf("fail decode mongo result err: %w", err)
 	}
 
+	if err := repo.decrypt(user); err != nil {
+		return nil, err
+	}
+
 	return user, nil
 }
+
+// encrypt returns a copy of user ready to be written, user is left as is.
+func (repo *UserRepository) encrypt(user *model.User) (*model.User, error) {
+	encrypted := *user
+
+	email, err := repo.Cipher.Encrypt(fieldEmail, user.Email)
+	if err != nil {
+		return nil, fmt.Errorf("fail encrypt email err: %w", err)
+	}
+
+	cpf, err := repo.Cipher.Encrypt(fieldCPF, user.CPF)
+	if err != nil {
+		return nil, fmt.Errorf("fail encrypt cpf err: %w", err)
+	}
+
+	encrypted.Email = email
+	encrypted.CPF = cpf
+	encrypted.EmailHash = repo.Cipher.BlindIndex(fieldEmail, user.Email)
+	encrypted.CPFHash = repo.Cipher.BlindIndex(fieldCPF, user.CPF)
+
+	return &encrypted, nil
+}
+
+func (repo *UserRepository) decrypt(user *model.User) error {
+	email, err := repo.Cipher.Decrypt(fieldEmail, user.Email)
+	if err != nil {
+		return err
+	}
+
+	cpf, err := repo.Cipher.Decrypt(fieldCPF, user.CPF)
+	if err != nil {
+		return err
+	}
+
+	user.Email = email
+	user.CPF = cpf
+
+	return nil
+}
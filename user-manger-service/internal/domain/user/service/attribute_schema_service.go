@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	model "github.com/lucasd-coder/fast-feet/user-manger-service/internal/domain/user"
+	pkgErrors "github.com/lucasd-coder/fast-feet/user-manger-service/internal/errors"
+	pb "github.com/lucasd-coder/fast-feet/user-manger-service/pkg/pb"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// SaveAttributeSchema replaces the schema of the user attributes, the users
+// already saved are checked again on their next attributes update.
+func (service *UserService) SaveAttributeSchema(ctx context.Context,
+	req *pb.AttributeSchemaRequest) (*pb.AttributeSchemaResponse, error) {
+	log := logger.FromContext(ctx)
+
+	log.Infof("received request saveAttributeSchema with %d fields", len(req.GetFields()))
+
+	schema := &model.AttributeSchema{
+		Fields:    make([]model.AttributeField, 0, len(req.GetFields())),
+		UpdatedAt: time.Now(),
+	}
+
+	for _, field := range req.GetFields() {
+		schema.Fields = append(schema.Fields, model.AttributeField{
+			Key:       field.GetKey(),
+			Type:      field.GetType(),
+			Pattern:   field.GetPattern(),
+			Required:  field.GetRequired(),
+			MaxLength: field.GetMaxLength(),
+		})
+	}
+
+	if err := schema.Validate(service.validate); err != nil {
+		return nil, pkgErrors.ValidationErrors(err)
+	}
+
+	if violations := schema.Violations(); len(violations) > 0 {
+		return nil, pkgErrors.InvalidArgumentError(fieldViolations("", violations))
+	}
+
+	if err := service.AttributeSchemaRepository.SaveAttributeSchema(ctx, schema); err != nil {
+		log.Errorf("failed to save attribute schema in database. Error: %+v", err)
+		return nil, err
+	}
+
+	return buildAttributeSchemaResponse(schema), nil
+}
+
+// GetAttributeSchema returns an empty schema while none was saved.
+func (service *UserService) GetAttributeSchema(ctx context.Context,
+	_ *pb.GetAttributeSchemaRequest) (*pb.AttributeSchemaResponse, error) {
+	schema, err := service.findAttributeSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildAttributeSchemaResponse(schema), nil
+}
+
+func (service *UserService) checkAttributes(ctx context.Context, attributes map[string]string) error {
+	schema, err := service.findAttributeSchema(ctx)
+	if err != nil {
+		return err
+	}
+
+	if violations := schema.Check(attributes); len(violations) > 0 {
+		return pkgErrors.InvalidArgumentError(fieldViolations("attributes.", violations))
+	}
+
+	return nil
+}
+
+func (service *UserService) findAttributeSchema(ctx context.Context) (*model.AttributeSchema, error) {
+	schema, err := service.AttributeSchemaRepository.FindAttributeSchema(ctx)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return &model.AttributeSchema{}, nil
+		}
+		logger.FromContext(ctx).Errorf("failed to find attribute schema in database. Error: %+v", err)
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+func fieldViolations(prefix string, violations []model.AttributeViolation) []*errdetails.BadRequest_FieldViolation {
+	details := make([]*errdetails.BadRequest_FieldViolation, 0, len(violations))
+	for _, violation := range violations {
+		details = append(details, &errdetails.BadRequest_FieldViolation{
+			Field:       prefix + violation.Key,
+			Description: violation.Description,
+		})
+	}
+
+	return details
+}
+
+func buildAttributeSchemaResponse(schema *model.AttributeSchema) *pb.AttributeSchemaResponse {
+	fields := make([]*pb.AttributeField, 0, len(schema.Fields))
+	for _, field := range schema.Fields {
+		fields = append(fields, &pb.AttributeField{
+			Key:       field.Key,
+			Type:      field.Type,
+			Pattern:   field.Pattern,
+			Required:  field.Required,
+			MaxLength: field.MaxLength,
+		})
+	}
+
+	return &pb.AttributeSchemaResponse{
+		Fields:    fields,
+		UpdatedAt: schema.GetUpdatedAt(),
+	}
+}
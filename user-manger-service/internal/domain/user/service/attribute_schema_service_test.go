@@ -0,0 +1,175 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	model "github.com/lucasd-coder/fast-feet/user-manger-service/internal/domain/user"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/domain/user/service"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/mocks"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/provider/validator"
+	pb "github.com/lucasd-coder/fast-feet/user-manger-service/pkg/pb"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+type AttributeSchemaServiceSuite struct {
+	suite.Suite
+	svc    service.UserService
+	ctx    context.Context
+	repo   *mocks.UserRepository_internal_domain_user
+	schema *mocks.AttributeSchemaRepository_internal_domain_user
+}
+
+func (suite *AttributeSchemaServiceSuite) SetupTest() {
+	suite.repo = new(mocks.UserRepository_internal_domain_user)
+	suite.schema = new(mocks.AttributeSchemaRepository_internal_domain_user)
+	suite.svc = *service.NewUserService(suite.repo, suite.schema, validator.NewValidation(),
+		new(mocks.BlobStore_internal_shared))
+	suite.ctx = context.Background()
+}
+
+func (suite *AttributeSchemaServiceSuite) vehicleSchema() *model.AttributeSchema {
+	return &model.AttributeSchema{
+		Fields: []model.AttributeField{
+			{Key: "vehicle", Type: model.AttributeString, Pattern: "^(bike|car)$", Required: true},
+		},
+	}
+}
+
+func (suite *AttributeSchemaServiceSuite) violations(err error) []*errdetails.BadRequest_FieldViolation {
+	st := status.Convert(err)
+	suite.Equal(codes.InvalidArgument, st.Code())
+
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			return badRequest.GetFieldViolations()
+		}
+	}
+
+	suite.Fail("status without bad request details")
+	return nil
+}
+
+func (suite *AttributeSchemaServiceSuite) TestSaveAttributeSchema() {
+	req := &pb.AttributeSchemaRequest{
+		Fields: []*pb.AttributeField{
+			{Key: "vehicle", Type: model.AttributeString, Pattern: "^(bike|car)$", Required: true},
+			{Key: "seats", Type: model.AttributeNumber, MaxLength: 2},
+		},
+	}
+
+	suite.schema.On("SaveAttributeSchema", suite.ctx, mock.MatchedBy(func(schema *model.AttributeSchema) bool {
+		return len(schema.Fields) == 2 && schema.Fields[0].Required && !schema.UpdatedAt.IsZero()
+	})).Return(nil)
+
+	resp, err := suite.svc.SaveAttributeSchema(suite.ctx, req)
+	suite.NoError(err)
+	suite.Len(resp.GetFields(), 2)
+	suite.Equal("seats", resp.GetFields()[1].GetKey())
+	suite.NotEmpty(resp.GetUpdatedAt())
+}
+
+func (suite *AttributeSchemaServiceSuite) TestSaveAttributeSchemaValidation() {
+	req := &pb.AttributeSchemaRequest{
+		Fields: []*pb.AttributeField{
+			{Key: "vehicle", Type: model.AttributeString},
+			{Key: "vehicle", Type: model.AttributeString, Pattern: "(bike"},
+		},
+	}
+
+	_, err := suite.svc.SaveAttributeSchema(suite.ctx, req)
+
+	violations := suite.violations(err)
+	suite.Len(violations, 2)
+	suite.Equal("fields[1].key", violations[0].GetField())
+	suite.schema.AssertNotCalled(suite.T(), "SaveAttributeSchema", mock.Anything, mock.Anything)
+}
+
+func (suite *AttributeSchemaServiceSuite) TestGetAttributeSchemaNotSaved() {
+	suite.schema.On("FindAttributeSchema", suite.ctx).Return(nil, mongo.ErrNoDocuments)
+
+	resp, err := suite.svc.GetAttributeSchema(suite.ctx, &pb.GetAttributeSchemaRequest{})
+	suite.NoError(err)
+	suite.Empty(resp.GetFields())
+}
+
+func (suite *AttributeSchemaServiceSuite) TestGetAttributeSchemaFailure() {
+	suite.schema.On("FindAttributeSchema", suite.ctx).Return(nil, mongo.ErrClientDisconnected)
+
+	_, err := suite.svc.GetAttributeSchema(suite.ctx, &pb.GetAttributeSchemaRequest{})
+	suite.True(errors.Is(err, mongo.ErrClientDisconnected))
+}
+
+func (suite *AttributeSchemaServiceSuite) TestSaveUserRejectsAttributes() {
+	req := &pb.UserRequest{
+		UserId:     "07c837a1-9489-49f3-a038-51a9aff29abe",
+		Name:       "maria",
+		Email:      "maria4@gmail.com",
+		Cpf:        "79020873008",
+		Attributes: map[string]string{"vehicle": "truck", "color": "red"},
+	}
+
+	suite.schema.On("FindAttributeSchema", suite.ctx).Return(suite.vehicleSchema(), nil)
+
+	_, err := suite.svc.Save(suite.ctx, req)
+
+	violations := suite.violations(err)
+	suite.Len(violations, 2)
+	suite.Equal("attributes.color", violations[0].GetField())
+	suite.Equal("attributes.vehicle", violations[1].GetField())
+	suite.repo.AssertNotCalled(suite.T(), "Save", mock.Anything, mock.Anything)
+}
+
+func (suite *AttributeSchemaServiceSuite) TestUpdateUserRejectsAttributes() {
+	req := &pb.UpdateUserRequest{
+		UserId:     "ee22262f-6d5f-4044-a7d9-e44a196b808c",
+		Attributes: map[string]string{},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"attributes"}},
+	}
+
+	suite.repo.On("FindByUserID", suite.ctx, req.GetUserId()).Return(&model.User{
+		UserID: req.GetUserId(),
+		Name:   "maria",
+		Email:  "maria8@gmail.com",
+		CPF:    "440.072.470-05",
+	}, nil)
+	suite.schema.On("FindAttributeSchema", suite.ctx).Return(suite.vehicleSchema(), nil)
+
+	_, err := suite.svc.UpdateUser(suite.ctx, req)
+
+	violations := suite.violations(err)
+	suite.Len(violations, 1)
+	suite.Equal("attributes.vehicle", violations[0].GetField())
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *AttributeSchemaServiceSuite) TestUpdateUserOtherFieldsSkipSchema() {
+	req := &pb.UpdateUserRequest{
+		UserId:     "ee22262f-6d5f-4044-a7d9-e44a196b808c",
+		Name:       "joana",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	}
+
+	suite.repo.On("FindByUserID", suite.ctx, req.GetUserId()).Return(&model.User{
+		UserID: req.GetUserId(),
+		Name:   "maria",
+		Email:  "maria8@gmail.com",
+		CPF:    "440.072.470-05",
+	}, nil)
+	suite.repo.On("Update", suite.ctx, mock.Anything).Return(nil)
+
+	_, err := suite.svc.UpdateUser(suite.ctx, req)
+	suite.NoError(err)
+	suite.schema.AssertNotCalled(suite.T(), "FindAttributeSchema", mock.Anything)
+}
+
+func TestAttributeSchemaServiceSuite(t *testing.T) {
+	suite.Run(t, new(AttributeSchemaServiceSuite))
+}
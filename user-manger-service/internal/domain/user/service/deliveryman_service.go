@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/lucasd-coder/fast-feet/pkg/logger"
+	model "github.com/lucasd-coder/fast-feet/user-manger-service/internal/domain/user"
+	pkgErrors "github.com/lucasd-coder/fast-feet/user-manger-service/internal/errors"
+	pb "github.com/lucasd-coder/fast-feet/user-manger-service/pkg/pb"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+const maxAvatarSize = 2 << 20
+
+var avatarContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+}
+
+// SaveDeliverymanProfile creates or replaces the vehicle and licence of the
+// deliveryman.
+func (service *UserService) SaveDeliverymanProfile(ctx context.Context,
+	req *pb.DeliverymanProfileRequest) (*pb.DeliverymanProfileResponse, error) {
+	log := logger.FromContext(ctx)
+
+	log.Infof("received request saveDeliverymanProfile with userId: %s", req.GetUserId())
+
+	pld := model.SaveDeliveryman{
+		UserID:        req.GetUserId(),
+		VehicleType:   req.GetVehicleType(),
+		VehiclePlate:  req.GetVehiclePlate(),
+		LicenseNumber: req.GetLicenseNumber(),
+		LicenseExpiry: req.GetLicenseExpiry(),
+	}
+
+	if err := pld.Validate(service.validate); err != nil {
+		return nil, pkgErrors.ValidationErrors(err)
+	}
+
+	if err := service.UserRepository.SaveDeliveryman(ctx, pld.UserID, pld.ToDeliveryman()); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, pkgErrors.NotFoundError("user not found")
+		}
+		log.Errorf("failed to save deliveryman profile in database. Error: %+v", err)
+		return nil, err
+	}
+
+	return service.findDeliveryman(ctx, pld.UserID)
+}
+
+func (service *UserService) GetDeliverymanProfile(ctx context.Context,
+	req *pb.GetDeliverymanProfileRequest) (*pb.DeliverymanProfileResponse, error) {
+	pld := model.DeliverymanByUserID{
+		UserID: req.GetUserId(),
+	}
+
+	if err := pld.Validate(service.validate); err != nil {
+		return nil, pkgErrors.ValidationErrors(err)
+	}
+
+	return service.findDeliveryman(ctx, pld.UserID)
+}
+
+func (service *UserService) SetAvailability(ctx context.Context,
+	req *pb.SetAvailabilityRequest) (*pb.DeliverymanProfileResponse, error) {
+	log := logger.FromContext(ctx)
+
+	log.Infof("received request setAvailability with userId: %s, available: %t", req.GetUserId(), req.GetAvailable())
+
+	pld := model.DeliverymanByUserID{
+		UserID: req.GetUserId(),
+	}
+
+	if err := pld.Validate(service.validate); err != nil {
+		return nil, pkgErrors.ValidationErrors(err)
+	}
+
+	if err := service.UserRepository.SetAvailability(ctx, pld.UserID, req.GetAvailable()); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, pkgErrors.NotFoundError("deliveryman profile not found")
+		}
+		log.Errorf("failed to set availability in database. Error: %+v", err)
+		return nil, err
+	}
+
+	return service.findDeliveryman(ctx, pld.UserID)
+}
+
+// UploadAvatar stores the avatar in the blob store before pointing the
+// profile at it, the key is the same for every upload of the user.
+func (service *UserService) UploadAvatar(ctx context.Context,
+	req *pb.UploadAvatarRequest) (*pb.DeliverymanProfileResponse, error) {
+	log := logger.FromContext(ctx)
+
+	log.Infof("received request uploadAvatar with userId: %s, size: %d", req.GetUserId(), len(req.GetAvatar()))
+
+	pld := model.DeliverymanByUserID{
+		UserID: req.GetUserId(),
+	}
+
+	if err := pld.Validate(service.validate); err != nil {
+		return nil, pkgErrors.ValidationErrors(err)
+	}
+
+	contentType := http.DetectContentType(req.GetAvatar())
+
+	if violation := validateAvatar(req.GetAvatar(), contentType); violation != nil {
+		return nil, pkgErrors.InvalidArgumentError([]*errdetails.BadRequest_FieldViolation{violation})
+	}
+
+	if _, err := service.findDeliveryman(ctx, pld.UserID); err != nil {
+		return nil, err
+	}
+
+	key := model.AvatarKey(pld.UserID)
+
+	if err := service.blobStore.Put(ctx, key, contentType, req.GetAvatar()); err != nil {
+		log.Errorf("failed to put avatar in blob store. Error: %+v", err)
+		return nil, err
+	}
+
+	if err := service.UserRepository.SetAvatar(ctx, pld.UserID, key); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, pkgErrors.NotFoundError("deliveryman profile not found")
+		}
+		log.Errorf("failed to set avatar in database. Error: %+v", err)
+		return nil, err
+	}
+
+	return service.findDeliveryman(ctx, pld.UserID)
+}
+
+func (service *UserService) findDeliveryman(ctx context.Context, userID string) (*pb.DeliverymanProfileResponse, error) {
+	log := logger.FromContext(ctx)
+
+	user, err := service.UserRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, pkgErrors.NotFoundError("user not found")
+		}
+		log.Errorf("failed to find user in database. Error: %+v", err)
+		return nil, err
+	}
+
+	if user.Deliveryman == nil {
+		return nil, pkgErrors.NotFoundError("deliveryman profile not found")
+	}
+
+	return service.buildDeliverymanProfileResponse(user), nil
+}
+
+func (service *UserService) buildDeliverymanProfileResponse(user *model.User) *pb.DeliverymanProfileResponse {
+	d := user.Deliveryman
+
+	resp := &pb.DeliverymanProfileResponse{
+		UserId:        user.UserID,
+		VehicleType:   d.VehicleType,
+		VehiclePlate:  d.VehiclePlate,
+		LicenseNumber: d.LicenseNumber,
+		LicenseExpiry: d.GetLicenseExpiry(),
+		Available:     d.Available,
+		UpdatedAt:     d.GetUpdatedAt(),
+	}
+
+	if d.AvatarKey != "" {
+		resp.AvatarUrl = fmt.Sprintf("%s?v=%d", service.blobStore.URL(d.AvatarKey), d.UpdatedAt.Unix())
+	}
+
+	return resp
+}
+
+func validateAvatar(avatar []byte, contentType string) *errdetails.BadRequest_FieldViolation {
+	switch {
+	case len(avatar) == 0:
+		return &errdetails.BadRequest_FieldViolation{Field: "avatar", Description: "avatar is required"}
+	case len(avatar) > maxAvatarSize:
+		return &errdetails.BadRequest_FieldViolation{
+			Field:       "avatar",
+			Description: fmt.Sprintf("avatar larger than %d bytes", maxAvatarSize),
+		}
+	case !avatarContentTypes[contentType]:
+		return &errdetails.BadRequest_FieldViolation{
+			Field:       "avatar",
+			Description: fmt.Sprintf("unsupported avatar type %q", contentType),
+		}
+	}
+	return nil
+}
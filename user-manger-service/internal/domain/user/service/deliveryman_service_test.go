@@ -0,0 +1,214 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	model "github.com/lucasd-coder/fast-feet/user-manger-service/internal/domain/user"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/domain/user/service"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/mocks"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/provider/validator"
+	pb "github.com/lucasd-coder/fast-feet/user-manger-service/pkg/pb"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const deliverymanID = "3f1c2b7e-8d4a-4b6e-9c1f-2a7d5e9b0c31"
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+type DeliverymanServiceSuite struct {
+	suite.Suite
+	svc   service.UserService
+	ctx   context.Context
+	repo  *mocks.UserRepository_internal_domain_user
+	blobs *mocks.BlobStore_internal_shared
+}
+
+func (suite *DeliverymanServiceSuite) SetupTest() {
+	suite.repo = new(mocks.UserRepository_internal_domain_user)
+	suite.blobs = new(mocks.BlobStore_internal_shared)
+	suite.svc = *service.NewUserService(suite.repo, new(mocks.AttributeSchemaRepository_internal_domain_user),
+		validator.NewValidation(), suite.blobs)
+	suite.ctx = context.Background()
+}
+
+func (suite *DeliverymanServiceSuite) deliveryman() *model.User {
+	return &model.User{
+		UserID: deliverymanID,
+		Deliveryman: &model.Deliveryman{
+			VehicleType:   model.VehicleMotorcycle,
+			VehiclePlate:  "ABC1D23",
+			LicenseNumber: "12345678901",
+			LicenseExpiry: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:     time.Now(),
+		},
+	}
+}
+
+func (suite *DeliverymanServiceSuite) TestSaveDeliverymanProfileValidation() {
+	tests := []struct {
+		name string
+		args *pb.DeliverymanProfileRequest
+	}{
+		{
+			name: "unknown vehicle type",
+			args: &pb.DeliverymanProfileRequest{UserId: deliverymanID, VehicleType: "TRUCK"},
+		},
+		{
+			name: "motorcycle without plate",
+			args: &pb.DeliverymanProfileRequest{
+				UserId:        deliverymanID,
+				VehicleType:   model.VehicleMotorcycle,
+				LicenseNumber: "12345678901",
+				LicenseExpiry: "2030-01-01T00:00:00Z",
+			},
+		},
+		{
+			name: "invalid plate",
+			args: &pb.DeliverymanProfileRequest{
+				UserId:        deliverymanID,
+				VehicleType:   model.VehicleCar,
+				VehiclePlate:  "12ABC34",
+				LicenseNumber: "12345678901",
+				LicenseExpiry: "2030-01-01T00:00:00Z",
+			},
+		},
+		{
+			name: "invalid license expiry",
+			args: &pb.DeliverymanProfileRequest{
+				UserId:        deliverymanID,
+				VehicleType:   model.VehicleCar,
+				VehiclePlate:  "ABC1234",
+				LicenseNumber: "12345678901",
+				LicenseExpiry: "01/01/2030",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, err := suite.svc.SaveDeliverymanProfile(suite.ctx, tt.args)
+			suite.Equal(codes.InvalidArgument, status.Code(err))
+		})
+	}
+	suite.repo.AssertNotCalled(suite.T(), "SaveDeliveryman", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DeliverymanServiceSuite) TestSaveDeliverymanProfileBicycle() {
+	req := &pb.DeliverymanProfileRequest{UserId: deliverymanID, VehicleType: model.VehicleBicycle}
+
+	suite.repo.On("SaveDeliveryman", suite.ctx, deliverymanID, mock.MatchedBy(func(d *model.Deliveryman) bool {
+		return d.VehicleType == model.VehicleBicycle && d.LicenseExpiry.IsZero()
+	})).Return(nil)
+	suite.repo.On("FindByUserID", suite.ctx, deliverymanID).Return(&model.User{
+		UserID:      deliverymanID,
+		Deliveryman: &model.Deliveryman{VehicleType: model.VehicleBicycle},
+	}, nil)
+
+	resp, err := suite.svc.SaveDeliverymanProfile(suite.ctx, req)
+	suite.NoError(err)
+	suite.Equal(model.VehicleBicycle, resp.GetVehicleType())
+	suite.False(resp.GetAvailable())
+	suite.Empty(resp.GetLicenseExpiry())
+}
+
+func (suite *DeliverymanServiceSuite) TestSaveDeliverymanProfileUserNotFound() {
+	req := &pb.DeliverymanProfileRequest{
+		UserId:        deliverymanID,
+		VehicleType:   model.VehicleCar,
+		VehiclePlate:  "ABC-1234",
+		LicenseNumber: "12345678901",
+		LicenseExpiry: "2030-01-01T00:00:00Z",
+	}
+
+	suite.repo.On("SaveDeliveryman", suite.ctx, deliverymanID, mock.MatchedBy(func(d *model.Deliveryman) bool {
+		return d.VehiclePlate == "ABC1234"
+	})).Return(mongo.ErrNoDocuments)
+
+	_, err := suite.svc.SaveDeliverymanProfile(suite.ctx, req)
+	suite.Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *DeliverymanServiceSuite) TestGetDeliverymanProfileWithoutProfile() {
+	suite.repo.On("FindByUserID", suite.ctx, deliverymanID).Return(&model.User{UserID: deliverymanID}, nil)
+
+	_, err := suite.svc.GetDeliverymanProfile(suite.ctx, &pb.GetDeliverymanProfileRequest{UserId: deliverymanID})
+	suite.Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *DeliverymanServiceSuite) TestSetAvailability() {
+	user := suite.deliveryman()
+	user.Deliveryman.Available = true
+
+	suite.repo.On("SetAvailability", suite.ctx, deliverymanID, true).Return(nil)
+	suite.repo.On("FindByUserID", suite.ctx, deliverymanID).Return(user, nil)
+
+	resp, err := suite.svc.SetAvailability(suite.ctx, &pb.SetAvailabilityRequest{UserId: deliverymanID, Available: true})
+	suite.NoError(err)
+	suite.True(resp.GetAvailable())
+	suite.Equal("2030-01-01T00:00:00Z", resp.GetLicenseExpiry())
+}
+
+func (suite *DeliverymanServiceSuite) TestSetAvailabilityWithoutProfile() {
+	suite.repo.On("SetAvailability", suite.ctx, deliverymanID, true).Return(mongo.ErrNoDocuments)
+
+	_, err := suite.svc.SetAvailability(suite.ctx, &pb.SetAvailabilityRequest{UserId: deliverymanID, Available: true})
+	suite.Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *DeliverymanServiceSuite) TestUploadAvatar() {
+	user := suite.deliveryman()
+	key := model.AvatarKey(deliverymanID)
+
+	suite.repo.On("FindByUserID", suite.ctx, deliverymanID).Return(user, nil).Once()
+	suite.blobs.On("Put", suite.ctx, key, "image/png", pngHeader).Return(nil)
+	suite.repo.On("SetAvatar", suite.ctx, deliverymanID, key).Return(nil)
+
+	withAvatar := suite.deliveryman()
+	withAvatar.Deliveryman.AvatarKey = key
+	suite.repo.On("FindByUserID", suite.ctx, deliverymanID).Return(withAvatar, nil).Once()
+	suite.blobs.On("URL", key).Return("http://localhost:8090/blobs/" + key)
+
+	resp, err := suite.svc.UploadAvatar(suite.ctx, &pb.UploadAvatarRequest{UserId: deliverymanID, Avatar: pngHeader})
+	suite.NoError(err)
+	suite.Contains(resp.GetAvatarUrl(), "http://localhost:8090/blobs/"+key+"?v=")
+}
+
+func (suite *DeliverymanServiceSuite) TestUploadAvatarRejectsContent() {
+	tests := []struct {
+		name   string
+		avatar []byte
+	}{
+		{name: "empty"},
+		{name: "not an image", avatar: []byte("<html><body></body></html>")},
+		{name: "too large", avatar: append(bytes.Clone(pngHeader), make([]byte, 2<<20)...)},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, err := suite.svc.UploadAvatar(suite.ctx, &pb.UploadAvatarRequest{UserId: deliverymanID, Avatar: tt.avatar})
+			suite.Equal(codes.InvalidArgument, status.Code(err))
+		})
+	}
+	suite.blobs.AssertNotCalled(suite.T(), "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DeliverymanServiceSuite) TestUploadAvatarBlobStoreFailure() {
+	suite.repo.On("FindByUserID", suite.ctx, deliverymanID).Return(suite.deliveryman(), nil)
+	suite.blobs.On("Put", suite.ctx, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("disk full"))
+
+	_, err := suite.svc.UploadAvatar(suite.ctx, &pb.UploadAvatarRequest{UserId: deliverymanID, Avatar: pngHeader})
+	suite.Error(err)
+	suite.repo.AssertNotCalled(suite.T(), "SetAvatar", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDeliverymanServiceSuite(t *testing.T) {
+	suite.Run(t, new(DeliverymanServiceSuite))
+}
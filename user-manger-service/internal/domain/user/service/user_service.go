@@ -4,8 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log/slog"
 	"net/mail"
+	"slices"
 	"time"
 
 	"github.com/lucasd-coder/fast-feet/pkg/logger"
@@ -21,22 +21,29 @@ import (
 
 type UserService struct {
 	pb.UnimplementedUserServiceServer
-	UserRepository model.UserRepository
-	validate       shared.Validator
+	UserRepository            model.UserRepository
+	AttributeSchemaRepository model.AttributeSchemaRepository
+	validate                  shared.Validator
+	blobStore                 shared.BlobStore
 }
 
 func NewUserService(userRepo model.UserRepository,
+	schemaRepo model.AttributeSchemaRepository,
 	val *validator.Validation,
+	blobStore shared.BlobStore,
 ) *UserService {
 	return &UserService{
-		UserRepository: userRepo,
-		validate:       val,
+		UserRepository:            userRepo,
+		AttributeSchemaRepository: schemaRepo,
+		validate:                  val,
+		blobStore:                 blobStore,
 	}
 }
 
 func (service *UserService) Save(ctx context.Context, req *pb.UserRequest) (*pb.UserResponse, error) {
-	slog.With("payload", req).
-		Info("received request")
+	log := logger.FromContext(ctx)
+
+	log.Infof("received request save with userId: %s", req.GetUserId())
 
 	pld := model.User{
 		UserID:     req.GetUserId(),
@@ -51,6 +58,10 @@ func (service *UserService) Save(ctx context.Context, req *pb.UserRequest) (*pb.
 		return nil, pkgErrors.ValidationErrors(err)
 	}
 
+	if err := service.checkAttributes(ctx, pld.Attributes); err != nil {
+		return nil, err
+	}
+
 	user, err := service.UserRepository.FindByUserID(ctx, pld.UserID)
 	if err != nil {
 		if !errors.Is(err, mongo.ErrNoDocuments) {
@@ -124,6 +135,215 @@ func (service *UserService) FindByEmail(ctx context.Context, req *pb.UserByEmail
 	return buildUserResponse(user), nil
 }
 
+// UpdateUser changes the fields of the update mask, email and CPF must stay
+// unique across users.
+func (service *UserService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UserResponse, error) {
+	log := logger.FromContext(ctx)
+
+	log.Infof("received request updateUser with userId: %s, fields: %v", req.GetUserId(), req.GetUpdateMask().GetPaths())
+
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		return nil, pkgErrors.InvalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+			{
+				Field:       "updateMask",
+				Description: "update mask is required",
+			},
+		})
+	}
+
+	user, err := service.UserRepository.FindByUserID(ctx, req.GetUserId())
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, pkgErrors.NotFoundError("user not found")
+		}
+		log.Errorf("failed to find user with userID in database. Error: %+v", err)
+		return nil, err
+	}
+
+	current := *user
+
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		switch path {
+		case "name":
+			user.Name = req.GetName()
+		case "email":
+			user.Email = req.GetEmail()
+		case "cpf":
+			user.CPF = req.GetCpf()
+		case "attributes":
+			user.Attributes = req.GetAttributes()
+		default:
+			return nil, pkgErrors.InvalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+				{
+					Field:       "updateMask",
+					Description: fmt.Sprintf("unknown field %q", path),
+				},
+			})
+		}
+	}
+
+	if err := user.Validate(service.validate); err != nil {
+		return nil, pkgErrors.ValidationErrors(err)
+	}
+
+	// the attributes are only checked when changed, a schema saved later
+	// does not block the update of the other fields.
+	if slices.Contains(req.GetUpdateMask().GetPaths(), "attributes") {
+		if err := service.checkAttributes(ctx, user.Attributes); err != nil {
+			return nil, err
+		}
+	}
+
+	if user.Email != current.Email {
+		if err := service.checkUnique(ctx, user.UserID, "email", service.UserRepository.FindByEmail, user.Email); err != nil {
+			return nil, err
+		}
+	}
+
+	if user.CPF != current.CPF {
+		if err := service.checkUnique(ctx, user.UserID, "cpf", service.UserRepository.FindByCpf, user.CPF); err != nil {
+			return nil, err
+		}
+	}
+
+	user.UpdatedAt = time.Now()
+
+	if err := service.UserRepository.Update(ctx, user); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, pkgErrors.AlreadyExistsError("email or cpf already in use")
+		}
+		log.Errorf("failed to update user in database. Error: %+v", err)
+		return nil, err
+	}
+
+	log.Info("request updateUser finished....")
+	return buildUserResponse(user), nil
+}
+
+// DeleteUser erases the user record, its personal data is not kept.
+func (service *UserService) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+	log := logger.FromContext(ctx)
+
+	log.Infof("received request deleteUser with userId: %s", req.GetUserId())
+
+	pld := model.DeleteUser{
+		UserID: req.GetUserId(),
+	}
+
+	if err := pld.Validate(service.validate); err != nil {
+		return nil, pkgErrors.ValidationErrors(err)
+	}
+
+	// the avatar goes first, a retry after the record is gone would not find
+	// the user anymore.
+	if err := service.blobStore.Delete(ctx, model.AvatarKey(pld.UserID)); err != nil {
+		log.Errorf("failed to delete avatar of user. Error: %+v", err)
+		return nil, err
+	}
+
+	if err := service.UserRepository.Delete(ctx, pld.UserID); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, pkgErrors.NotFoundError("user not found")
+		}
+		log.Errorf("failed to delete user in database. Error: %+v", err)
+		return nil, err
+	}
+
+	log.Info("request deleteUser finished....")
+	return &pb.DeleteUserResponse{
+		UserId:    pld.UserID,
+		DeletedAt: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// ExportUser returns the personal data kept of the user for the LGPD export.
+func (service *UserService) ExportUser(ctx context.Context, req *pb.ExportUserRequest) (*pb.ExportUserResponse, error) {
+	log := logger.FromContext(ctx)
+
+	log.Infof("received request exportUser with userId: %s", req.GetUserId())
+
+	pld := model.ExportUser{
+		UserID: req.GetUserId(),
+	}
+
+	if err := pld.Validate(service.validate); err != nil {
+		return nil, pkgErrors.ValidationErrors(err)
+	}
+
+	user, err := service.UserRepository.FindByUserID(ctx, pld.UserID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, pkgErrors.NotFoundError("user not found")
+		}
+		log.Errorf("failed to find user in database. Error: %+v", err)
+		return nil, err
+	}
+
+	log.Info("request exportUser finished....")
+	return &pb.ExportUserResponse{
+		UserId:     user.UserID,
+		Name:       user.Name,
+		Email:      user.Email,
+		Cpf:        user.CPF,
+		Attributes: user.Attributes,
+		CreatedAt:  user.GetCreatedAt(),
+		UpdatedAt:  user.GetUpdatedAt(),
+	}, nil
+}
+
+func (service *UserService) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	log := logger.FromContext(ctx)
+
+	pld := model.ListUsers{
+		Cursor:      req.GetCursor(),
+		Limit:       req.GetLimit(),
+		NamePrefix:  req.GetNamePrefix(),
+		CreatedFrom: req.GetCreatedFrom(),
+		CreatedTo:   req.GetCreatedTo(),
+		Attributes:  req.GetAttributes(),
+	}
+
+	if err := pld.Validate(service.validate); err != nil {
+		return nil, pkgErrors.ValidationErrors(err)
+	}
+
+	users, err := service.UserRepository.FindAll(ctx, &pld)
+	if err != nil {
+		log.Errorf("failed to list users in database. Error: %+v", err)
+		return nil, err
+	}
+
+	resp := &pb.ListUsersResponse{}
+
+	if int64(len(users)) > pld.GetLimit() {
+		users = users[:pld.GetLimit()]
+		resp.NextCursor = users[len(users)-1].ID.Hex()
+	}
+
+	for i := range users {
+		resp.Users = append(resp.Users, buildUserResponse(&users[i]))
+	}
+
+	return resp, nil
+}
+
+func (service *UserService) checkUnique(ctx context.Context, userID, field string,
+	find func(ctx context.Context, value string) (*model.User, error), value string) error {
+	user, err := find(ctx, value)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil
+		}
+		return err
+	}
+
+	if user.UserID != userID {
+		return pkgErrors.AlreadyExistsError(fmt.Sprintf("%s already in use", field))
+	}
+
+	return nil
+}
+
 func buildUserResponse(user *model.User) *pb.UserResponse {
 	if user == nil {
 		return nil
@@ -135,6 +355,7 @@ func buildUserResponse(user *model.User) *pb.UserResponse {
 		Email:      user.Email,
 		Attributes: user.Attributes,
 		CreatedAt:  user.GetCreatedAt(),
+		UpdatedAt:  user.GetUpdatedAt(),
 	}
 }
 
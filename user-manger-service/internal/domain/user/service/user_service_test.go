@@ -2,6 +2,7 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -13,9 +14,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 const (
@@ -26,17 +29,23 @@ const (
 
 type UserServiceSuite struct {
 	suite.Suite
-	svc  service.UserService
-	ctx  context.Context
-	repo *mocks.UserRepository_internal_domain_user
+	svc    service.UserService
+	ctx    context.Context
+	repo   *mocks.UserRepository_internal_domain_user
+	schema *mocks.AttributeSchemaRepository_internal_domain_user
+	blobs  *mocks.BlobStore_internal_shared
 }
 
 func (suite *UserServiceSuite) SetupTest() {
 	val := validator.NewValidation()
 	repo := new(mocks.UserRepository_internal_domain_user)
+	schema := new(mocks.AttributeSchemaRepository_internal_domain_user)
+	blobs := new(mocks.BlobStore_internal_shared)
 
 	suite.repo = repo
-	suite.svc = *service.NewUserService(repo, val)
+	suite.schema = schema
+	suite.blobs = blobs
+	suite.svc = *service.NewUserService(repo, schema, val, blobs)
 	suite.ctx = context.Background()
 }
 
@@ -91,6 +100,7 @@ func (suite *UserServiceSuite) TestSaveAlreadyExistUser() {
 		CPF:    req.GetCpf(),
 	}
 
+	suite.schema.On("FindAttributeSchema", suite.ctx).Return(nil, mongo.ErrNoDocuments)
 	suite.repo.On("FindByUserID", suite.ctx, user.UserID).Return(user, nil)
 
 	_, err := suite.svc.Save(suite.ctx, req)
@@ -111,6 +121,7 @@ func (suite *UserServiceSuite) TestSaveMongoErrClientDisconnected() {
 		Cpf:    "79020873008",
 	}
 
+	suite.schema.On("FindAttributeSchema", suite.ctx).Return(nil, mongo.ErrNoDocuments)
 	suite.repo.On("FindByUserID", suite.ctx, req.UserId).Return(nil, mongo.ErrClientDisconnected)
 
 	_, err := suite.svc.Save(suite.ctx, req)
@@ -134,6 +145,7 @@ func (suite *UserServiceSuite) TestSaveCreatedSuccessfully() {
 		CPF:    req.GetCpf(),
 	}
 
+	suite.schema.On("FindAttributeSchema", suite.ctx).Return(nil, mongo.ErrNoDocuments)
 	suite.repo.On("FindByUserID", suite.ctx, req.GetUserId()).Return(nil, nil)
 	suite.repo.On("Save", suite.ctx, mock.Anything).Return(user, nil)
 
@@ -290,6 +302,248 @@ func (suite *UserServiceSuite) TestFindByEmailGetUserSuccessfully() {
 	assert.Equal(suite.T(), user.Attributes, resp.GetAttributes())
 }
 
+func (suite *UserServiceSuite) TestUpdateUserValidation() {
+	tests := []struct {
+		name string
+		args *pb.UpdateUserRequest
+	}{
+		{
+			name: "test validation update mask required",
+			args: &pb.UpdateUserRequest{
+				UserId: "ee22262f-6d5f-4044-a7d9-e44a196b808c",
+				Name:   "joana",
+			},
+		},
+		{
+			name: "test validation unknown field in update mask",
+			args: &pb.UpdateUserRequest{
+				UserId:     "ee22262f-6d5f-4044-a7d9-e44a196b808c",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"userId"}},
+			},
+		},
+		{
+			name: "test validation invalid email",
+			args: &pb.UpdateUserRequest{
+				UserId:     "ee22262f-6d5f-4044-a7d9-e44a196b808c",
+				Email:      "invalid email",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email"}},
+			},
+		},
+	}
+
+	user := &model.User{
+		UserID: "ee22262f-6d5f-4044-a7d9-e44a196b808c",
+		Name:   "maria",
+		Email:  "maria6@gmail.com",
+		CPF:    "440.072.470-05",
+	}
+
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			found := *user
+			suite.repo.On("FindByUserID", suite.ctx, user.UserID).Return(&found, nil).Once()
+
+			_, err := suite.svc.UpdateUser(suite.ctx, tt.args)
+			suite.Equal(codes.InvalidArgument, status.Code(err), "suite.svc.UpdateUser() = %v", err)
+		})
+	}
+}
+
+func (suite *UserServiceSuite) TestUpdateUserNotFound() {
+	req := &pb.UpdateUserRequest{
+		UserId:     "ee22262f-6d5f-4044-a7d9-e44a196b808c",
+		Name:       "joana",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	}
+
+	suite.repo.On("FindByUserID", suite.ctx, req.GetUserId()).Return(nil, mongo.ErrNoDocuments)
+
+	_, err := suite.svc.UpdateUser(suite.ctx, req)
+	suite.Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *UserServiceSuite) TestUpdateUserEmailAlreadyInUse() {
+	req := &pb.UpdateUserRequest{
+		UserId:     "ee22262f-6d5f-4044-a7d9-e44a196b808c",
+		Email:      "joana@gmail.com",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email"}},
+	}
+
+	user := &model.User{
+		UserID: req.GetUserId(),
+		Name:   "maria",
+		Email:  "maria7@gmail.com",
+		CPF:    "440.072.470-05",
+	}
+	other := &model.User{
+		UserID: "07c837a1-9489-49f3-a038-51a9aff29abe",
+		Email:  req.GetEmail(),
+	}
+
+	suite.repo.On("FindByUserID", suite.ctx, req.GetUserId()).Return(user, nil)
+	suite.repo.On("FindByEmail", suite.ctx, req.GetEmail()).Return(other, nil)
+
+	_, err := suite.svc.UpdateUser(suite.ctx, req)
+	suite.Equal(codes.AlreadyExists, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *UserServiceSuite) TestUpdateUserSuccessfully() {
+	req := &pb.UpdateUserRequest{
+		UserId:     "ee22262f-6d5f-4044-a7d9-e44a196b808c",
+		Name:       "joana",
+		Email:      "joana@gmail.com",
+		Cpf:        "563.043.250-88",
+		Attributes: map[string]string{"vehicle": "bike"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email", "attributes"}},
+	}
+
+	user := &model.User{
+		UserID: req.GetUserId(),
+		Name:   "maria",
+		Email:  "maria8@gmail.com",
+		CPF:    "440.072.470-05",
+	}
+
+	suite.schema.On("FindAttributeSchema", suite.ctx).Return(nil, mongo.ErrNoDocuments)
+	suite.repo.On("FindByUserID", suite.ctx, req.GetUserId()).Return(user, nil)
+	suite.repo.On("FindByEmail", suite.ctx, req.GetEmail()).Return(nil, mongo.ErrNoDocuments)
+	suite.repo.On("Update", suite.ctx, mock.MatchedBy(func(updated *model.User) bool {
+		return updated.Name == "maria" &&
+			updated.Email == req.GetEmail() &&
+			updated.CPF == "440.072.470-05" &&
+			!updated.UpdatedAt.IsZero()
+	})).Return(nil)
+
+	resp, err := suite.svc.UpdateUser(suite.ctx, req)
+	suite.NoError(err)
+	suite.Equal(req.GetEmail(), resp.GetEmail())
+	suite.Equal(req.GetAttributes(), resp.GetAttributes())
+	suite.NotEmpty(resp.GetUpdatedAt())
+}
+
+func (suite *UserServiceSuite) TestDeleteUserNotFound() {
+	req := &pb.DeleteUserRequest{UserId: "f2e1c4d9-1a7b-4c55-9a3e-1d7a1fd0b9a2"}
+
+	suite.blobs.On("Delete", suite.ctx, "avatars/"+req.GetUserId()).Return(nil)
+	suite.repo.On("Delete", suite.ctx, req.GetUserId()).Return(mongo.ErrNoDocuments)
+
+	_, err := suite.svc.DeleteUser(suite.ctx, req)
+	suite.Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *UserServiceSuite) TestDeleteUserSuccessfully() {
+	req := &pb.DeleteUserRequest{UserId: "f2e1c4d9-1a7b-4c55-9a3e-1d7a1fd0b9a2"}
+
+	suite.blobs.On("Delete", suite.ctx, "avatars/"+req.GetUserId()).Return(nil)
+	suite.repo.On("Delete", suite.ctx, req.GetUserId()).Return(nil)
+
+	resp, err := suite.svc.DeleteUser(suite.ctx, req)
+	suite.NoError(err)
+	suite.Equal(req.GetUserId(), resp.GetUserId())
+	suite.NotEmpty(resp.GetDeletedAt())
+}
+
+func (suite *UserServiceSuite) TestDeleteUserKeepsRecordWhenAvatarFails() {
+	req := &pb.DeleteUserRequest{UserId: "f2e1c4d9-1a7b-4c55-9a3e-1d7a1fd0b9a2"}
+
+	suite.blobs.On("Delete", suite.ctx, "avatars/"+req.GetUserId()).Return(errors.New("permission denied"))
+
+	_, err := suite.svc.DeleteUser(suite.ctx, req)
+	suite.Error(err)
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (suite *UserServiceSuite) TestDeleteUserValidation() {
+	_, err := suite.svc.DeleteUser(suite.ctx, &pb.DeleteUserRequest{UserId: "1234"})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (suite *UserServiceSuite) TestExportUserNotFound() {
+	req := &pb.ExportUserRequest{UserId: "f2e1c4d9-1a7b-4c55-9a3e-1d7a1fd0b9a2"}
+
+	suite.repo.On("FindByUserID", suite.ctx, req.GetUserId()).Return(nil, mongo.ErrNoDocuments)
+
+	_, err := suite.svc.ExportUser(suite.ctx, req)
+	suite.Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *UserServiceSuite) TestExportUserSuccessfully() {
+	req := &pb.ExportUserRequest{UserId: "f2e1c4d9-1a7b-4c55-9a3e-1d7a1fd0b9a2"}
+
+	suite.repo.On("FindByUserID", suite.ctx, req.GetUserId()).Return(&model.User{
+		UserID:     req.GetUserId(),
+		Name:       "maria",
+		Email:      "maria@gmail.com",
+		CPF:        "80961164088",
+		Attributes: map[string]string{"phone": "84999999999"},
+	}, nil)
+
+	resp, err := suite.svc.ExportUser(suite.ctx, req)
+	suite.NoError(err)
+	suite.Equal("80961164088", resp.GetCpf())
+	suite.Equal("maria@gmail.com", resp.GetEmail())
+	suite.Equal("84999999999", resp.GetAttributes()["phone"])
+}
+
+func (suite *UserServiceSuite) TestExportUserValidation() {
+	_, err := suite.svc.ExportUser(suite.ctx, &pb.ExportUserRequest{UserId: "1234"})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	suite.repo.AssertNotCalled(suite.T(), "FindByUserID", mock.Anything, mock.Anything)
+}
+
+func (suite *UserServiceSuite) TestListUsersNextCursor() {
+	users := []model.User{
+		{ID: primitive.NewObjectID(), UserID: "f2e1c4d9-1a7b-4c55-9a3e-1d7a1fd0b9a2", Name: "maria"},
+		{ID: primitive.NewObjectID(), UserID: "0b9a7c1e-4c2d-4f0e-8a3b-5d6e7f8a9b0c", Name: "mariana"},
+		{ID: primitive.NewObjectID(), UserID: "6c5d4e3f-2a1b-4c0d-9e8f-7a6b5c4d3e2f", Name: "marina"},
+	}
+
+	suite.repo.On("FindAll", suite.ctx, mock.MatchedBy(func(pld *model.ListUsers) bool {
+		return pld.NamePrefix == "mar" && pld.GetLimit() == 2
+	})).Return(users, nil)
+
+	resp, err := suite.svc.ListUsers(suite.ctx, &pb.ListUsersRequest{NamePrefix: "mar", Limit: 2})
+	suite.NoError(err)
+	suite.Len(resp.GetUsers(), 2)
+	suite.Equal("mariana", resp.GetUsers()[1].GetName())
+	suite.Equal(users[1].ID.Hex(), resp.GetNextCursor())
+}
+
+func (suite *UserServiceSuite) TestListUsersLastPage() {
+	users := []model.User{
+		{ID: primitive.NewObjectID(), UserID: "f2e1c4d9-1a7b-4c55-9a3e-1d7a1fd0b9a2", Name: "maria"},
+	}
+
+	suite.repo.On("FindAll", suite.ctx, mock.Anything).Return(users, nil)
+
+	resp, err := suite.svc.ListUsers(suite.ctx, &pb.ListUsersRequest{})
+	suite.NoError(err)
+	suite.Len(resp.GetUsers(), 1)
+	suite.Empty(resp.GetNextCursor())
+}
+
+func (suite *UserServiceSuite) TestListUsersValidation() {
+	tests := []struct {
+		name string
+		req  *pb.ListUsersRequest
+	}{
+		{name: "invalid cursor", req: &pb.ListUsersRequest{Cursor: "1234"}},
+		{name: "limit above max", req: &pb.ListUsersRequest{Limit: 101}},
+		{name: "invalid createdFrom", req: &pb.ListUsersRequest{CreatedFrom: "2023-01-01"}},
+		{name: "attribute key with operator", req: &pb.ListUsersRequest{Attributes: map[string]string{"$where": "1"}}},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, err := suite.svc.ListUsers(suite.ctx, tt.req)
+			suite.Equal(codes.InvalidArgument, status.Code(err))
+		})
+	}
+	suite.repo.AssertNotCalled(suite.T(), "FindAll", mock.Anything, mock.Anything)
+}
+
 func TestUserServiceSuite(t *testing.T) {
 	suite.Run(t, new(UserServiceSuite))
 }
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"strings"
 	"time"
 
 	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/shared"
@@ -8,20 +9,146 @@ import (
 )
 
 type User struct {
-	ID         primitive.ObjectID `bson:"_id,omitempty"`
-	UserID     string             `bson:"userId,omitempty" validate:"required,pattern,uuid4"`
-	Name       string             `bson:"name,omitempty" validate:"required,pattern"`
-	Email      string             `bson:"email,omitempty" validate:"required,email,pattern"`
-	CPF        string             `bson:"cpf,omitempty" validate:"required,isCPF"`
-	Attributes map[string]string  `bson:"attributes,omitempty"`
-	CreatedAt  time.Time          `bson:"createdAt,omitempty"`
-	UpdatedAt  time.Time          `bson:"updatedAt,omitempty"`
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	UserID      string             `bson:"userId,omitempty" validate:"required,pattern,uuid4"`
+	Name        string             `bson:"name,omitempty" validate:"required,pattern"`
+	Email       string             `bson:"email,omitempty" validate:"required,email,pattern"`
+	CPF         string             `bson:"cpf,omitempty" validate:"required,isCPF"`
+	EmailHash   string             `bson:"emailHash,omitempty"`
+	CPFHash     string             `bson:"cpfHash,omitempty"`
+	Attributes  map[string]string  `bson:"attributes,omitempty"`
+	Deliveryman *Deliveryman       `bson:"deliveryman,omitempty"`
+	CreatedAt   time.Time          `bson:"createdAt,omitempty"`
+	UpdatedAt   time.Time          `bson:"updatedAt,omitempty"`
+}
+
+const (
+	VehicleBicycle    = "BICYCLE"
+	VehicleMotorcycle = "MOTORCYCLE"
+	VehicleCar        = "CAR"
+	VehicleVan        = "VAN"
+)
+
+// Deliveryman is the profile of a user that delivers orders, a bicycle needs
+// neither plate nor licence.
+type Deliveryman struct {
+	VehicleType   string    `bson:"vehicleType,omitempty"`
+	VehiclePlate  string    `bson:"vehiclePlate,omitempty"`
+	LicenseNumber string    `bson:"licenseNumber,omitempty"`
+	LicenseExpiry time.Time `bson:"licenseExpiry,omitempty"`
+	AvatarKey     string    `bson:"avatarKey,omitempty"`
+	Available     bool      `bson:"available"`
+	UpdatedAt     time.Time `bson:"updatedAt,omitempty"`
+}
+
+type SaveDeliveryman struct {
+	UserID        string `validate:"required,uuid4"`
+	VehicleType   string `validate:"required,oneof=BICYCLE MOTORCYCLE CAR VAN"`
+	VehiclePlate  string `validate:"required_unless=VehicleType BICYCLE,plate"`
+	LicenseNumber string `validate:"required_unless=VehicleType BICYCLE,omitempty,numeric,len=11"`
+	LicenseExpiry string `validate:"required_unless=VehicleType BICYCLE,rfc3339"`
+}
+
+type DeliverymanByUserID struct {
+	UserID string `validate:"required,uuid4"`
+}
+
+type DeleteUser struct {
+	UserID string `validate:"required,uuid4"`
+}
+
+type ExportUser struct {
+	UserID string `validate:"required,uuid4"`
+}
+
+const (
+	defaultListLimit int64 = 20
+	maxListLimit     int64 = 100
+)
+
+// ListUsers filters the users, Cursor is the ID of the last user of the
+// previous page.
+type ListUsers struct {
+	Cursor      string            `validate:"objectID"`
+	Limit       int64             `validate:"gte=0,lte=100"`
+	NamePrefix  string            `validate:"pattern"`
+	CreatedFrom string            `validate:"rfc3339"`
+	CreatedTo   string            `validate:"rfc3339"`
+	Attributes  map[string]string `validate:"dive,keys,required,excludesall=.$,endkeys,pattern"`
 }
 
 func (user *User) Validate(val shared.Validator) error {
 	return val.ValidateStruct(user)
 }
 
+func (s *SaveDeliveryman) Validate(val shared.Validator) error {
+	return val.ValidateStruct(s)
+}
+
+// ToDeliveryman parses the licence expiry, it must be called after Validate.
+func (s *SaveDeliveryman) ToDeliveryman() *Deliveryman {
+	d := &Deliveryman{
+		VehicleType:   s.VehicleType,
+		VehiclePlate:  strings.ReplaceAll(s.VehiclePlate, "-", ""),
+		LicenseNumber: s.LicenseNumber,
+		UpdatedAt:     time.Now(),
+	}
+	if s.LicenseExpiry != "" {
+		d.LicenseExpiry, _ = time.Parse(time.RFC3339, s.LicenseExpiry)
+	}
+	return d
+}
+
+func (d *DeliverymanByUserID) Validate(val shared.Validator) error {
+	return val.ValidateStruct(d)
+}
+
+// AvatarKey is the blob store key of the avatar of userID, an upload
+// replaces the previous one.
+func AvatarKey(userID string) string {
+	return "avatars/" + userID
+}
+
+func (d *Deliveryman) GetLicenseExpiry() string {
+	if d.LicenseExpiry.IsZero() {
+		return ""
+	}
+	return d.LicenseExpiry.Format(time.RFC3339)
+}
+
+func (d *Deliveryman) GetUpdatedAt() string {
+	if d.UpdatedAt.IsZero() {
+		return ""
+	}
+	return d.UpdatedAt.Format(time.RFC3339)
+}
+
+func (d *DeleteUser) Validate(val shared.Validator) error {
+	return val.ValidateStruct(d)
+}
+
+func (e *ExportUser) Validate(val shared.Validator) error {
+	return val.ValidateStruct(e)
+}
+
+func (l *ListUsers) Validate(val shared.Validator) error {
+	return val.ValidateStruct(l)
+}
+
+func (l *ListUsers) GetLimit() int64 {
+	if l.Limit <= 0 {
+		return defaultListLimit
+	}
+	return min(l.Limit, maxListLimit)
+}
+
 func (user *User) GetCreatedAt() string {
 	return user.CreatedAt.Format(time.RFC3339)
 }
+
+func (user *User) GetUpdatedAt() string {
+	if user.UpdatedAt.IsZero() {
+		return ""
+	}
+	return user.UpdatedAt.Format(time.RFC3339)
+}
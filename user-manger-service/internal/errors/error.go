@@ -18,10 +18,19 @@ func fieldViolation(field string, err error) *errdetails.BadRequest_FieldViolati
 	}
 }
 
+// InvalidArgumentError also attaches the violations as details, so callers
+// can map them back to their fields.
 func InvalidArgumentError(violations []*errdetails.BadRequest_FieldViolation) error {
 	badRequest := &errdetails.BadRequest{FieldViolations: violations}
 
-	return status.Errorf(codes.InvalidArgument, "invalid parameters: %v", badRequest)
+	st := status.Newf(codes.InvalidArgument, "invalid parameters: %v", badRequest)
+
+	withDetails, err := st.WithDetails(badRequest)
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
 }
 
 func UnauthenticatedError(err error) error {
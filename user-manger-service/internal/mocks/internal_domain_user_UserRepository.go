@@ -14,6 +14,46 @@ type UserRepository_internal_domain_user struct {
 	mock.Mock
 }
 
+// Delete provides a mock function with given fields: ctx, userID
+func (_m *UserRepository_internal_domain_user) Delete(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindAll provides a mock function with given fields: ctx, pld
+func (_m *UserRepository_internal_domain_user) FindAll(ctx context.Context, pld *model.ListUsers) ([]model.User, error) {
+	ret := _m.Called(ctx, pld)
+
+	var r0 []model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListUsers) ([]model.User, error)); ok {
+		return rf(ctx, pld)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListUsers) []model.User); ok {
+		r0 = rf(ctx, pld)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ListUsers) error); ok {
+		r1 = rf(ctx, pld)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindByCpf provides a mock function with given fields: ctx, cpf
 func (_m *UserRepository_internal_domain_user) FindByCpf(ctx context.Context, cpf string) (*model.User, error) {
 	ret := _m.Called(ctx, cpf)
@@ -118,6 +158,62 @@ func (_m *UserRepository_internal_domain_user) Save(ctx context.Context, user *m
 	return r0, r1
 }
 
+// SaveDeliveryman provides a mock function with given fields: ctx, userID, deliveryman
+func (_m *UserRepository_internal_domain_user) SaveDeliveryman(ctx context.Context, userID string, deliveryman *model.Deliveryman) error {
+	ret := _m.Called(ctx, userID, deliveryman)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.Deliveryman) error); ok {
+		r0 = rf(ctx, userID, deliveryman)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetAvailability provides a mock function with given fields: ctx, userID, available
+func (_m *UserRepository_internal_domain_user) SetAvailability(ctx context.Context, userID string, available bool) error {
+	ret := _m.Called(ctx, userID, available)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, userID, available)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetAvatar provides a mock function with given fields: ctx, userID, key
+func (_m *UserRepository_internal_domain_user) SetAvatar(ctx context.Context, userID string, key string) error {
+	ret := _m.Called(ctx, userID, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, user
+func (_m *UserRepository_internal_domain_user) Update(ctx context.Context, user *model.User) error {
+	ret := _m.Called(ctx, user)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.User) error); ok {
+		r0 = rf(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewUserRepository_internal_domain_user interface {
 	mock.TestingT
 	Cleanup(func())
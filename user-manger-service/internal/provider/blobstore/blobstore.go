@@ -0,0 +1,20 @@
+package blobstore
+
+import (
+	"fmt"
+
+	"github.com/lucasd-coder/fast-feet/user-manger-service/config"
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/shared"
+)
+
+const DriverFileSystem = "filesystem"
+
+// New returns the blob store selected by cfg.BlobStore.Driver.
+func New(cfg *config.Config) (shared.BlobStore, error) {
+	switch cfg.BlobStore.Driver {
+	case DriverFileSystem:
+		return NewFileSystem(cfg.BlobStore.Path, cfg.BlobStore.BaseURL), nil
+	default:
+		return nil, fmt.Errorf("unknown blob store driver: %q", cfg.BlobStore.Driver)
+	}
+}
@@ -0,0 +1,113 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileSystem keeps the blobs as files under dir, it serves them itself so a
+// single instance needs nothing else.
+type FileSystem struct {
+	dir     string
+	baseURL string
+}
+
+func NewFileSystem(dir, baseURL string) *FileSystem {
+	return &FileSystem{
+		dir:     dir,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// Put writes to a temporary file renamed over the key, a reader never sees
+// a partial blob.
+func (f *FileSystem) Put(ctx context.Context, key, _ string, data []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	path, err := f.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("fail to create blob dir: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("fail to create blob: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("fail to write blob: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("fail to write blob: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (f *FileSystem) Delete(ctx context.Context, key string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	path, err := f.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("fail to delete blob: %w", err)
+	}
+	return nil
+}
+
+func (f *FileSystem) URL(key string) string {
+	return f.baseURL + "/" + key
+}
+
+// ServeHTTP serves the blob named by the request path, directories are not
+// listed.
+func (f *FileSystem) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path, err := f.path(r.URL.Path)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil || info.IsDir() {
+		http.NotFound(w, r)
+		return
+	}
+
+	http.ServeContent(w, r, "", info.ModTime(), file)
+}
+
+func (f *FileSystem) path(key string) (string, error) {
+	key = strings.TrimPrefix(key, "/")
+	if key == "" || !filepath.IsLocal(key) {
+		return "", fmt.Errorf("invalid blob key: %q", key)
+	}
+	return filepath.Join(f.dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,44 @@
+package blobstore_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/provider/blobstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSystemPutServeDelete(t *testing.T) {
+	ctx := context.Background()
+	store := blobstore.NewFileSystem(t.TempDir(), "http://localhost:8090/blobs/")
+
+	require.NoError(t, store.Put(ctx, "avatars/1", "image/png", []byte("first")))
+	require.NoError(t, store.Put(ctx, "avatars/1", "image/png", []byte("second")))
+	assert.Equal(t, "http://localhost:8090/blobs/avatars/1", store.URL("avatars/1"))
+
+	rec := httptest.NewRecorder()
+	store.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/avatars/1", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "second", rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	store.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/avatars/", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	require.NoError(t, store.Delete(ctx, "avatars/1"))
+	require.NoError(t, store.Delete(ctx, "avatars/1"))
+
+	rec = httptest.NewRecorder()
+	store.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/avatars/1", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestFileSystemRejectsKeysOutsideDir(t *testing.T) {
+	store := blobstore.NewFileSystem(t.TempDir(), "")
+
+	assert.Error(t, store.Put(context.Background(), "../escape", "image/png", []byte("x")))
+	assert.Error(t, store.Delete(context.Background(), ""))
+}
@@ -36,6 +36,10 @@ func (v *Validation) ValidateStruct(s interface{}) error {
 		log.Fatal(err)
 	}
 
+	if err := v.validate.RegisterValidation("plate", val.Plate); err != nil {
+		log.Fatal(err)
+	}
+
 	return v.validate.Struct(s)
 }
 
@@ -0,0 +1,7 @@
+package ciphers
+
+import "github.com/lucasd-coder/fast-feet/user-manger-service/config"
+
+func New(cfg *config.Config) (*FieldCipher, error) {
+	return NewFieldCipher(cfg.Encryption.KeyID, cfg.Encryption.Keys(), cfg.Encryption.IndexKey)
+}
@@ -0,0 +1,165 @@
+package ciphers
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// prefix marks an encrypted value, a value without it was written before
+// the encryption and is read as is.
+const prefix = "enc:"
+
+var (
+	ErrCipherText   = errors.New("cipher text too short")
+	ErrUnknownKeyID = errors.New("unknown encryption key id")
+)
+
+// FieldCipher encrypts single document fields with the current key and
+// decrypts them with any key still registered, values look like
+// enc:<keyID>:<base64(nonce|ciphertext)>. Encryption is randomized, lookups
+// go through BlindIndex.
+type FieldCipher struct {
+	keyID    string
+	keys     map[string]cipher.AEAD
+	indexKey []byte
+}
+
+// NewFieldCipher takes the secrets by key ID, keyID must be one of them.
+// The index key is not rotated, changing it requires to rebuild every blind
+// index.
+func NewFieldCipher(keyID string, secrets map[string]string, indexKey string) (*FieldCipher, error) {
+	if _, ok := secrets[keyID]; !ok {
+		return nil, fmt.Errorf("err current key id %q: %w", keyID, ErrUnknownKeyID)
+	}
+
+	if indexKey == "" {
+		return nil, errors.New("err blind index key is required")
+	}
+
+	keys := make(map[string]cipher.AEAD, len(secrets))
+	for id, secret := range secrets {
+		if id == "" || strings.Contains(id, ":") {
+			return nil, fmt.Errorf("err invalid key id: %q", id)
+		}
+
+		aead, err := newGCM(secret)
+		if err != nil {
+			return nil, err
+		}
+		keys[id] = aead
+	}
+
+	return &FieldCipher{
+		keyID:    keyID,
+		keys:     keys,
+		indexKey: deriveKey([]byte(indexKey)),
+	}, nil
+}
+
+// Encrypt binds the ciphertext to field, it only decrypts for the same
+// field.
+func (c *FieldCipher) Encrypt(field, plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+
+	aead := c.keys[c.keyID]
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(field))
+
+	return prefix + c.keyID + ":" + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+func (c *FieldCipher) Decrypt(field, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	keyID, encoded, ok := strings.Cut(strings.TrimPrefix(value, prefix), ":")
+	if !ok {
+		return "", fmt.Errorf("err while decrypt %s: %w", field, ErrCipherText)
+	}
+
+	aead, ok := c.keys[keyID]
+	if !ok {
+		return "", fmt.Errorf("err while decrypt %s key id %q: %w", field, keyID, ErrUnknownKeyID)
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("err while decrypt %s: %w", field, err)
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("err while decrypt %s: %w", field, ErrCipherText)
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(field))
+	if err != nil {
+		return "", fmt.Errorf("err while decrypt %s: %w", field, err)
+	}
+
+	return string(plaintext), nil
+}
+
+// IsCurrent reports whether value is encrypted with the current key, an
+// empty value needs nothing.
+func (c *FieldCipher) IsCurrent(value string) bool {
+	return value == "" || strings.HasPrefix(value, c.CurrentPrefix())
+}
+
+func (c *FieldCipher) CurrentPrefix() string {
+	return prefix + c.keyID + ":"
+}
+
+// BlindIndex is a keyed hash of value, equal values of the same field give
+// the same index.
+func (c *FieldCipher) BlindIndex(field, value string) string {
+	if value == "" {
+		return ""
+	}
+
+	mac := hmac.New(sha256.New, c.indexKey)
+	mac.Write([]byte(field))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+func newGCM(secret string) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(deriveKey([]byte(secret)))
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func deriveKey(secret []byte) []byte {
+	salt := []byte{0x5e, 0x5d, 0x5a, 0x5c, 0x5b, 0x59, 0x58, 0x57}
+
+	iter := 10000
+	keyLen := 32
+
+	return pbkdf2.Key(secret, salt, iter, keyLen, sha256.New)
+}
@@ -0,0 +1,64 @@
+package ciphers_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lucasd-coder/fast-feet/user-manger-service/internal/shared/ciphers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldCipherRotation(t *testing.T) {
+	old, err := ciphers.NewFieldCipher("v1", map[string]string{"v1": "key1"}, "index")
+	require.NoError(t, err)
+
+	current, err := ciphers.NewFieldCipher("v2", map[string]string{"v1": "key1", "v2": "key2"}, "index")
+	require.NoError(t, err)
+
+	enc, err := old.Encrypt("email", "maria@gmail.com")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(enc, "enc:v1:"))
+	assert.False(t, current.IsCurrent(enc))
+
+	plain, err := current.Decrypt("email", enc)
+	require.NoError(t, err)
+	assert.Equal(t, "maria@gmail.com", plain)
+
+	reenc, err := current.Encrypt("email", plain)
+	require.NoError(t, err)
+	assert.True(t, current.IsCurrent(reenc))
+
+	_, err = old.Decrypt("email", reenc)
+	assert.ErrorIs(t, err, ciphers.ErrUnknownKeyID)
+}
+
+func TestFieldCipherIsRandomizedAndBoundToField(t *testing.T) {
+	c, err := ciphers.NewFieldCipher("v1", map[string]string{"v1": "key1"}, "index")
+	require.NoError(t, err)
+
+	first, _ := c.Encrypt("cpf", "04261860015")
+	second, _ := c.Encrypt("cpf", "04261860015")
+	assert.NotEqual(t, first, second)
+
+	_, err = c.Decrypt("email", first)
+	assert.Error(t, err)
+
+	assert.Equal(t, c.BlindIndex("cpf", "04261860015"), c.BlindIndex("cpf", "04261860015"))
+	assert.NotEqual(t, c.BlindIndex("cpf", "04261860015"), c.BlindIndex("email", "04261860015"))
+}
+
+func TestFieldCipherReadsPlainText(t *testing.T) {
+	c, err := ciphers.NewFieldCipher("v1", map[string]string{"v1": "key1"}, "index")
+	require.NoError(t, err)
+
+	plain, err := c.Decrypt("email", "maria@gmail.com")
+	require.NoError(t, err)
+	assert.Equal(t, "maria@gmail.com", plain)
+	assert.False(t, c.IsCurrent("maria@gmail.com"))
+}
+
+func TestNewFieldCipherUnknownCurrentKey(t *testing.T) {
+	_, err := ciphers.NewFieldCipher("v2", map[string]string{"v1": "key1"}, "index")
+	assert.ErrorIs(t, err, ciphers.ErrUnknownKeyID)
+}
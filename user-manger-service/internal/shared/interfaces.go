@@ -1,5 +1,15 @@
 package shared
 
+import "context"
+
 type Validator interface {
 	ValidateStruct(s interface{}) error
 }
+
+// BlobStore keeps binary objects such as avatars, Delete of a missing key
+// is not an error.
+type BlobStore interface {
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.3.0
-// - protoc             v3.21.9
+// - protoc             v3.21.12
 // source: service/user_service.proto
 
 package pb
@@ -19,9 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	UserService_Save_FullMethodName        = "/pb.UserService/Save"
-	UserService_FindByEmail_FullMethodName = "/pb.UserService/FindByEmail"
-	UserService_FindByCpf_FullMethodName   = "/pb.UserService/FindByCpf"
+	UserService_Save_FullMethodName                   = "/pb.UserService/Save"
+	UserService_FindByEmail_FullMethodName            = "/pb.UserService/FindByEmail"
+	UserService_FindByCpf_FullMethodName              = "/pb.UserService/FindByCpf"
+	UserService_UpdateUser_FullMethodName             = "/pb.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName             = "/pb.UserService/DeleteUser"
+	UserService_ExportUser_FullMethodName             = "/pb.UserService/ExportUser"
+	UserService_ListUsers_FullMethodName              = "/pb.UserService/ListUsers"
+	UserService_SaveDeliverymanProfile_FullMethodName = "/pb.UserService/SaveDeliverymanProfile"
+	UserService_GetDeliverymanProfile_FullMethodName  = "/pb.UserService/GetDeliverymanProfile"
+	UserService_SetAvailability_FullMethodName        = "/pb.UserService/SetAvailability"
+	UserService_UploadAvatar_FullMethodName           = "/pb.UserService/UploadAvatar"
+	UserService_SaveAttributeSchema_FullMethodName    = "/pb.UserService/SaveAttributeSchema"
+	UserService_GetAttributeSchema_FullMethodName     = "/pb.UserService/GetAttributeSchema"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -31,6 +41,16 @@ type UserServiceClient interface {
 	Save(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*UserResponse, error)
 	FindByEmail(ctx context.Context, in *UserByEmailRequest, opts ...grpc.CallOption) (*UserResponse, error)
 	FindByCpf(ctx context.Context, in *UserByCpfRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	ExportUser(ctx context.Context, in *ExportUserRequest, opts ...grpc.CallOption) (*ExportUserResponse, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	SaveDeliverymanProfile(ctx context.Context, in *DeliverymanProfileRequest, opts ...grpc.CallOption) (*DeliverymanProfileResponse, error)
+	GetDeliverymanProfile(ctx context.Context, in *GetDeliverymanProfileRequest, opts ...grpc.CallOption) (*DeliverymanProfileResponse, error)
+	SetAvailability(ctx context.Context, in *SetAvailabilityRequest, opts ...grpc.CallOption) (*DeliverymanProfileResponse, error)
+	UploadAvatar(ctx context.Context, in *UploadAvatarRequest, opts ...grpc.CallOption) (*DeliverymanProfileResponse, error)
+	SaveAttributeSchema(ctx context.Context, in *AttributeSchemaRequest, opts ...grpc.CallOption) (*AttributeSchemaResponse, error)
+	GetAttributeSchema(ctx context.Context, in *GetAttributeSchemaRequest, opts ...grpc.CallOption) (*AttributeSchemaResponse, error)
 }
 
 type userServiceClient struct {
@@ -68,6 +88,96 @@ func (c *userServiceClient) FindByCpf(ctx context.Context, in *UserByCpfRequest,
 	return out, nil
 }
 
+func (c *userServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ExportUser(ctx context.Context, in *ExportUserRequest, opts ...grpc.CallOption) (*ExportUserResponse, error) {
+	out := new(ExportUserResponse)
+	err := c.cc.Invoke(ctx, UserService_ExportUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SaveDeliverymanProfile(ctx context.Context, in *DeliverymanProfileRequest, opts ...grpc.CallOption) (*DeliverymanProfileResponse, error) {
+	out := new(DeliverymanProfileResponse)
+	err := c.cc.Invoke(ctx, UserService_SaveDeliverymanProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetDeliverymanProfile(ctx context.Context, in *GetDeliverymanProfileRequest, opts ...grpc.CallOption) (*DeliverymanProfileResponse, error) {
+	out := new(DeliverymanProfileResponse)
+	err := c.cc.Invoke(ctx, UserService_GetDeliverymanProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetAvailability(ctx context.Context, in *SetAvailabilityRequest, opts ...grpc.CallOption) (*DeliverymanProfileResponse, error) {
+	out := new(DeliverymanProfileResponse)
+	err := c.cc.Invoke(ctx, UserService_SetAvailability_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UploadAvatar(ctx context.Context, in *UploadAvatarRequest, opts ...grpc.CallOption) (*DeliverymanProfileResponse, error) {
+	out := new(DeliverymanProfileResponse)
+	err := c.cc.Invoke(ctx, UserService_UploadAvatar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SaveAttributeSchema(ctx context.Context, in *AttributeSchemaRequest, opts ...grpc.CallOption) (*AttributeSchemaResponse, error) {
+	out := new(AttributeSchemaResponse)
+	err := c.cc.Invoke(ctx, UserService_SaveAttributeSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetAttributeSchema(ctx context.Context, in *GetAttributeSchemaRequest, opts ...grpc.CallOption) (*AttributeSchemaResponse, error) {
+	out := new(AttributeSchemaResponse)
+	err := c.cc.Invoke(ctx, UserService_GetAttributeSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -75,6 +185,16 @@ type UserServiceServer interface {
 	Save(context.Context, *UserRequest) (*UserResponse, error)
 	FindByEmail(context.Context, *UserByEmailRequest) (*UserResponse, error)
 	FindByCpf(context.Context, *UserByCpfRequest) (*UserResponse, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	ExportUser(context.Context, *ExportUserRequest) (*ExportUserResponse, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	SaveDeliverymanProfile(context.Context, *DeliverymanProfileRequest) (*DeliverymanProfileResponse, error)
+	GetDeliverymanProfile(context.Context, *GetDeliverymanProfileRequest) (*DeliverymanProfileResponse, error)
+	SetAvailability(context.Context, *SetAvailabilityRequest) (*DeliverymanProfileResponse, error)
+	UploadAvatar(context.Context, *UploadAvatarRequest) (*DeliverymanProfileResponse, error)
+	SaveAttributeSchema(context.Context, *AttributeSchemaRequest) (*AttributeSchemaResponse, error)
+	GetAttributeSchema(context.Context, *GetAttributeSchemaRequest) (*AttributeSchemaResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -91,6 +211,36 @@ func (UnimplementedUserServiceServer) FindByEmail(context.Context, *UserByEmailR
 func (UnimplementedUserServiceServer) FindByCpf(context.Context, *UserByCpfRequest) (*UserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindByCpf not implemented")
 }
+func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedUserServiceServer) ExportUser(context.Context, *ExportUserRequest) (*ExportUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedUserServiceServer) SaveDeliverymanProfile(context.Context, *DeliverymanProfileRequest) (*DeliverymanProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDeliverymanProfile not implemented")
+}
+func (UnimplementedUserServiceServer) GetDeliverymanProfile(context.Context, *GetDeliverymanProfileRequest) (*DeliverymanProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeliverymanProfile not implemented")
+}
+func (UnimplementedUserServiceServer) SetAvailability(context.Context, *SetAvailabilityRequest) (*DeliverymanProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAvailability not implemented")
+}
+func (UnimplementedUserServiceServer) UploadAvatar(context.Context, *UploadAvatarRequest) (*DeliverymanProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadAvatar not implemented")
+}
+func (UnimplementedUserServiceServer) SaveAttributeSchema(context.Context, *AttributeSchemaRequest) (*AttributeSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveAttributeSchema not implemented")
+}
+func (UnimplementedUserServiceServer) GetAttributeSchema(context.Context, *GetAttributeSchemaRequest) (*AttributeSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttributeSchema not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +308,186 @@ func _UserService_FindByCpf_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ExportUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ExportUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ExportUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ExportUser(ctx, req.(*ExportUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SaveDeliverymanProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeliverymanProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SaveDeliverymanProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SaveDeliverymanProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SaveDeliverymanProfile(ctx, req.(*DeliverymanProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetDeliverymanProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeliverymanProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetDeliverymanProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetDeliverymanProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetDeliverymanProfile(ctx, req.(*GetDeliverymanProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetAvailability(ctx, req.(*SetAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UploadAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadAvatarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UploadAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UploadAvatar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UploadAvatar(ctx, req.(*UploadAvatarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SaveAttributeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttributeSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SaveAttributeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SaveAttributeSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SaveAttributeSchema(ctx, req.(*AttributeSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetAttributeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttributeSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetAttributeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetAttributeSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetAttributeSchema(ctx, req.(*GetAttributeSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +507,46 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindByCpf",
 			Handler:    _UserService_FindByCpf_Handler,
 		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _UserService_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _UserService_DeleteUser_Handler,
+		},
+		{
+			MethodName: "ExportUser",
+			Handler:    _UserService_ExportUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _UserService_ListUsers_Handler,
+		},
+		{
+			MethodName: "SaveDeliverymanProfile",
+			Handler:    _UserService_SaveDeliverymanProfile_Handler,
+		},
+		{
+			MethodName: "GetDeliverymanProfile",
+			Handler:    _UserService_GetDeliverymanProfile_Handler,
+		},
+		{
+			MethodName: "SetAvailability",
+			Handler:    _UserService_SetAvailability_Handler,
+		},
+		{
+			MethodName: "UploadAvatar",
+			Handler:    _UserService_UploadAvatar_Handler,
+		},
+		{
+			MethodName: "SaveAttributeSchema",
+			Handler:    _UserService_SaveAttributeSchema_Handler,
+		},
+		{
+			MethodName: "GetAttributeSchema",
+			Handler:    _UserService_GetAttributeSchema_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service/user_service.proto",